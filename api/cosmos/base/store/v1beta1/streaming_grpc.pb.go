@@ -0,0 +1,134 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/base/store/v1beta1/streaming.proto
+
+package storev1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StreamingClient is the client API for Streaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StreamingClient interface {
+	// Subscribe streams the changes of the blocks committed from the subscription on, in order.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error)
+}
+
+type streamingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamingClient(cc grpc.ClientConnInterface) StreamingClient {
+	return &streamingClient{cc}
+}
+
+func (c *streamingClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Streaming_ServiceDesc.Streams[0], "/cosmos.base.store.v1beta1.Streaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Streaming_SubscribeClient interface {
+	Recv() (*BlockChanges, error)
+	grpc.ClientStream
+}
+
+type streamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingSubscribeClient) Recv() (*BlockChanges, error) {
+	m := new(BlockChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServer is the server API for Streaming service.
+// All implementations must embed UnimplementedStreamingServer
+// for forward compatibility
+type StreamingServer interface {
+	// Subscribe streams the changes of the blocks committed from the subscription on, in order.
+	Subscribe(*SubscribeRequest, Streaming_SubscribeServer) error
+	mustEmbedUnimplementedStreamingServer()
+}
+
+// UnimplementedStreamingServer must be embedded to have forward compatible implementations.
+type UnimplementedStreamingServer struct {
+}
+
+func (UnimplementedStreamingServer) Subscribe(*SubscribeRequest, Streaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedStreamingServer) mustEmbedUnimplementedStreamingServer() {}
+
+// UnsafeStreamingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamingServer will
+// result in compilation errors.
+type UnsafeStreamingServer interface {
+	mustEmbedUnimplementedStreamingServer()
+}
+
+func RegisterStreamingServer(s grpc.ServiceRegistrar, srv StreamingServer) {
+	s.RegisterService(&Streaming_ServiceDesc, srv)
+}
+
+func _Streaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServer).Subscribe(m, &streamingSubscribeServer{stream})
+}
+
+type Streaming_SubscribeServer interface {
+	Send(*BlockChanges) error
+	grpc.ServerStream
+}
+
+type streamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingSubscribeServer) Send(m *BlockChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Streaming_ServiceDesc is the grpc.ServiceDesc for Streaming service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Streaming_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.Streaming",
+	HandlerType: (*StreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Streaming_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/store/v1beta1/streaming.proto",
+}
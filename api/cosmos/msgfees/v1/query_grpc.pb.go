@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/msgfees/v1/query.proto
+
+package msgfeesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// MsgFee returns the fees of a Msg type URL.
+	MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error)
+	// MsgFees returns the fees of all the Msg type URLs with fees.
+	MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error) {
+	out := new(QueryMsgFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.msgfees.v1.Query/MsgFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error) {
+	out := new(QueryMsgFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.msgfees.v1.Query/MsgFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// MsgFee returns the fees of a Msg type URL.
+	MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error)
+	// MsgFees returns the fees of all the Msg type URLs with fees.
+	MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFee not implemented")
+}
+func (UnimplementedQueryServer) MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFees not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_MsgFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.msgfees.v1.Query/MsgFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFee(ctx, req.(*QueryMsgFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.msgfees.v1.Query/MsgFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFees(ctx, req.(*QueryMsgFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.msgfees.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MsgFee",
+			Handler:    _Query_MsgFee_Handler,
+		},
+		{
+			MethodName: "MsgFees",
+			Handler:    _Query_MsgFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/msgfees/v1/query.proto",
+}
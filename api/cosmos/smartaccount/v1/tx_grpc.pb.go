@@ -0,0 +1,149 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: cosmos/smartaccount/v1/tx.proto
+
+package smartaccountv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAuthenticator converts the base account of the sender into a smart
+	// account authenticated by the given authenticator.
+	RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of the smart account of the
+	// sender.
+	RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error) {
+	out := new(MsgRegisterAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.smartaccount.v1.Msg/RegisterAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error) {
+	out := new(MsgRotateAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.smartaccount.v1.Msg/RotateAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	// RegisterAuthenticator converts the base account of the sender into a smart
+	// account authenticated by the given authenticator.
+	RegisterAuthenticator(context.Context, *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of the smart account of the
+	// sender.
+	RotateAuthenticator(context.Context, *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) RegisterAuthenticator(context.Context, *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RotateAuthenticator(context.Context, *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_RegisterAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.smartaccount.v1.Msg/RegisterAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, req.(*MsgRegisterAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.smartaccount.v1.Msg/RotateAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateAuthenticator(ctx, req.(*MsgRotateAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.smartaccount.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAuthenticator",
+			Handler:    _Msg_RegisterAuthenticator_Handler,
+		},
+		{
+			MethodName: "RotateAuthenticator",
+			Handler:    _Msg_RotateAuthenticator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/smartaccount/v1/tx.proto",
+}
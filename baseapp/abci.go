@@ -309,6 +309,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	// collect the writes of the block for the streaming services hooking into
+	// the Commit messages
+	for _, commitListener := range app.commitListeners {
+		commitListener.startCommit()
+	}
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
@@ -316,6 +322,23 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+
+	// call the streaming service hooks with the Commit messages and the change sets of the block
+	var haltStreaming bool
+	for _, commitListener := range app.commitListeners {
+		changeSet := commitListener.endCommit()
+		if err := commitListener.listener.ListenCommit(app.deliverState.ctx, res, changeSet); err != nil {
+			app.logger.Error("Commit listening hook failed", "height", header.Height, "err", err)
+			if errors.Is(err, ErrHaltNode) {
+				haltStreaming = true
+			}
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -333,6 +356,9 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
 		halt = true
+
+	case haltStreaming:
+		halt = true
 	}
 
 	if halt {
@@ -345,10 +371,7 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 
 	go app.snapshotManager.SnapshotIfApplicable(header.Height)
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -54,7 +56,8 @@ type BaseApp struct { //nolint: maligned
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry codectypes.InterfaceRegistry
-	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
+	txDecoder         sdk.TxDecoder   // unmarshal []byte into sdk.Tx
+	mempool           mempool.Mempool // application side mempool
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
 	postHandler    sdk.AnteHandler  // post handler, optional, e.g. for tips
@@ -133,6 +136,10 @@ type BaseApp struct { //nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// commitListeners collect the change sets of the streaming services hooking
+	// into the Commit messages
+	commitListeners []*changeSetListener
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -165,6 +172,10 @@ func NewBaseApp(
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
 
+	if app.mempool == nil {
+		app.SetMempool(mempool.NoOpMempool{})
+	}
+
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
 	return app
@@ -198,6 +209,9 @@ func (app *BaseApp) Trace() bool {
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
+// Mempool returns the application side mempool.
+func (app *BaseApp) Mempool() mempool.Mempool { return app.mempool }
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
@@ -593,12 +607,17 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, within the given
+// context instead of the one of the execution mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -679,6 +698,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			// a pending tx which became invalid after a commit is evicted
+			if mode == runTxModeReCheck {
+				if rmErr := app.mempool.Remove(tx); rmErr != nil && !errors.Is(rmErr, mempool.ErrTxNotFound) {
+					app.logger.Error("failed to remove tx from mempool", "err", rmErr)
+				}
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -687,6 +713,20 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	switch mode {
+	case runTxModeCheck:
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+
+	case runTxModeDeliver:
+		// the mempool is a per-node choice, so its errors must not change the
+		// result of the tx
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			app.logger.Error("failed to remove tx from mempool", "err", err)
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -701,7 +741,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		//
 		// Note: If the postHandler fails, we also revert the runMsgs state.
 		if app.postHandler != nil {
-			newCtx, err := app.postHandler(runMsgCtx, tx, mode == runTxModeSimulate)
+			// The post handlers get a fresh event manager, as the one of
+			// runMsgCtx already holds the AnteHandler events.
+			postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+			newCtx, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate)
 			if err != nil {
 				return gInfo, nil, nil, priority, err
 			}
@@ -709,11 +752,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
 
-		if mode == runTxModeDeliver {
+		switch mode {
+		case runTxModeDeliver:
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
 			msCache.Write()
+
+		case runTxModeSimulate:
+			// Simulations run in a discarded branch of the check state, to
+			// which the state changes are written so that they can be
+			// returned by SimulateWithStateDiff.
+			msCache.Write()
 		}
 
 		if len(anteEvents) > 0 && (mode == runTxModeDeliver || mode == runTxModeSimulate) {
@@ -1013,6 +1013,44 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// Test that the events of the post handler are appended to the tx events,
+// without repeating the ante handler events.
+func TestDeliverTxPostHandlerEvents(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx.EventManager().EmitEvents(counterEvent("post_handler", tx.(txTest).Counter))
+			return ctx, nil
+		})
+	}
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, postOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	events := res.GetEvents()
+	require.Len(t, events, 4, "should contain ante handler, message type, counter and post handler events respectively")
+	require.Equal(t, sdk.MarkEventsToIndex(counterEvent("ante_handler", 0).ToABCIEvents(), map[string]struct{}{})[0], events[0], "ante handler event")
+	require.Equal(t, sdk.MarkEventsToIndex(counterEvent("post_handler", 0).ToABCIEvents(), map[string]struct{}{})[0], events[3], "post handler event")
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1165,6 +1203,49 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTxWithStateDiff(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx.KVStore(capKey2).Set([]byte("fee"), []byte("1"))
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			ctx.KVStore(capKey1).Set([]byte("x"), []byte("y"))
+			ctx.KVStore(capKey2).Delete([]byte("a"))
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.checkState.ctx.KVStore(capKey2).Set([]byte("a"), []byte("1"))
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+	txBytes, err := cdc.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+
+	expected := []StateChange{
+		{StoreKVPair: storetypes.StoreKVPair{StoreKey: "key1", Key: []byte("x"), Value: []byte("y")}},
+		{StoreKVPair: storetypes.StoreKVPair{StoreKey: "key2", Delete: true, Key: []byte("a")}, OldValue: []byte("1")},
+		{StoreKVPair: storetypes.StoreKVPair{StoreKey: "key2", Key: []byte("fee"), Value: []byte("1")}},
+	}
+	for i := 0; i < 2; i++ {
+		_, result, changes, err := app.SimulateWithStateDiff(txBytes)
+		require.NoError(t, err)
+		require.NotNil(t, result)
+		require.Equal(t, expected, changes)
+	}
+
+	// require the check state to be left unchanged
+	require.Equal(t, []byte("1"), app.checkState.ctx.KVStore(capKey2).Get([]byte("a")))
+	require.Nil(t, app.checkState.ctx.KVStore(capKey1).Get([]byte("x")))
+	require.Nil(t, app.checkState.ctx.KVStore(capKey2).Get([]byte("fee")))
+}
+
 func TestRunInvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -2210,3 +2291,64 @@ func TestBaseApp_EndBlock(t *testing.T) {
 	require.Equal(t, int64(100), res.GetValidatorUpdates()[0].Power)
 	require.Equal(t, cp.Block.MaxGas, res.ConsensusParamUpdates.Block.MaxGas)
 }
+
+type mockCommitListener struct {
+	StreamingService
+	keys       []storetypes.StoreKey
+	changeSets [][]*storetypes.StoreKVPair
+}
+
+func (l *mockCommitListener) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(l.keys))
+	for _, key := range l.keys {
+		listeners[key] = nil
+	}
+	return listeners
+}
+
+func (l *mockCommitListener) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+func (l *mockCommitListener) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+func (l *mockCommitListener) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+func (l *mockCommitListener) ListenCommit(_ sdk.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	l.changeSets = append(l.changeSets, changeSet)
+	return nil
+}
+
+func TestCommitListener(t *testing.T) {
+	listener := &mockCommitListener{keys: []storetypes.StoreKey{capKey1}}
+	beginBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set([]byte("height"), sdk.Uint64ToBigEndian(uint64(req.Header.Height)))
+			ctx.KVStore(capKey2).Set([]byte("height"), sdk.Uint64ToBigEndian(uint64(req.Header.Height)))
+			return abci.ResponseBeginBlock{}
+		})
+	}
+	streamingOpt := func(bapp *BaseApp) { bapp.SetStreamingService(listener) }
+	app := setupBaseApp(t, beginBlockerOpt, streamingOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		header := tmproto.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// only the writes to the exposed stores are collected
+	require.Len(t, listener.changeSets, 2)
+	for i, changeSet := range listener.changeSets {
+		require.Len(t, changeSet, 1)
+		require.Equal(t, capKey1.Name(), changeSet[0].StoreKey)
+		require.Equal(t, []byte("height"), changeSet[0].Key)
+		require.Equal(t, sdk.Uint64ToBigEndian(uint64(i+1)), changeSet[0].Value)
+	}
+}
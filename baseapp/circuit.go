@@ -0,0 +1,11 @@
+package baseapp
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// CircuitBreaker defines the interface of a circuit breaker, which decides
+// whether a Msg type URL may be executed. It is checked by the
+// MsgServiceRouter before routing any Msg to its handler, including the Msg's
+// dispatched by other modules such as x/authz or x/group.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) bool
+}
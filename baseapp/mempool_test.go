@@ -0,0 +1,113 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var _ mempool.Mempool = (*counterMempool)(nil)
+
+// counterMempool is a mempool indexing txTest transactions by counter, and
+// recording the priority they were inserted with. Remove fails with removeErr
+// when it is set.
+type counterMempool struct {
+	txs       map[int64]int64
+	removeErr error
+}
+
+func (mp *counterMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.txs[tx.(txTest).Counter] = sdk.UnwrapSDKContext(ctx).Priority()
+	return nil
+}
+
+func (mp *counterMempool) Select(context.Context, [][]byte) mempool.Iterator { return nil }
+
+func (mp *counterMempool) CountTx() int { return len(mp.txs) }
+
+func (mp *counterMempool) Remove(tx sdk.Tx) error {
+	if mp.removeErr != nil {
+		return mp.removeErr
+	}
+
+	counter := tx.(txTest).Counter
+	if _, ok := mp.txs[counter]; !ok {
+		return mempool.ErrTxNotFound
+	}
+
+	delete(mp.txs, counter)
+	return nil
+}
+
+func TestDefaultMempool(t *testing.T) {
+	app := newBaseApp(t.Name())
+	require.Equal(t, mempool.NoOpMempool{}, app.Mempool())
+}
+
+func TestMempoolInsertRemove(t *testing.T) {
+	counterKey := []byte("counter-key")
+	mp := &counterMempool{txs: map[int64]int64{}}
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	require.Equal(t, mp, app.Mempool())
+	app.InitChain(abci.RequestInitChain{})
+
+	cdc := codec.NewLegacyAmino()
+	registerTestCodec(cdc)
+
+	txBytes := make([][]byte, 3)
+	for i := range txBytes {
+		var err error
+		txBytes[i], err = cdc.Marshal(newTxCounter(int64(i), 0))
+		require.NoError(t, err)
+
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes[i]})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// txs passing CheckTx are inserted with their priority
+	require.Equal(t, map[int64]int64{0: testTxPriority, 1: testTxPriority, 2: testTxPriority}, mp.txs)
+
+	// txs failing CheckTx are not inserted
+	failTx := newTxCounter(3, 0)
+	failTx.setFailOnAnte(true)
+	failTxBytes, err := cdc.Marshal(failTx)
+	require.NoError(t, err)
+	require.False(t, app.CheckTx(abci.RequestCheckTx{Tx: failTxBytes}).IsOK())
+	require.Equal(t, 3, mp.CountTx())
+
+	// delivered txs are removed
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes[0]})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, mp.CountTx())
+
+	// a mempool failing to remove a tx doesn't change the result of DeliverTx
+	mp.removeErr = errors.New("remove failed")
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes[1]})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, mp.CountTx())
+	mp.removeErr = nil
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// txs failing ReCheckTx are evicted
+	mp.txs[failTx.Counter] = testTxPriority
+	require.False(t, app.CheckTx(abci.RequestCheckTx{Tx: failTxBytes, Type: abci.CheckTxType_Recheck}).IsOK())
+	require.Equal(t, map[int64]int64{1: testTxPriority, 2: testTxPriority}, mp.txs)
+}
@@ -15,6 +15,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -142,3 +144,43 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+type testCircuitBreaker struct {
+	disabled map[string]bool
+}
+
+func (cb testCircuitBreaker) IsAllowed(_ sdk.Context, msgTypeURL string) bool {
+	return !cb.disabled[msgTypeURL]
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(makeMinimalConfig(), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	testdata.RegisterInterfaces(registry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	cb := testCircuitBreaker{disabled: map[string]bool{}}
+	app.SetCircuitBreaker(cb)
+
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+
+	cb.disabled[sdk.MsgTypeURL(msg)] = true
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -11,7 +11,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -75,6 +79,16 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetStateArchive sets the state archive of the multistore associated with the app.
+func SetStateArchive(archive *archive.Archive) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateArchive(archive) }
+}
+
+// SetMempool sets the application side mempool.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -208,12 +222,14 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	app.router = router
 }
 
-// SetSnapshot sets the snapshot store and options.
+// SetSnapshot sets the snapshot store and options. The snapshot manager is set
+// even if the snapshot interval is off, so that snapshots can still be created
+// and restored on demand.
 func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) {
 	if app.sealed {
 		panic("SetSnapshot() on sealed BaseApp")
 	}
-	if snapshotStore == nil || opts.Interval == snapshottypes.SnapshotIntervalOff {
+	if snapshotStore == nil {
 		app.snapshotManager = nil
 		return
 	}
@@ -221,6 +237,23 @@ func (app *BaseApp) SetSnapshot(snapshotStore *snapshots.Store, opts snapshottyp
 	app.snapshotManager = snapshots.NewManager(snapshotStore, opts, app.cms, nil, app.logger)
 }
 
+// SetStateArchive sets the state archive recording the committed state, and serving the
+// queries at the heights pruned from the multistore. It is a no-op if archive is nil.
+func (app *BaseApp) SetStateArchive(archive *archive.Archive) {
+	if app.sealed {
+		panic("SetStateArchive() on sealed BaseApp")
+	}
+	if archive == nil {
+		return
+	}
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic(fmt.Sprintf("invalid commit multi-store for the state archive; expected %T, got: %T", &rootmulti.Store{}, app.cms))
+	}
+	rms.SetStateArchive(archive)
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
@@ -237,9 +270,37 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 	// register the StreamingService within the BaseApp
 	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
+	// collect the change sets of the committed blocks for the services hooking into the Commit messages
+	if commitListener, ok := s.(ABCICommitListener); ok {
+		changeSet := &changeSetListener{listener: commitListener}
+		for key := range s.Listeners() {
+			app.cms.AddListeners(key, []storetypes.WriteListener{changeSet})
+		}
+		app.commitListeners = append(app.commitListeners, changeSet)
+	}
 }
 
 // SetTxDecoder sets the TxDecoder if it wasn't provided in the BaseApp constructor.
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
+
+// SetMempool sets the application side mempool. Transactions passing CheckTx
+// are inserted in it, and removed once delivered.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
+
+// SetCircuitBreaker sets the circuit breaker checked by the MsgServiceRouter
+// before routing any Msg to its handler.
+func (app *BaseApp) SetCircuitBreaker(cb CircuitBreaker) {
+	if app.msgServiceRouter == nil {
+		panic("cannot set circuit breaker with no msg service router set")
+	}
+
+	app.msgServiceRouter.SetCircuit(cb)
+}
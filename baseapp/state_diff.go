@@ -0,0 +1,82 @@
+package baseapp
+
+import (
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StateChange is a write or a delete made by a simulated tx to a KVStore.
+type StateChange struct {
+	storetypes.StoreKVPair
+
+	// OldValue is the value of the key before the tx, nil if it was not set.
+	OldValue []byte
+}
+
+// listeningMultiStore is implemented by the multistores which can be branched
+// with a listener streaming the writes of the branch, such as the
+// cachemulti.Store of the check state.
+type listeningMultiStore interface {
+	storetypes.MultiStore
+
+	CacheMultiStoreWithListener(listener storetypes.WriteListener) storetypes.CacheMultiStore
+}
+
+// stateChangeListener records the writes streamed by a listenkv.Store, along
+// with their store key.
+type stateChangeListener struct {
+	changes   []StateChange
+	storeKeys []storetypes.StoreKey
+}
+
+func (l *stateChangeListener) OnWrite(storeKey storetypes.StoreKey, key []byte, value []byte, delete bool) error {
+	l.changes = append(l.changes, StateChange{
+		StoreKVPair: storetypes.StoreKVPair{
+			StoreKey: storeKey.Name(),
+			Delete:   delete,
+			Key:      key,
+			Value:    value,
+		},
+	})
+	l.storeKeys = append(l.storeKeys, storeKey)
+	return nil
+}
+
+// SimulateWithStateDiff executes a tx in simulate mode like Simulate, and
+// returns in addition the state changes the tx would make. The changes are the
+// net writes and deletes of the tx, ordered by store key name then by key, as
+// captured by a listenkv.Store when the state of the simulation is flushed.
+func (app *BaseApp) SimulateWithStateDiff(txBytes []byte) (sdk.GasInfo, *sdk.Result, []StateChange, error) {
+	ctx := app.getContextForTx(runTxModeSimulate, txBytes)
+
+	// The writes of the simulation are flushed to a branch of the context, so
+	// that the context still holds the values before the tx.
+	ms, ok := ctx.MultiStore().CacheMultiStore().(listeningMultiStore)
+	if !ok {
+		return sdk.GasInfo{}, nil, nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "multistore does not support listening to simulations")
+	}
+
+	listener := &stateChangeListener{}
+	simMs := ms.CacheMultiStoreWithListener(listener)
+
+	gasInfo, result, _, _, err := app.runTxWithContext(ctx.WithMultiStore(simMs), runTxModeSimulate, txBytes)
+	if err != nil {
+		return gasInfo, result, nil, err
+	}
+
+	simMs.Write()
+
+	for i, storeKey := range listener.storeKeys {
+		listener.changes[i].OldValue = ctx.MultiStore().GetKVStore(storeKey).Get(listener.changes[i].Key)
+	}
+
+	changes := listener.changes
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].StoreKey < changes[j].StoreKey
+	})
+
+	return gasInfo, result, changes, nil
+}
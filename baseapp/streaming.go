@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"io"
 	"sync"
 
@@ -31,3 +32,62 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// ErrHaltNode is wrapped by the errors of the ABCICommitListeners requiring the node to halt.
+var ErrHaltNode = errors.New("streaming service requires halting the node")
+
+// ABCICommitListener is an optional interface of the StreamingServices hooking into the Commit
+// messages, which are updated with the change set of each committed block.
+type ABCICommitListener interface {
+	// ListenCommit updates the streaming service with the Commit response of a block and the
+	// writes and deletes it committed to the stores of the service's listeners, in the order
+	// they were flushed to the stores. An error wrapping ErrHaltNode halts the node once the
+	// block is committed.
+	ListenCommit(ctx types.Context, res abci.ResponseCommit, changeSet []*store.StoreKVPair) error
+}
+
+// changeSetListener is the WriteListener collecting the change sets of an ABCICommitListener.
+// It only collects the writes made while the BaseApp commits a block, i.e. the writes of the
+// block flushed to the root multistore, leaving out those flushed between the branches of the
+// deliver and check states.
+type changeSetListener struct {
+	listener ABCICommitListener
+
+	mtx        sync.Mutex
+	committing bool
+	changeSet  []*store.StoreKVPair
+}
+
+// OnWrite satisfies the WriteListener interface.
+func (l *changeSetListener) OnWrite(storeKey store.StoreKey, key []byte, value []byte, delete bool) error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if l.committing {
+		l.changeSet = append(l.changeSet, &store.StoreKVPair{
+			StoreKey: storeKey.Name(),
+			Delete:   delete,
+			Key:      key,
+			Value:    value,
+		})
+	}
+	return nil
+}
+
+// startCommit starts collecting the writes of the block being committed.
+func (l *changeSetListener) startCommit() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.committing = true
+}
+
+// endCommit stops collecting the writes, and returns those of the committed block.
+func (l *changeSetListener) endCommit() []*store.StoreKVPair {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	changeSet := l.changeSet
+	l.committing, l.changeSet = false, nil
+	return changeSet
+}
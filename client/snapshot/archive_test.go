@@ -0,0 +1,85 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+func setupSnapshotStore(t *testing.T) *snapshots.Store {
+	store, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func makeChunks(chunks ...[]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestDumpAndLoadArchive(t *testing.T) {
+	source := setupSnapshotStore(t)
+	snapshot, err := source.Save(3, 2, makeChunks([]byte{3, 2, 0}, []byte{3, 2, 1}, []byte{3, 2, 2}))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	require.NoError(t, dumpArchive(source, 3, 2, &archive))
+	require.Error(t, dumpArchive(source, 4, 2, io.Discard))
+
+	target := setupSnapshotStore(t)
+	loaded, err := loadArchive(target, bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, snapshot, loaded)
+
+	_, chunks, err := target.Load(3, 2)
+	require.NoError(t, err)
+	var loadedChunks [][]byte
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		loadedChunks = append(loadedChunks, bz)
+	}
+	require.Equal(t, [][]byte{{3, 2, 0}, {3, 2, 1}, {3, 2, 2}}, loadedChunks)
+
+	// loading the archive again conflicts with the loaded snapshot
+	_, err = loadArchive(target, bytes.NewReader(archive.Bytes()))
+	require.Error(t, err)
+}
+
+func TestLoadArchiveMismatch(t *testing.T) {
+	source := setupSnapshotStore(t)
+	snapshot, err := source.Save(3, 2, makeChunks([]byte{3, 2, 0}, []byte{3, 2, 1}))
+	require.NoError(t, err)
+	bz, err := snapshot.Marshal()
+	require.NoError(t, err)
+
+	// archive the metadata of the snapshot with a tampered chunk
+	var archive bytes.Buffer
+	gzipWriter := gzip.NewWriter(&archive)
+	tarWriter := tar.NewWriter(gzipWriter)
+	require.NoError(t, writeArchiveFile(tarWriter, SnapshotFileName, bz))
+	require.NoError(t, writeArchiveFile(tarWriter, "0", []byte{3, 2, 0}))
+	require.NoError(t, writeArchiveFile(tarWriter, "1", []byte{9, 9, 9}))
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+
+	target := setupSnapshotStore(t)
+	_, err = loadArchive(target, &archive)
+	require.Error(t, err)
+
+	// the mismatching snapshot is not kept
+	stored, err := target.Get(3, 2)
+	require.NoError(t, err)
+	require.Nil(t, stored)
+}
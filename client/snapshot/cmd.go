@@ -0,0 +1,72 @@
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+// Cmd returns the snapshots group command, managing the snapshots of the
+// local snapshot store of a node.
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local snapshots",
+		Long: `Manage the snapshots of the local snapshot store of a node: list, export, delete,
+dump them to portable archives and load them back, and restore the application state
+from them without state syncing from a peer.`,
+		RunE: client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		ExportSnapshotCmd(appCreator),
+		DeleteSnapshotCmd(),
+		DumpArchiveCmd(),
+		LoadArchiveCmd(),
+		RestoreSnapshotCmd(appCreator),
+	)
+
+	return cmd
+}
+
+// parseHeightAndFormat parses the height and format arguments of a snapshot.
+func parseHeightAndFormat(args []string) (uint64, uint32, error) {
+	height, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid height %s: %w", args[0], err)
+	}
+
+	format, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid format %s: %w", args[1], err)
+	}
+
+	return height, uint32(format), nil
+}
+
+// openApp creates the application of the node, and returns its snapshot
+// manager along with its latest height.
+func openApp(cmd *cobra.Command, appCreator servertypes.AppCreator) (*snapshots.Manager, int64, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	db, err := server.OpenDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	app := appCreator(serverCtx.Logger, db, nil, serverCtx.Viper)
+	manager := app.SnapshotManager()
+	if manager == nil {
+		return nil, 0, fmt.Errorf("no snapshot store configured")
+	}
+
+	return manager, app.Info(abci.RequestInfo{}).LastBlockHeight, nil
+}
@@ -0,0 +1,30 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// DeleteSnapshotCmd returns the command to delete a local snapshot.
+func DeleteSnapshotCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [height] [format]",
+		Short: "Delete a local snapshot",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			return snapshotStore.Delete(height, format)
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+)
+
+const (
+	// SnapshotFileName is the name of the file of the snapshot metadata in
+	// the snapshot archives. The chunk files are named after their index.
+	SnapshotFileName = "snapshot"
+
+	flagOutput = "output"
+)
+
+// DumpArchiveCmd returns the command to dump a local snapshot to a portable
+// archive.
+func DumpArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [height] [format]",
+		Short: "Dump a local snapshot to a portable archive",
+		Long: `Dump a local snapshot to a gzipped tar archive, holding the snapshot metadata and
+its chunks, which can be loaded in the snapshot store of another node with load.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			output, _ := cmd.Flags().GetString(flagOutput)
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar.gz", height, format)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			if err := dumpArchive(snapshotStore, height, format, file); err != nil {
+				return err
+			}
+
+			cmd.Printf("Dumped snapshot at height %d, format %d to %s\n", height, format, output)
+			return file.Close()
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file (default: [height]-[format].tar.gz)")
+
+	return cmd
+}
+
+// dumpArchive writes a local snapshot to w as a gzipped tar archive.
+func dumpArchive(snapshotStore *snapshots.Store, height uint64, format uint32, w io.Writer) error {
+	snapshot, err := snapshotStore.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+	}
+
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		return err
+	}
+
+	// the chunks are already compressed, so the fastest compression is used
+	gzipWriter, err := gzip.NewWriterLevel(w, gzip.BestSpeed)
+	if err != nil {
+		return err
+	}
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if err := writeArchiveFile(tarWriter, SnapshotFileName, bz); err != nil {
+		return err
+	}
+
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(height, format, i)
+		if err != nil {
+			return err
+		}
+		if chunk == nil {
+			return fmt.Errorf("chunk %d of snapshot at height %d format %d not found", i, height, format)
+		}
+
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return err
+		}
+
+		if err := writeArchiveFile(tarWriter, strconv.FormatUint(uint64(i), 10), bz); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// writeArchiveFile writes a file to a tar archive.
+func writeArchiveFile(tarWriter *tar.Writer, name string, bz []byte) error {
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = tarWriter.Write(bz)
+	return err
+}
@@ -0,0 +1,44 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// ExportSnapshotCmd returns the command to create a snapshot of the
+// application state in the local snapshot store.
+func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the application state to a local snapshot",
+		Long: `Export the application state at a height to a local snapshot. The height must not
+be pruned, and must be above the height of the latest local snapshot.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, latestHeight, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+			if height <= 0 {
+				height = latestHeight
+			}
+
+			cmd.Printf("Exporting snapshot at height %d\n", height)
+			snapshot, err := manager.Create(uint64(height))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(server.FlagHeight, 0, "Height to export the state at (0 means latest height)")
+
+	return cmd
+}
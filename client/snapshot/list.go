@@ -0,0 +1,34 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ListSnapshotsCmd returns the command to list the local snapshots.
+func ListSnapshotsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List local snapshots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			snapshots, err := snapshotStore.List()
+			if err != nil {
+				return err
+			}
+
+			for _, snapshot := range snapshots {
+				cmd.Printf("height: %d, format: %d, chunks: %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// LoadArchiveCmd returns the command to load a snapshot archive in the local
+// snapshot store.
+func LoadArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "load [archive-file]",
+		Short: "Load a snapshot archive in the local snapshot store",
+		Long: `Load a snapshot archive created with dump in the local snapshot store. The chunks
+of the archive are verified against the snapshot metadata. The application state can
+then be restored from the snapshot with restore.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(serverCtx.Viper)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			snapshot, err := loadArchive(snapshotStore, file)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Loaded snapshot at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+}
+
+// loadArchive saves the snapshot of a gzipped tar archive read from r in the
+// snapshot store, deleting it if it does not match its archived metadata.
+func loadArchive(snapshotStore *snapshots.Store, r io.Reader) (*snapshottypes.Snapshot, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the snapshot archive: %w", err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	hdr, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the snapshot archive: %w", err)
+	}
+	if hdr.Name != SnapshotFileName {
+		return nil, fmt.Errorf("invalid snapshot archive, expected file %s, got %s", SnapshotFileName, hdr.Name)
+	}
+
+	bz, err := io.ReadAll(tarReader)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot snapshottypes.Snapshot
+	if err := snapshot.Unmarshal(bz); err != nil {
+		return nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+
+			hdr, err := tarReader.Next()
+			if err == nil && hdr.Name != strconv.FormatUint(uint64(i), 10) {
+				err = fmt.Errorf("invalid snapshot archive, expected chunk %d, got %s", i, hdr.Name)
+			}
+			if err == nil {
+				_, err = io.Copy(pw, tarReader)
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			pw.Close()
+		}
+	}()
+
+	saved, err := snapshotStore.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(saved.Hash, snapshot.Hash) || !reflect.DeepEqual(saved.Metadata, snapshot.Metadata) {
+		if err := snapshotStore.Delete(saved.Height, saved.Format); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("the chunks of the snapshot archive do not match its metadata")
+	}
+
+	return saved, nil
+}
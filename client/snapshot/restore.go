@@ -0,0 +1,38 @@
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreSnapshotCmd returns the command to restore the application state
+// from a local snapshot.
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [height] [format]",
+		Short: "Restore the application state from a local snapshot",
+		Long: `Restore the application state from a local snapshot, verifying its chunks against
+the chunk hashes of its metadata. The application state must be empty. Only the
+application state is restored; the Tendermint state of the node is left untouched.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			manager, _, err := openApp(cmd, appCreator)
+			if err != nil {
+				return err
+			}
+
+			if err := manager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+
+			cmd.Printf("Restored the application state at height %d\n", height)
+			return nil
+		},
+	}
+}
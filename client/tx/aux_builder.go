@@ -52,6 +52,14 @@ func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
 	b.auxSignerData.SignDoc.BodyBytes = nil
 }
 
+// SetUnordered sets the unordered field of the tx.
+func (b *AuxTxBuilder) SetUnordered(unordered bool) {
+	b.checkEmptyFields()
+
+	b.body.Unordered = unordered
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
 // SetMsgs sets an array of Msgs in the tx.
 func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
 	anys := make([]*codectypes.Any, len(msgs))
@@ -69,17 +69,18 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
-		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
+		// a dry run prints the state changes the tx would make
+		simRes, adjusted, err := simulateTx(clientCtx, txf, clientCtx.Simulate, msgs...)
 		if err != nil {
 			return err
 		}
 
 		txf = txf.WithGas(adjusted)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
-	}
 
-	if clientCtx.Simulate {
-		return nil
+		if clientCtx.Simulate {
+			return clientCtx.PrintProto(simRes)
+		}
 	}
 
 	tx, err := txf.BuildUnsignedTx(msgs...)
@@ -129,6 +130,14 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 // simulation response obtained by the query and the adjusted gas amount.
 func CalculateGas(
 	clientCtx gogogrpc.ClientConn, txf Factory, msgs ...sdk.Msg,
+) (*tx.SimulateResponse, uint64, error) {
+	return simulateTx(clientCtx, txf, false, msgs...)
+}
+
+// simulateTx simulates the execution of a transaction like CalculateGas, and
+// requests its state changes in the response if stateDiff is true.
+func simulateTx(
+	clientCtx gogogrpc.ClientConn, txf Factory, stateDiff bool, msgs ...sdk.Msg,
 ) (*tx.SimulateResponse, uint64, error) {
 	txBytes, err := txf.BuildSimTx(msgs...)
 	if err != nil {
@@ -137,7 +146,8 @@ func CalculateGas(
 
 	txSvcClient := tx.NewServiceClient(clientCtx)
 	simRes, err := txSvcClient.Simulate(context.Background(), &tx.SimulateRequest{
-		TxBytes: txBytes,
+		TxBytes:   txBytes,
+		StateDiff: stateDiff,
 	})
 	if err != nil {
 		return nil, 0, err
@@ -238,6 +248,12 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		signMode = txf.txConfig.SignModeHandler().DefaultMode()
 	}
 
+	// the legacy amino JSON sign bytes, also signed in EIP-191, do not cover
+	// the unordered field
+	if txf.unordered && (signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON || signMode == signing.SignMode_SIGN_MODE_EIP_191) {
+		return errors.New("unordered txs cannot be signed in legacy amino JSON or EIP-191 sign mode")
+	}
+
 	k, err := txf.keybase.Key(name)
 	if err != nil {
 		return err
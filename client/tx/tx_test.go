@@ -158,6 +158,33 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnorderedTx(t *testing.T) {
+	txConfig, _ := newTestTxConfig(t)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithAccountNumber(50).
+		WithChainID("test-chain").
+		WithUnordered(true)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	// unordered txs require a timeout height
+	_, err := txf.BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	// unordered txs cannot set a sequence
+	_, err = txf.WithTimeoutHeight(10).WithSequence(23).BuildUnsignedTx(msg)
+	require.Error(t, err)
+
+	txb, err := txf.WithTimeoutHeight(10).BuildUnsignedTx(msg)
+	require.NoError(t, err)
+
+	unorderedTx := txb.GetTx().(sdk.TxWithUnordered)
+	require.True(t, unorderedTx.GetUnordered())
+	require.Equal(t, uint64(10), unorderedTx.GetTimeoutHeight())
+}
+
 func TestSign(t *testing.T) {
 	txConfig, cdc := newTestTxConfig(t)
 	requireT := require.New(t)
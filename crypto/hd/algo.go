@@ -3,6 +3,7 @@ package hd
 import (
 	"github.com/cosmos/go-bip39"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -15,6 +16,9 @@ const (
 	MultiType = PubKeyType("multi")
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
+	// EthSecp256k1Type uses the Ethereum secp256k1 ECDSA parameters, with
+	// keccak256 addresses and signatures.
+	EthSecp256k1Type = PubKeyType("eth_secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
 	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
@@ -25,6 +29,11 @@ const (
 // Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 var Secp256k1 = secp256k1Algo{}
 
+// EthSecp256k1 uses the Ethereum secp256k1 ECDSA parameters. Keys are derived
+// as secp256k1 ones, so that the keys of Ethereum wallets are recovered from
+// their mnemonic with the Ethereum BIP44 coin type, 60.
+var EthSecp256k1 = ethSecp256k1Algo{}
+
 type (
 	DeriveFn   func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
 	GenerateFn func(bz []byte) types.PrivKey
@@ -68,3 +77,24 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ethSecp256k1Algo struct{}
+
+func (s ethSecp256k1Algo) Name() PubKeyType {
+	return EthSecp256k1Type
+}
+
+// Derive derives and returns the eth_secp256k1 private key for the given seed and HD path.
+func (s ethSecp256k1Algo) Derive() DeriveFn {
+	return Secp256k1.Derive()
+}
+
+// Generate generates an eth_secp256k1 private key from the given bytes.
+func (s ethSecp256k1Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		bzArr := make([]byte, ethsecp256k1.PrivKeySize)
+		copy(bzArr, bz)
+
+		return &ethsecp256k1.PrivKey{Key: bzArr}
+	}
+}
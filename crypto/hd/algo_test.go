@@ -1,6 +1,7 @@
 package hd_test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -13,4 +14,18 @@ func TestDefaults(t *testing.T) {
 	require.Equal(t, hd.PubKeyType("secp256k1"), hd.Secp256k1Type)
 	require.Equal(t, hd.PubKeyType("ed25519"), hd.Ed25519Type)
 	require.Equal(t, hd.PubKeyType("sr25519"), hd.Sr25519Type)
+	require.Equal(t, hd.PubKeyType("eth_secp256k1"), hd.EthSecp256k1Type)
+}
+
+func TestEthSecp256k1Derive(t *testing.T) {
+	// first account of the default Hardhat mnemonic, on the Ethereum BIP44 path
+	mnemonic := "test test test test test test test test test test test junk"
+	path := hd.NewFundraiserParams(0, 60, 0).String()
+
+	bz, err := hd.EthSecp256k1.Derive()(mnemonic, "", path)
+	require.NoError(t, err)
+
+	privKey := hd.EthSecp256k1.Generate()(bz)
+	require.Equal(t, "eth_secp256k1", privKey.Type())
+	require.Equal(t, "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", hex.EncodeToString(privKey.PubKey().Address()))
 }
@@ -47,3 +47,14 @@ func (sal SigningAlgoList) String() string {
 
 	return strings.Join(names, ",")
 }
+
+// EthSecp256k1Option is an Option enabling the eth_secp256k1 signing algorithm,
+// along with the default ones, so that the keys of Ethereum wallets such as
+// MetaMask can be imported in the keyring.
+func EthSecp256k1Option() Option {
+	return func(options *Options) {
+		if !options.SupportedAlgos.Contains(hd.EthSecp256k1) {
+			options.SupportedAlgos = append(options.SupportedAlgos, hd.EthSecp256k1)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestNewSigningAlgoByString(t *testing.T) {
@@ -72,3 +73,25 @@ func (n notSupportedAlgo) Derive() hd.DeriveFn {
 func (n notSupportedAlgo) Generate() hd.GenerateFn {
 	return hd.Secp256k1.Generate()
 }
+
+func TestEthSecp256k1Option(t *testing.T) {
+	kb := NewInMemory(getCodec())
+	_, _, err := kb.NewMnemonic("eth", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.Error(t, err)
+
+	kb = NewInMemory(getCodec(), EthSecp256k1Option(), EthSecp256k1Option())
+	supported, _ := kb.SupportedAlgorithms()
+	require.Equal(t, SigningAlgoList{hd.Secp256k1, hd.EthSecp256k1}, supported)
+
+	k, _, err := kb.NewMnemonic("eth", English, hd.NewFundraiserParams(0, 60, 0).String(), DefaultBIP39Passphrase, hd.EthSecp256k1)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, string(hd.EthSecp256k1Type), pubKey.Type())
+
+	msg := []byte("some message")
+	sig, signer, err := kb.Sign("eth", msg)
+	require.NoError(t, err)
+	require.Equal(t, pubKey, signer)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+}
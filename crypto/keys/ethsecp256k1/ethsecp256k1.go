@@ -0,0 +1,241 @@
+// Package ethsecp256k1 implements Ethereum style secp256k1 keys, as used by
+// wallets such as MetaMask: the address of a key is derived with keccak256, and
+// messages are hashed with keccak256 and signed with a recoverable signature.
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	// PrivKeySize is the size of a private key, in bytes.
+	PrivKeySize = 32
+	// SignatureSize is the size of a signature, in bytes. Signatures are of
+	// the form R || S || V, where V is the recovery id.
+	SignatureSize = 65
+
+	keyType     = "eth_secp256k1"
+	PrivKeyName = "cosmos/PrivKeyEthSecp256k1"
+	PubKeyName  = "cosmos/PubKeyEthSecp256k1"
+)
+
+// used to reject malleable signatures, as done by the secp256k1 keys.
+var secp256k1halfN = new(big.Int).Rsh(secp256k1.S256().N, 1)
+
+// Keccak256 returns the keccak256 hash of the given data, as used by Ethereum.
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz) // does not error
+	}
+
+	return hasher.Sum(nil)
+}
+
+// GenPrivKey generates a new Ethereum style secp256k1 private key. It uses OS
+// randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	priv, err := secp256k1.NewPrivateKey(secp256k1.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	return &PrivKey{Key: paddedBytes(priv.D)}
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	_, pubkeyObject := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey.Key)
+	return &PubKey{Key: pubkeyObject.SerializeCompressed()}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign creates a recoverable ECDSA signature on curve Secp256k1, using
+// keccak256 on the msg. The returned signature will be of the form R || S || V
+// (in lower-S form), where V is the recovery id, 0 or 1.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), privKey.Key)
+
+	// the compact signature is of the form V || R || S, with V = 27 + recovery id
+	compactSig, err := secp256k1.SignCompact(secp256k1.S256(), priv, Keccak256(msg), false)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := make([]byte, SignatureSize)
+	copy(sig, compactSig[1:])
+	sig[SignatureSize-1] = compactSig[0] - 27
+
+	return sig, nil
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address returns an Ethereum style address: the last 20 bytes of the
+// keccak256 hash of the uncompressed pubkey, without its 0x04 prefix.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	pub, err := secp256k1.ParsePubKey(pubKey.Key, secp256k1.S256())
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.Address(Keccak256(pub.SerializeUncompressed()[1:])[12:])
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the form R || S || V over the
+// keccak256 hash of msg. V may either be the recovery id, or the recovery id
+// plus 27 as returned by Ethereum wallets. It rejects signatures which are not
+// in lower-S form.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	v := sig[SignatureSize-1]
+	if v >= 27 {
+		v -= 27
+	}
+	if v > 1 {
+		return false
+	}
+
+	// Reject malleable signatures, as done by the secp256k1 keys.
+	if new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1halfN) > 0 {
+		return false
+	}
+
+	compactSig := make([]byte, SignatureSize)
+	compactSig[0] = 27 + v
+	copy(compactSig[1:], sig[:64])
+
+	recovered, _, err := secp256k1.RecoverCompact(secp256k1.S256(), compactSig, Keccak256(msg))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(recovered.SerializeCompressed(), pubKey.Key)
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errors.Wrap(errors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// paddedBytes returns the big-endian bytes of i, left-padded to PrivKeySize.
+func paddedBytes(i *big.Int) []byte {
+	bz := i.Bytes()
+	padded := make([]byte, PrivKeySize)
+	copy(padded[PrivKeySize-len(bz):], bz)
+
+	return padded
+}
@@ -0,0 +1,116 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// test vector of the web3.js documentation of web3.eth.accounts.sign.
+const (
+	testPrivKey   = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testAddress   = "2c7536e3605d9c16a7a3d7b1898e529396a65c23"
+	testMsg       = "\x19Ethereum Signed Message:\n9Some data"
+	testSignature = "b91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c"
+)
+
+func TestAddress(t *testing.T) {
+	privKey := &ethsecp256k1.PrivKey{Key: mustDecodeHex(t, testPrivKey)}
+	require.Equal(t, testAddress, hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func TestSignAndVerifySignature(t *testing.T) {
+	privKey := &ethsecp256k1.PrivKey{Key: mustDecodeHex(t, testPrivKey)}
+	pubKey := privKey.PubKey()
+
+	// signature of an Ethereum wallet, with V = 27 + recovery id
+	walletSig := mustDecodeHex(t, testSignature)
+	require.True(t, pubKey.VerifySignature([]byte(testMsg), walletSig))
+
+	// the signatures are deterministic, and V is the recovery id
+	sig, err := privKey.Sign([]byte(testMsg))
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.Equal(t, walletSig[:64], sig[:64])
+	require.Equal(t, walletSig[64]-27, sig[64])
+	require.True(t, pubKey.VerifySignature([]byte(testMsg), sig))
+
+	// wrong message, recovery id and signer
+	require.False(t, pubKey.VerifySignature([]byte("Some data"), sig))
+	wrongV := append([]byte{}, sig...)
+	wrongV[64] ^= 1
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), wrongV))
+	wrongV[64] = 2
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), wrongV))
+	require.False(t, ethsecp256k1.GenPrivKey().PubKey().VerifySignature([]byte(testMsg), sig))
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), sig[:64]))
+
+	// the secp256k1 keys do not hash the message with keccak256
+	secpPrivKey := &secp256k1.PrivKey{Key: privKey.Key}
+	secpSig, err := secpPrivKey.Sign([]byte(testMsg))
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), append(secpSig, 0)))
+	require.False(t, pubKey.VerifySignature([]byte(testMsg), append(secpSig, 1)))
+}
+
+func TestGenPrivKey(t *testing.T) {
+	msg := []byte("We have lingered long enough on the shores of the cosmic ocean.")
+	for i := 0; i < 100; i++ {
+		privKey := ethsecp256k1.GenPrivKey()
+		require.Len(t, privKey.Key, ethsecp256k1.PrivKeySize)
+
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+	}
+}
+
+func TestEquals(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	secpPrivKey := &secp256k1.PrivKey{Key: privKey.Key}
+
+	require.True(t, privKey.Equals(&ethsecp256k1.PrivKey{Key: privKey.Key}))
+	require.False(t, privKey.Equals(secpPrivKey))
+	require.True(t, privKey.PubKey().Equals(&ethsecp256k1.PubKey{Key: privKey.PubKey().Bytes()}))
+	require.False(t, privKey.PubKey().Equals(secpPrivKey.PubKey()))
+	require.NotEqual(t, privKey.PubKey().Address(), secpPrivKey.PubKey().Address())
+}
+
+func TestAminoMarshal(t *testing.T) {
+	cdc := codec.NewLegacyAmino()
+	cdc.RegisterInterface((*cryptotypes.PubKey)(nil), nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PubKey{}, ethsecp256k1.PubKeyName, nil)
+	cdc.RegisterInterface((*cryptotypes.PrivKey)(nil), nil)
+	cdc.RegisterConcrete(&ethsecp256k1.PrivKey{}, ethsecp256k1.PrivKeyName, nil)
+
+	privKey := ethsecp256k1.GenPrivKey()
+	for _, key := range []interface{}{privKey, privKey.PubKey()} {
+		bz, err := cdc.Marshal(key)
+		require.NoError(t, err)
+
+		var decoded interface{}
+		switch key.(type) {
+		case *ethsecp256k1.PrivKey:
+			decoded = &ethsecp256k1.PrivKey{}
+		default:
+			decoded = &ethsecp256k1.PubKey{}
+		}
+		require.NoError(t, cdc.Unmarshal(bz, decoded))
+		require.Equal(t, key, decoded)
+	}
+
+	require.Error(t, (&ethsecp256k1.PubKey{}).UnmarshalAmino([]byte{1, 2, 3}))
+	require.Error(t, (&ethsecp256k1.PrivKey{}).UnmarshalAmino([]byte{1, 2, 3}))
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
@@ -0,0 +1,74 @@
+package pebbledb
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+type pebbleBatch struct {
+	batch  *pebble.Batch
+	mgr    *dbManager
+	writes map[string]struct{}
+}
+
+var _ db.Writer = (*pebbleBatch)(nil)
+
+func (mgr *dbManager) newPebbleBatch() *pebbleBatch {
+	return &pebbleBatch{
+		batch:  mgr.current.NewBatch(),
+		mgr:    mgr,
+		writes: map[string]struct{}{},
+	}
+}
+
+// Set implements Writer.
+func (b *pebbleBatch) Set(key, value []byte) error {
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	b.writes[string(key)] = struct{}{}
+	return b.batch.Set(key, value, nil)
+}
+
+// Delete implements Writer.
+func (b *pebbleBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	b.writes[string(key)] = struct{}{}
+	return b.batch.Delete(key, nil)
+}
+
+// Write implements Writer.
+func (b *pebbleBatch) Commit() (err error) {
+	if b.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	defer func() { err = dbutil.CombineErrors(err, b.Discard(), "Discard also failed") }()
+	// A write-only batch can't conflict, but its writes must be visible to open transactions
+	err = b.mgr.conflicts.commit(0, nil, b.writes, func() error {
+		return b.batch.Commit(pebble.NoSync)
+	})
+	return
+}
+
+// Close implements Writer.
+func (b *pebbleBatch) Discard() error {
+	if b.batch != nil {
+		defer atomic.AddInt32(&b.mgr.openWriters, -1)
+		err := b.batch.Close()
+		b.batch = nil
+		return err
+	}
+	return nil
+}
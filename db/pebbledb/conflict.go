@@ -0,0 +1,88 @@
+package pebbledb
+
+import "sync"
+
+// conflictTracker provides optimistic concurrency control for transactions, since Pebble batches
+// have no native conflict detection. Each ReadWriter records the keys it reads; on commit, it
+// fails if any transaction which committed after it was opened has written one of them.
+type conflictTracker struct {
+	mtx    sync.Mutex
+	lastTs uint64
+	// write sets of committed transactions, ordered by commit timestamp
+	committed []committedTxn
+	// number of open transactions by read timestamp
+	readers map[uint64]int
+}
+
+type committedTxn struct {
+	ts     uint64
+	writes map[string]struct{}
+}
+
+// begin registers a new transaction and returns its read timestamp.
+func (ct *conflictTracker) begin() uint64 {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	ct.readers[ct.lastTs]++
+	return ct.lastTs
+}
+
+// end unregisters a transaction opened at readTs.
+func (ct *conflictTracker) end(readTs uint64) {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	ct.readers[readTs]--
+	if ct.readers[readTs] <= 0 {
+		delete(ct.readers, readTs)
+	}
+	ct.cleanup()
+}
+
+// commit checks the keys read by a transaction opened at readTs against those written since,
+// then calls write and records the transaction's own writes.
+func (ct *conflictTracker) commit(
+	readTs uint64, reads, writes map[string]struct{}, write func() error,
+) error {
+	ct.mtx.Lock()
+	defer ct.mtx.Unlock()
+	if len(reads) != 0 {
+		for _, txn := range ct.committed {
+			if txn.ts <= readTs {
+				continue
+			}
+			for key := range reads {
+				if _, has := txn.writes[key]; has {
+					return ErrTransactionConflict
+				}
+			}
+		}
+	}
+	if err := write(); err != nil {
+		return err
+	}
+	if len(writes) != 0 {
+		ct.lastTs++
+		ct.committed = append(ct.committed, committedTxn{ts: ct.lastTs, writes: writes})
+		ct.cleanup()
+	}
+	return nil
+}
+
+// cleanup discards write sets which can no longer conflict with any open transaction.
+func (ct *conflictTracker) cleanup() {
+	if len(ct.readers) == 0 {
+		ct.committed = nil
+		return
+	}
+	minTs := ct.lastTs
+	for ts := range ct.readers {
+		if ts < minTs {
+			minTs = ts
+		}
+	}
+	i := 0
+	for i < len(ct.committed) && ct.committed[i].ts <= minTs {
+		i++
+	}
+	ct.committed = ct.committed[i:]
+}
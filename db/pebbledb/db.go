@@ -0,0 +1,519 @@
+package pebbledb
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+var (
+	currentDBFileName    string = "current.db"
+	checkpointFileFormat string = "%020d.db"
+)
+
+var (
+	_ db.Connection = (*PebbleDB)(nil)
+	_ db.Reader     = (*dbTxn)(nil)
+	_ db.Writer     = (*dbWriter)(nil)
+	_ db.ReadWriter = (*dbWriter)(nil)
+)
+
+// ErrTransactionConflict is returned when committing a transaction which read a key written by
+// another transaction committed after it was opened.
+var ErrTransactionConflict = errors.New("transaction conflict")
+
+// PebbleDB is a connection to a PebbleDB key-value database.
+type PebbleDB = dbManager
+
+type dbManager struct {
+	current *pebble.DB
+	dir     string
+	opts    *pebble.Options
+	vmgr    *db.VersionManager
+	mtx     sync.RWMutex
+	// Track open DBWriters
+	openWriters int32
+	cpCache     checkpointCache
+	conflicts   conflictTracker
+}
+
+type checkpointCache struct {
+	cache map[uint64]*cpCacheEntry
+	mtx   sync.RWMutex
+}
+
+type cpCacheEntry struct {
+	cxn       *pebble.DB
+	openCount uint
+}
+
+type dbTxn struct {
+	reader  pebble.Reader
+	mgr     *dbManager
+	version uint64
+	// closer releases the reader, nil if it is not owned by the transaction
+	closer io.Closer
+}
+
+type dbWriter struct {
+	dbTxn
+	batch  *pebble.Batch
+	readTs uint64
+	reads  map[string]struct{}
+	writes map[string]struct{}
+}
+
+// NewDB creates a new PebbleDB key-value database inside the given directory.
+// If dir does not exist, it will be created.
+func NewDB(dir string) (*dbManager, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	opts := &pebble.Options{}
+	opts.EnsureDefaults()
+
+	mgr := &dbManager{
+		dir:       dir,
+		opts:      opts,
+		cpCache:   checkpointCache{cache: map[uint64]*cpCacheEntry{}},
+		conflicts: conflictTracker{readers: map[uint64]int{}},
+	}
+
+	err := os.MkdirAll(mgr.checkpointsDir(), 0o755)
+	if err != nil {
+		return nil, err
+	}
+	if mgr.vmgr, err = readVersions(mgr.checkpointsDir()); err != nil {
+		return nil, err
+	}
+	dbPath := filepath.Join(dir, currentDBFileName)
+	// if the current db file is missing but there are checkpoints, restore it
+	if mgr.vmgr.Count() > 0 {
+		if _, err = os.Stat(dbPath); os.IsNotExist(err) {
+			err = mgr.restoreFromCheckpoint(mgr.vmgr.Last(), dbPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+func (mgr *dbManager) checkpointsDir() string {
+	return filepath.Join(mgr.dir, "checkpoints")
+}
+
+// Reads directory for checkpoints files
+func readVersions(dir string) (*db.VersionManager, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []uint64
+	for _, f := range files {
+		var version uint64
+		if _, err := fmt.Sscanf(f.Name(), checkpointFileFormat, &version); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return db.NewVersionManager(versions), nil
+}
+
+func (mgr *dbManager) checkpointPath(version uint64) (string, error) {
+	dbPath := filepath.Join(mgr.checkpointsDir(), fmt.Sprintf(checkpointFileFormat, version))
+	if stat, err := os.Stat(dbPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = db.ErrVersionDoesNotExist
+		}
+		return "", err
+	} else if !stat.IsDir() {
+		return "", db.ErrVersionDoesNotExist
+	}
+	return dbPath, nil
+}
+
+func (mgr *dbManager) openCheckpoint(version uint64) (*pebble.DB, error) {
+	mgr.cpCache.mtx.Lock()
+	defer mgr.cpCache.mtx.Unlock()
+	cp, has := mgr.cpCache.cache[version]
+	if has {
+		cp.openCount += 1
+		return cp.cxn, nil
+	}
+	dbPath, err := mgr.checkpointPath(version)
+	if err != nil {
+		return nil, err
+	}
+	opts := mgr.opts.Clone()
+	opts.ReadOnly = true
+	d, err := pebble.Open(dbPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	mgr.cpCache.cache[version] = &cpCacheEntry{cxn: d, openCount: 1}
+	return d, nil
+}
+
+func (mgr *dbManager) Reader() db.Reader {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	snapshot := mgr.current.NewSnapshot()
+	return &dbTxn{
+		reader: snapshot,
+		mgr:    mgr,
+		closer: snapshot,
+	}
+}
+
+func (mgr *dbManager) ReaderAt(version uint64) (db.Reader, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	d, err := mgr.openCheckpoint(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbTxn{
+		reader:  d,
+		mgr:     mgr,
+		version: version,
+	}, nil
+}
+
+func (mgr *dbManager) ReadWriter() db.ReadWriter {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	batch := mgr.current.NewIndexedBatch()
+	return &dbWriter{
+		dbTxn: dbTxn{
+			reader: batch,
+			mgr:    mgr,
+		},
+		batch:  batch,
+		readTs: mgr.conflicts.begin(),
+		reads:  map[string]struct{}{},
+		writes: map[string]struct{}{},
+	}
+}
+
+func (mgr *dbManager) Writer() db.Writer {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	return mgr.newPebbleBatch()
+}
+
+func (mgr *dbManager) Versions() (db.VersionSet, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	return mgr.vmgr, nil
+}
+
+// SaveNextVersion implements Connection.
+func (mgr *dbManager) SaveNextVersion() (uint64, error) {
+	return mgr.save(0)
+}
+
+// SaveVersion implements Connection.
+func (mgr *dbManager) SaveVersion(target uint64) error {
+	if target == 0 {
+		return db.ErrInvalidVersion
+	}
+	_, err := mgr.save(target)
+	return err
+}
+
+func (mgr *dbManager) save(target uint64) (uint64, error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if mgr.openWriters > 0 {
+		return 0, db.ErrOpenTransactions
+	}
+	newVmgr := mgr.vmgr.Copy()
+	target, err := newVmgr.Save(target)
+	if err != nil {
+		return 0, err
+	}
+	dir := filepath.Join(mgr.checkpointsDir(), fmt.Sprintf(checkpointFileFormat, target))
+	if err := mgr.current.Checkpoint(dir, pebble.WithFlushedWAL()); err != nil {
+		return 0, err
+	}
+	mgr.vmgr = newVmgr
+	return target, nil
+}
+
+func (mgr *dbManager) DeleteVersion(ver uint64) error {
+	if mgr.cpCache.has(ver) {
+		return db.ErrOpenTransactions
+	}
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	dbPath, err := mgr.checkpointPath(ver)
+	if err != nil {
+		return err
+	}
+	mgr.vmgr = mgr.vmgr.Copy()
+	mgr.vmgr.Delete(ver)
+	return os.RemoveAll(dbPath)
+}
+
+func (mgr *dbManager) Revert() (err error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	if mgr.openWriters > 0 {
+		return db.ErrOpenTransactions
+	}
+	// Close current connection and replace it with a copy of the last checkpoint
+	if err = mgr.current.Close(); err != nil {
+		return
+	}
+	dbPath := filepath.Join(mgr.dir, currentDBFileName)
+	err = os.RemoveAll(dbPath)
+	if err != nil {
+		return
+	}
+	if last := mgr.vmgr.Last(); last != 0 {
+		err = mgr.restoreFromCheckpoint(last, dbPath)
+		if err != nil {
+			return
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, mgr.opts)
+	return
+}
+
+// restoreFromCheckpoint copies the checkpoint of a version to path. As pebble never modifies
+// its table files, these are hard linked instead of copied when possible.
+func (mgr *dbManager) restoreFromCheckpoint(version uint64, path string) error {
+	cpPath, err := mgr.checkpointPath(version)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return err
+	}
+	files, err := os.ReadDir(cpPath)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		src, dst := filepath.Join(cpPath, f.Name()), filepath.Join(path, f.Name())
+		if strings.HasSuffix(f.Name(), ".sst") && os.Link(src, dst) == nil {
+			continue
+		}
+		if err := copyFile(src, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() { err = dbutil.CombineErrors(err, out.Close(), "Close also failed") }()
+	_, err = io.Copy(out, in)
+	return
+}
+
+// Close implements Connection.
+func (mgr *dbManager) Close() error {
+	mgr.cpCache.mtx.Lock()
+	defer mgr.cpCache.mtx.Unlock()
+	for ver, cp := range mgr.cpCache.cache {
+		cp.cxn.Close()
+		delete(mgr.cpCache.cache, ver)
+	}
+	return mgr.current.Close()
+}
+
+// Stats implements Connection.
+func (mgr *dbManager) Stats() map[string]string {
+	return map[string]string{"pebble.stats": mgr.current.Metrics().String()}
+}
+
+// Get implements Reader.
+func (tx *dbTxn) Get(key []byte) ([]byte, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	res, closer, err := tx.reader.Get(key)
+	if errors.Is(err, pebble.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+	return copyBytes(res), nil
+}
+
+// Get implements Reader. The key is recorded to detect write conflicts on commit.
+func (tx *dbWriter) Get(key []byte) ([]byte, error) {
+	res, err := tx.dbTxn.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	tx.reads[string(key)] = struct{}{}
+	return res, nil
+}
+
+// Has implements DBReader.
+func (tx *dbTxn) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Has implements DBReader.
+func (tx *dbWriter) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Set implements DBWriter.
+func (tx *dbWriter) Set(key []byte, value []byte) error {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	tx.writes[string(key)] = struct{}{}
+	return tx.batch.Set(key, value, nil)
+}
+
+// Delete implements DBWriter.
+func (tx *dbWriter) Delete(key []byte) error {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	tx.writes[string(key)] = struct{}{}
+	return tx.batch.Delete(key, nil)
+}
+
+func (tx *dbWriter) Commit() (err error) {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	defer func() { err = dbutil.CombineErrors(err, tx.Discard(), "Discard also failed") }()
+	err = tx.mgr.conflicts.commit(tx.readTs, tx.reads, tx.writes, func() error {
+		return tx.batch.Commit(pebble.NoSync)
+	})
+	return
+}
+
+func (tx *dbTxn) Discard() error {
+	if tx.reader == nil {
+		return nil // Discard() is idempotent
+	}
+	defer func() { tx.reader = nil }()
+	if tx.closer != nil {
+		return tx.closer.Close()
+	}
+	if tx.version == 0 {
+		return nil
+	}
+	if !tx.mgr.cpCache.decrement(tx.version) {
+		return fmt.Errorf("transaction has no corresponding checkpoint cache entry: %v", tx.version)
+	}
+	return nil
+}
+
+func (tx *dbWriter) Discard() error {
+	if tx.batch == nil {
+		return nil
+	}
+	defer atomic.AddInt32(&tx.mgr.openWriters, -1)
+	tx.mgr.conflicts.end(tx.readTs)
+	err := tx.batch.Close()
+	tx.batch, tx.reader = nil, nil
+	return err
+}
+
+// Iterator implements DBReader.
+func (tx *dbTxn) Iterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	itr := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return newPebbleIterator(itr, start, end, false), nil
+}
+
+// ReverseIterator implements DBReader.
+func (tx *dbTxn) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	itr := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return newPebbleIterator(itr, start, end, true), nil
+}
+
+func (cpc *checkpointCache) has(ver uint64) bool {
+	cpc.mtx.RLock()
+	defer cpc.mtx.RUnlock()
+	_, has := cpc.cache[ver]
+	return has
+}
+
+func (cpc *checkpointCache) decrement(ver uint64) bool {
+	cpc.mtx.Lock()
+	defer cpc.mtx.Unlock()
+	cp, has := cpc.cache[ver]
+	if !has {
+		return false
+	}
+	cp.openCount -= 1
+	if cp.openCount == 0 {
+		cp.cxn.Close()
+		delete(cpc.cache, ver)
+	}
+	return true
+}
+
+func copyBytes(bz []byte) []byte {
+	ret := make([]byte, len(bz))
+	copy(ret, bz)
+	return ret
+}
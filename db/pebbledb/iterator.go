@@ -0,0 +1,85 @@
+package pebbledb
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+)
+
+type pebbleIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	isReverse  bool
+	// Whether iterator has been advanced to the first element (is fully initialized)
+	primed bool
+	valid  bool
+}
+
+var _ db.Iterator = (*pebbleIterator)(nil)
+
+// newPebbleIterator wraps a source iterator, which must be bounded by the domain [start, end).
+func newPebbleIterator(source *pebble.Iterator, start, end []byte, isReverse bool) *pebbleIterator {
+	return &pebbleIterator{
+		source:    source,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}
+}
+
+// Domain implements Iterator.
+func (itr *pebbleIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *pebbleIterator) Valid() bool {
+	return itr.primed && itr.valid
+}
+
+// Key implements Iterator.
+func (itr *pebbleIterator) Key() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.source.Key())
+}
+
+// Value implements Iterator.
+func (itr *pebbleIterator) Value() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.source.Value())
+}
+
+// Next implements Iterator.
+func (itr *pebbleIterator) Next() bool {
+	if !itr.primed {
+		itr.primed = true
+		if itr.isReverse {
+			itr.valid = itr.source.Last()
+		} else {
+			itr.valid = itr.source.First()
+		}
+	} else if itr.valid {
+		if itr.isReverse {
+			itr.valid = itr.source.Prev()
+		} else {
+			itr.valid = itr.source.Next()
+		}
+	}
+	return itr.valid
+}
+
+// Error implements Iterator.
+func (itr *pebbleIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *pebbleIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *pebbleIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
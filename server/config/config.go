@@ -33,6 +33,18 @@ const (
 	// DefaultGRPCMaxSendMsgSize defines the default gRPC max message size in
 	// bytes the server can send.
 	DefaultGRPCMaxSendMsgSize = math.MaxInt32
+
+	// DefaultVersionedDBBackend defines the default backend of the versioned application database.
+	DefaultVersionedDBBackend = VersionedDBBackendPebbleDB
+)
+
+// Backends of the versioned application database. RocksDB is only available
+// in binaries built with the rocksdb_build tag.
+const (
+	VersionedDBBackendMemDB    = "memdb"
+	VersionedDBBackendBadgerDB = "badgerdb"
+	VersionedDBBackendPebbleDB = "pebbledb"
+	VersionedDBBackendRocksDB  = "rocksdb"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -87,6 +99,9 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// VersionedDBBackend defines the backend of the versioned application database.
+	VersionedDBBackend string `mapstructure:"versioned-db-backend"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -190,6 +205,17 @@ type StateSyncConfig struct {
 	// SnapshotKeepRecent sets the number of recent state sync snapshots to keep.
 	// 0 keeps all snapshots.
 	SnapshotKeepRecent uint32 `mapstructure:"snapshot-keep-recent"`
+
+	// SnapshotParallel takes snapshots in the parallel format, exporting and
+	// restoring substores concurrently.
+	SnapshotParallel bool `mapstructure:"snapshot-parallel"`
+}
+
+// StateArchiveConfig defines the state archive configuration.
+type StateArchiveConfig struct {
+	// Enable records the committed state in a versioned database, from which the
+	// queries at the heights pruned from the application database are served.
+	Enable bool `mapstructure:"enable"`
 }
 
 // Config defines the server's top level configuration
@@ -203,6 +229,8 @@ type Config struct {
 	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
 	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+
+	StateArchive StateArchiveConfig `mapstructure:"state-archive"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -236,15 +264,16 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      defaultMinGasPrices,
-			InterBlockCache:   true,
-			Pruning:           pruningtypes.PruningOptionDefault,
-			PruningKeepRecent: "0",
-			PruningInterval:   "0",
-			MinRetainBlocks:   0,
-			IndexEvents:       make([]string, 0),
-			IAVLCacheSize:     781250, // 50 MB
-			AppDBBackend:      "",
+			MinGasPrices:       defaultMinGasPrices,
+			InterBlockCache:    true,
+			Pruning:            pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:  "0",
+			PruningInterval:    "0",
+			MinRetainBlocks:    0,
+			IndexEvents:        make([]string, 0),
+			IAVLCacheSize:      781250, // 50 MB
+			AppDBBackend:       "",
+			VersionedDBBackend: DefaultVersionedDBBackend,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -299,17 +328,18 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
-			IAVLCacheSize:     v.GetUint64("iavl-cache-size"),
-			AppDBBackend:      v.GetString("app-db-backend"),
+			MinGasPrices:       v.GetString("minimum-gas-prices"),
+			InterBlockCache:    v.GetBool("inter-block-cache"),
+			Pruning:            v.GetString("pruning"),
+			PruningKeepRecent:  v.GetString("pruning-keep-recent"),
+			PruningInterval:    v.GetString("pruning-interval"),
+			HaltHeight:         v.GetUint64("halt-height"),
+			HaltTime:           v.GetUint64("halt-time"),
+			IndexEvents:        v.GetStringSlice("index-events"),
+			MinRetainBlocks:    v.GetUint64("min-retain-blocks"),
+			IAVLCacheSize:      v.GetUint64("iavl-cache-size"),
+			AppDBBackend:       v.GetString("app-db-backend"),
+			VersionedDBBackend: v.GetString("versioned-db-backend"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -355,6 +385,10 @@ func GetConfig(v *viper.Viper) Config {
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
+			SnapshotParallel:   v.GetBool("state-sync.snapshot-parallel"),
+		},
+		StateArchive: StateArchiveConfig{
+			Enable: v.GetBool("state-archive.enable"),
 		},
 	}
 }
@@ -369,6 +403,11 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	switch c.VersionedDBBackend {
+	case "", VersionedDBBackendMemDB, VersionedDBBackendBadgerDB, VersionedDBBackendPebbleDB, VersionedDBBackendRocksDB:
+	default:
+		return sdkerrors.ErrAppConfig.Wrapf("unknown versioned-db-backend %q", c.VersionedDBBackend)
+	}
 
 	return nil
 }
@@ -23,6 +23,14 @@ func TestSetMinimumFees(t *testing.T) {
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
 
+func TestValidateVersionedDBBackend(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+	cfg.VersionedDBBackend = "leveldb"
+	require.Error(t, cfg.ValidateBasic())
+}
+
 func TestIndexEventsMarshalling(t *testing.T) {
 	expectedIn := `index-events = ["key1", "key2", ]` + "\n"
 	cfg := DefaultConfig()
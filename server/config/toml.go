@@ -80,6 +80,11 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Second fallback (if the types.DBBackend also isn't set), is the db-backend value set in Tendermint's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# VersionedDBBackend defines the backend of the versioned application database.
+# Valid options are "memdb", "badgerdb", "pebbledb" and "rocksdb" (the latter
+# requires a binary built with the rocksdb_build tag).
+versioned-db-backend = "{{ .BaseConfig.VersionedDBBackend }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -231,6 +236,23 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+# snapshot-parallel takes snapshots in a format whose substores are exported and restored
+# concurrently. Nodes running older versions cannot restore snapshots in this format.
+snapshot-parallel = {{ .StateSync.SnapshotParallel }}
+
+###############################################################################
+###                        State Archive Configuration                      ###
+###############################################################################
+
+# The state archive records the committed state in a versioned database, using the
+# versioned-db-backend, from which the queries at heights pruned from the application
+# database are served, without proofs. The archive keeps every height it records.
+[state-archive]
+
+# enable records the state from the current height on, and serves the queries of the
+# recorded heights once they are pruned.
+enable = {{ .StateArchive.Enable }}
 `
 
 var configTemplate *template.Template
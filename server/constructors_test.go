@@ -7,12 +7,24 @@ import (
 	"github.com/stretchr/testify/require"
 
 	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
 )
 
-func Test_openDB(t *testing.T) {
+func Test_OpenDB(t *testing.T) {
+	t.Parallel()
+	_, err := OpenDB(t.TempDir(), dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+}
+
+func Test_OpenVersionedDB(t *testing.T) {
 	t.Parallel()
-	_, err := openDB(t.TempDir(), dbm.GoLevelDBBackend)
+	db, err := OpenVersionedDB(t.TempDir(), config.VersionedDBBackendPebbleDB)
 	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = OpenVersionedDB(t.TempDir(), "leveldb")
+	require.Error(t, err)
 }
 
 func Test_openTraceWriter(t *testing.T) {
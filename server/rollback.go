@@ -26,7 +26,7 @@ application.
 			ctx := GetServerContextFromCmd(cmd)
 			cfg := ctx.Config
 			home := cfg.RootDir
-			db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+			db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 			if err != nil {
 				return err
 			}
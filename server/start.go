@@ -60,6 +60,10 @@ const (
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+	FlagStateSyncSnapshotParallel   = "state-sync.snapshot-parallel"
+
+	// state archive-related flags
+	FlagStateArchiveEnable = "state-archive.enable"
 
 	// api-related flags
 	FlagAPIEnable             = "api.enable"
@@ -185,6 +189,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
+	cmd.Flags().Bool(FlagStateSyncSnapshotParallel, false, "Take state sync snapshots with substores exported concurrently")
+
+	cmd.Flags().Bool(FlagStateArchiveEnable, false, "Record the committed state to serve the queries at pruned heights")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -196,7 +203,7 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	transport := ctx.Viper.GetString(flagTransport)
 	home := ctx.Viper.GetString(flags.FlagHome)
 
-	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
@@ -260,7 +267,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	db, err := openDB(home, GetAppDBBackend(ctx.Viper))
+	db, err := OpenDB(home, GetAppDBBackend(ctx.Viper))
 	if err != nil {
 		return err
 	}
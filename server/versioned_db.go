@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/db/pebbledb"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+)
+
+type versionedDBCreator func(dir string) (db.Connection, error)
+
+// versionedDBCreators maps each supported versioned database backend to its constructor.
+var versionedDBCreators = map[string]versionedDBCreator{
+	config.VersionedDBBackendMemDB: func(string) (db.Connection, error) {
+		return memdb.NewDB(), nil
+	},
+	config.VersionedDBBackendBadgerDB: func(dir string) (db.Connection, error) {
+		return badgerdb.NewDB(dir)
+	},
+	config.VersionedDBBackendPebbleDB: func(dir string) (db.Connection, error) {
+		return pebbledb.NewDB(dir)
+	},
+}
+
+// GetVersionedDBBackend gets the backend type to use for the versioned application DB.
+func GetVersionedDBBackend(opts types.AppOptions) string {
+	rv := cast.ToString(opts.Get("versioned-db-backend"))
+	if len(rv) != 0 {
+		return rv
+	}
+	return config.DefaultVersionedDBBackend
+}
+
+// OpenVersionedDB opens the versioned application database in the data directory of rootDir.
+func OpenVersionedDB(rootDir string, backend string) (db.Connection, error) {
+	return openVersionedDB(filepath.Join(rootDir, "data", "application.versioned"), backend)
+}
+
+// GetStateArchive opens the state archive in the data directory of the home directory,
+// using the versioned application database backend. It returns nil if the state archive
+// is not enabled.
+func GetStateArchive(appOpts types.AppOptions) (*archive.Archive, error) {
+	if !cast.ToBool(appOpts.Get(FlagStateArchiveEnable)) {
+		return nil, nil
+	}
+
+	archiveDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "state_archive.versioned")
+	archiveDB, err := openVersionedDB(archiveDir, GetVersionedDBBackend(appOpts))
+	if err != nil {
+		return nil, err
+	}
+	return archive.NewArchive(archiveDB), nil
+}
+
+func openVersionedDB(dir string, backend string) (db.Connection, error) {
+	creator, ok := versionedDBCreators[backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown versioned db backend %q", backend)
+	}
+	return creator(dir)
+}
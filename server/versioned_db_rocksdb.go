@@ -0,0 +1,15 @@
+//go:build rocksdb_build
+
+package server
+
+import (
+	"github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/rocksdb"
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func init() {
+	versionedDBCreators[config.VersionedDBBackendRocksDB] = func(dir string) (db.Connection, error) {
+		return rocksdb.NewDB(dir)
+	}
+}
@@ -6,19 +6,23 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"cosmossdk.io/core/appconfig"
+	smartaccounttypes "github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	epochingtypes "github.com/cosmos/cosmos-sdk/x/epoching/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	msgfeestypes "github.com/cosmos/cosmos-sdk/x/msgfees/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -31,17 +35,21 @@ import (
 	authzmodulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	capabilitymodulev1 "cosmossdk.io/api/cosmos/capability/module/v1"
+	circuitmodulev1 "cosmossdk.io/api/cosmos/circuit/module/v1"
 	crisismodulev1 "cosmossdk.io/api/cosmos/crisis/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
+	epochingmodulev1 "cosmossdk.io/api/cosmos/epoching/module/v1"
 	evidencemodulev1 "cosmossdk.io/api/cosmos/evidence/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
 	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
 	govmodulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
 	groupmodulev1 "cosmossdk.io/api/cosmos/group/module/v1"
 	mintmodulev1 "cosmossdk.io/api/cosmos/mint/module/v1"
+	msgfeesmodulev1 "cosmossdk.io/api/cosmos/msgfees/module/v1"
 	nftmodulev1 "cosmossdk.io/api/cosmos/nft/module/v1"
 	paramsmodulev1 "cosmossdk.io/api/cosmos/params/module/v1"
 	slashingmodulev1 "cosmossdk.io/api/cosmos/slashing/module/v1"
+	smartaccountmodulev1 "cosmossdk.io/api/cosmos/smartaccount/module/v1"
 	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
 	txmodulev1 "cosmossdk.io/api/cosmos/tx/module/v1"
 	upgrademodulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
@@ -81,10 +89,17 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					group.ModuleName,
 					paramstypes.ModuleName,
 					vestingtypes.ModuleName,
+					smartaccounttypes.ModuleName,
+					circuittypes.ModuleName,
+					msgfeestypes.ModuleName,
+					epochingtypes.ModuleName,
 				},
+				// NOTE: epoching module's endblocker must come before the staking one so that the
+				// validator set updates caused by the buffered messages are applied at the epoch end
 				EndBlockers: []string{
 					crisistypes.ModuleName,
 					govtypes.ModuleName,
+					epochingtypes.ModuleName,
 					stakingtypes.ModuleName,
 					capabilitytypes.ModuleName,
 					authtypes.ModuleName,
@@ -101,6 +116,9 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					paramstypes.ModuleName,
 					upgradetypes.ModuleName,
 					vestingtypes.ModuleName,
+					smartaccounttypes.ModuleName,
+					circuittypes.ModuleName,
+					msgfeestypes.ModuleName,
 				},
 				OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
 					{
@@ -122,6 +140,7 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 					{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 					{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 					{Account: nft.ModuleName},
+					{Account: epochingtypes.ModuleName},
 				},
 			}),
 		},
@@ -129,6 +148,10 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 			Name:   vestingtypes.ModuleName,
 			Config: appconfig.WrapAny(&vestingmodulev1.Module{}),
 		},
+		{
+			Name:   smartaccounttypes.ModuleName,
+			Config: appconfig.WrapAny(&smartaccountmodulev1.Module{}),
+		},
 		{
 			Name:   banktypes.ModuleName,
 			Config: appconfig.WrapAny(&bankmodulev1.Module{}),
@@ -190,6 +213,18 @@ var AppConfig = appconfig.Compose(&appv1alpha1.Config{
 			Name:   nft.ModuleName,
 			Config: appconfig.WrapAny(&nftmodulev1.Module{}),
 		},
+		{
+			Name:   circuittypes.ModuleName,
+			Config: appconfig.WrapAny(&circuitmodulev1.Module{}),
+		},
+		{
+			Name:   msgfeestypes.ModuleName,
+			Config: appconfig.WrapAny(&msgfeesmodulev1.Module{}),
+		},
+		{
+			Name:   epochingtypes.ModuleName,
+			Config: appconfig.WrapAny(&epochingmodulev1.Module{}),
+		},
 		{
 			Name:   feegrant.ModuleName,
 			Config: appconfig.WrapAny(&feegrantmodulev1.Module{}),
@@ -7,6 +7,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"io"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -138,6 +140,10 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 	return nil
 }
 
+func (m *mockSnapshotter) SnapshotName() string {
+	return "mock"
+}
+
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
 	return snapshottypes.CurrentFormat
 }
@@ -158,6 +164,96 @@ func (m *mockSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
 }
 
+// mockParallelSnapshotter is a ParallelSnapshotter whose substores are lists of payload items.
+// The substores are snapshotted in the order of names if set.
+type mockParallelSnapshotter struct {
+	mtx       sync.Mutex
+	substores map[string][][]byte
+	names     []string
+	committed bool
+}
+
+func (m *mockParallelSnapshotter) SnapshotSubstores(height uint64) ([]string, error) {
+	if m.names != nil {
+		return m.names, nil
+	}
+	names := make([]string, 0, len(m.substores))
+	for name := range m.substores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m *mockParallelSnapshotter) SnapshotSubstore(height uint64, name string, protoWriter protoio.Writer) error {
+	err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{Name: name},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range m.substores[name] {
+		if err := snapshottypes.WriteExtensionItem(protoWriter, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelSnapshotter) RestoreSubstore(height uint64, protoReader protoio.Reader) error {
+	item := &snapshottypes.SnapshotItem{}
+	if err := protoReader.ReadMsg(item); err != nil {
+		return err
+	}
+	name := item.GetStore().Name
+	items := [][]byte{}
+	for {
+		item := &snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		items = append(items, item.GetExtensionPayload().Payload)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.substores == nil {
+		m.substores = map[string][][]byte{}
+	}
+	m.substores[name] = items
+	return nil
+}
+
+func (m *mockParallelSnapshotter) CommitRestore(height uint64) error {
+	m.committed = true
+	return nil
+}
+
+func (m *mockParallelSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	names, _ := m.SnapshotSubstores(height)
+	for _, name := range names {
+		if err := m.SnapshotSubstore(height, name, protoWriter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *mockParallelSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	panic("not implemented")
+}
+
+func (m *mockParallelSnapshotter) PruneSnapshotHeight(height int64) {}
+
+func (m *mockParallelSnapshotter) SetSnapshotInterval(snapshotInterval uint64) {}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
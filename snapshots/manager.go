@@ -10,6 +10,7 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -176,6 +177,10 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	if multistore, ok := m.multistore.(types.ParallelSnapshotter); ok && m.opts.Parallel {
+		return m.createParallelSnapshot(height, multistore)
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
@@ -200,10 +205,18 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// snapshotExtensions writes the metadata and payload items of each extension snapshotter.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -212,14 +225,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
-		if err := extension.Snapshot(height, streamWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+		if err := extension.Snapshot(height, protoWriter); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -267,7 +279,7 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	if !m.isFormatSupported(snapshot.Format) {
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -305,6 +317,10 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 
 // restoreSnapshot do the heavy work of snapshot restoration after preliminary checks on request have passed.
 func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	if snapshot.Format == types.ParallelFormat {
+		return m.restoreParallelSnapshot(snapshot, m.multistore.(types.ParallelSnapshotter), chChunks)
+	}
+
 	streamReader, err := NewStreamReader(chChunks)
 	if err != nil {
 		return err
@@ -315,6 +331,13 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
+	return m.restoreExtensions(snapshot.Height, next, streamReader)
+}
+
+// restoreExtensions restores the extension snapshotters, starting with the given metadata item
+// of the first extension.
+func (m *Manager) restoreExtensions(height uint64, next types.SnapshotItem, protoReader protoio.Reader) error {
+	var err error
 	for {
 		if next.Item == nil {
 			// end of stream
@@ -331,7 +354,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		if !IsFormatSupported(extension, metadata.Format) {
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
-		next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+		next, err = extension.Restore(height, metadata.Format, protoReader)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
@@ -391,6 +414,85 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot of the local snapshot store,
+// without fetching its chunks from a peer. The chunks are verified against the chunk hashes
+// of the snapshot metadata.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if !m.isFormatSupported(snapshot.Format) {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			uint32(len(snapshot.Metadata.ChunkHashes)),
+			snapshot.Chunks)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	// Verify the hash of each chunk before passing it to the restore.
+	chVerified := make(chan io.ReadCloser)
+	go func() {
+		defer close(chVerified)
+		index := 0
+		for chunk := range chChunks {
+			bz, err := io.ReadAll(chunk)
+			_ = chunk.Close()
+			if err == nil && index >= len(snapshot.Metadata.ChunkHashes) {
+				err = sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot has unexpected chunks")
+			}
+			if err == nil {
+				hash := sha256.Sum256(bz)
+				expected := snapshot.Metadata.ChunkHashes[index]
+				if !bytes.Equal(hash[:], expected) {
+					err = sdkerrors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %x, got %x", index, expected, hash)
+				}
+			}
+			if err != nil {
+				pr, pw := io.Pipe()
+				pw.CloseWithError(err)
+				chVerified <- pr
+				return
+			}
+			chVerified <- io.NopCloser(bytes.NewReader(bz))
+			index++
+		}
+	}()
+	defer DrainChunks(chVerified)
+
+	return m.restoreSnapshot(*snapshot, chVerified)
+}
+
+// isFormatSupported returns if the multistore can restore snapshots of the given format.
+// The ParallelFormat can be restored by any ParallelSnapshotter, whether or not it is used
+// to take snapshots.
+func (m *Manager) isFormatSupported(format uint32) bool {
+	switch format {
+	case types.CurrentFormat:
+		return true
+	case types.ParallelFormat:
+		_, ok := m.multistore.(types.ParallelSnapshotter)
+		return ok
+	default:
+		return false
+	}
+}
+
 // sortedExtensionNames sort extension names for deterministic iteration.
 func (m *Manager) sortedExtensionNames() []string {
 	names := make([]string, 0, len(m.extensions))
@@ -1,6 +1,7 @@
 package snapshots_test
 
 import (
+	"crypto/rand"
 	"errors"
 	"testing"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var opts = types.NewSnapshotOptions(1500, 2)
@@ -228,3 +230,114 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	target := &mockSnapshotter{
+		prunedHeights: make(map[int64]struct{}),
+	}
+	manager := snapshots.NewManager(store, opts, target, nil, log.NewNopLogger())
+
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	_, err := store.Save(4, types.CurrentFormat, makeChunks(snapshotItems(expectItems)))
+	require.NoError(t, err)
+
+	// Restoring a missing snapshot errors
+	err = manager.RestoreLocalSnapshot(5, types.CurrentFormat)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// Restoring a snapshot of another format errors
+	err = manager.RestoreLocalSnapshot(2, 1)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	err = manager.RestoreLocalSnapshot(4, types.CurrentFormat)
+	require.NoError(t, err)
+	assert.Equal(t, expectItems, target.items)
+
+	// The manager is available for other operations after the restore
+	_, err = manager.Prune(2)
+	require.NoError(t, err)
+}
+
+func TestManager_ParallelSnapshot(t *testing.T) {
+	// "b" is incompressible and spans several chunks
+	large := make([][]byte, 25)
+	for i := range large {
+		large[i] = make([]byte, 1e6)
+		_, err := rand.Read(large[i])
+		require.NoError(t, err)
+	}
+	source := &mockParallelSnapshotter{substores: map[string][][]byte{
+		"a": {{1, 2, 3}, {4, 5, 6}},
+		"b": large,
+		"c": {},
+	}}
+	extension := &mockSnapshotter{items: [][]byte{{7, 8, 9}}}
+	parallelOpts := types.NewSnapshotOptions(1500, 2)
+	parallelOpts.Parallel = true
+	manager := snapshots.NewManager(setupStore(t), parallelOpts, source, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extension))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+	assert.Equal(t, types.ParallelFormat, snapshot.Format)
+	// the manifest, one chunk for "a", "c" and the extensions, and three chunks for "b"
+	assert.EqualValues(t, 7, snapshot.Chunks)
+
+	// A manager without a ParallelSnapshotter can't restore it
+	seqManager := snapshots.NewManager(setupStore(t), opts, &mockSnapshotter{}, nil, log.NewNopLogger())
+	err = seqManager.Restore(*snapshot)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	target := &mockParallelSnapshotter{}
+	targetExtension := &mockSnapshotter{}
+	targetManager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RegisterExtensions(targetExtension))
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	assert.Equal(t, source.substores, target.substores)
+	assert.True(t, target.committed)
+	assert.Equal(t, extension.items, targetExtension.items)
+
+	// The snapshot can be restored from the local snapshot store as well
+	expectItems := extension.items
+	extension.items = nil
+	require.NoError(t, manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+	assert.True(t, source.committed)
+	assert.Equal(t, expectItems, extension.items)
+}
+
+func TestManager_ParallelSnapshotDuplicateSubstores(t *testing.T) {
+	// the snapshot has two streams for "a"
+	source := &mockParallelSnapshotter{
+		substores: map[string][][]byte{"a": {{1, 2, 3}}, "b": {{4, 5, 6}}},
+		names:     []string{"a", "b", "a"},
+	}
+	parallelOpts := types.NewSnapshotOptions(1500, 2)
+	parallelOpts.Parallel = true
+	manager := snapshots.NewManager(setupStore(t), parallelOpts, source, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	target := &mockParallelSnapshotter{}
+	targetManager := snapshots.NewManager(setupStore(t), opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks && err == nil; i++ {
+		var chunk []byte
+		chunk, err = manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		_, err = targetManager.RestoreChunk(chunk)
+	}
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+	assert.False(t, target.committed)
+}
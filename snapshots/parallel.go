@@ -0,0 +1,356 @@
+package snapshots
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// A ParallelFormat snapshot consists of independent streams, each a zlib-compressed sequence of
+// delimited SnapshotItem messages split into chunks, as produced by a StreamWriter. There is one
+// stream per substore, followed by a single stream for all extension snapshotters.
+//
+// The first chunk is a manifest holding the number of streams and the number of chunks of each
+// stream, as uvarints. It is followed by the chunks of the substore streams, interleaved in
+// round-robin order so that they can be restored concurrently as chunks arrive, and then by the
+// chunks of the extension stream, which is only restored once all substores are.
+
+// chunkRef identifies a chunk of a stream.
+type chunkRef struct {
+	stream int
+	index  uint32
+}
+
+// encodeManifest encodes the number of chunks of each stream into a manifest chunk.
+func encodeManifest(streamChunks []uint32) []byte {
+	buf := make([]byte, binary.MaxVarintLen64*(len(streamChunks)+1))
+	n := binary.PutUvarint(buf, uint64(len(streamChunks)))
+	for _, chunks := range streamChunks {
+		n += binary.PutUvarint(buf[n:], uint64(chunks))
+	}
+	return buf[:n]
+}
+
+// decodeManifest decodes a manifest chunk, checking that it matches the number of chunks of the
+// snapshot.
+func decodeManifest(bz []byte, snapshotChunks uint32) ([]uint32, error) {
+	r := bytes.NewReader(bz)
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMetadata, "invalid snapshot manifest")
+	}
+	// there must be at least the extension stream, and each stream has at least one chunk
+	if count == 0 || count >= uint64(snapshotChunks) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot manifest has %v streams", count)
+	}
+	streamChunks := make([]uint32, count)
+	total := uint64(1)
+	for i := range streamChunks {
+		chunks, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidMetadata, "invalid snapshot manifest")
+		}
+		if chunks == 0 {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot stream %v has no chunks", i)
+		}
+		total += chunks
+		if total > uint64(snapshotChunks) {
+			break
+		}
+		streamChunks[i] = uint32(chunks)
+	}
+	if r.Len() != 0 || total != uint64(snapshotChunks) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMetadata,
+			"snapshot manifest does not match the %v chunks of the snapshot", snapshotChunks)
+	}
+	return streamChunks, nil
+}
+
+// chunkOrder returns the order of the chunks following the manifest: the chunks of the substore
+// streams in round-robin order, then the chunks of the last (extension) stream.
+func chunkOrder(streamChunks []uint32) []chunkRef {
+	order := []chunkRef{}
+	substores := streamChunks[:len(streamChunks)-1]
+	for index, remaining := uint32(0), true; remaining; index++ {
+		remaining = false
+		for stream, chunks := range substores {
+			if index < chunks {
+				order = append(order, chunkRef{stream: stream, index: index})
+				remaining = true
+			}
+		}
+	}
+	last := len(streamChunks) - 1
+	for index := uint32(0); index < streamChunks[last]; index++ {
+		order = append(order, chunkRef{stream: last, index: index})
+	}
+	return order
+}
+
+// createParallelSnapshot takes a ParallelFormat snapshot. The streams are written concurrently
+// into a spool directory, then saved in chunk order into the snapshot store.
+func (m *Manager) createParallelSnapshot(
+	height uint64, multistore types.ParallelSnapshotter,
+) (*types.Snapshot, error) {
+	names, err := multistore.SnapshotSubstores(height)
+	if err != nil {
+		return nil, err
+	}
+	spoolDir := m.store.pathSpool(height, types.ParallelFormat)
+	if err := os.RemoveAll(spoolDir); err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to clean up snapshot spool directory %q", spoolDir)
+	}
+	defer os.RemoveAll(spoolDir)
+
+	// The last stream holds the extensions
+	streams := len(names) + 1
+	streamChunks := make([]uint32, streams)
+	errs := make([]error, streams)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i := 0; i < streams; i++ {
+		wg.Add(1)
+		go func(stream int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			ch := make(chan io.ReadCloser)
+			go func() {
+				streamWriter := NewStreamWriter(ch)
+				if streamWriter == nil {
+					return
+				}
+				var err error
+				if stream < len(names) {
+					err = multistore.SnapshotSubstore(height, names[stream], streamWriter)
+				} else {
+					err = m.snapshotExtensions(height, streamWriter)
+				}
+				if err != nil {
+					streamWriter.CloseWithError(err)
+					return
+				}
+				if err := streamWriter.Close(); err != nil {
+					streamWriter.CloseWithError(err)
+				}
+			}()
+			streamChunks[stream], errs[stream] = spoolChunks(filepath.Join(spoolDir, strconv.Itoa(stream)), ch)
+		}(i)
+	}
+	wg.Wait()
+	for stream, err := range errs {
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to snapshot stream %v", stream)
+		}
+	}
+
+	ch := make(chan io.ReadCloser)
+	go func() {
+		defer close(ch)
+		ch <- io.NopCloser(bytes.NewReader(encodeManifest(streamChunks)))
+		for _, ref := range chunkOrder(streamChunks) {
+			path := filepath.Join(spoolDir, strconv.Itoa(ref.stream), strconv.FormatUint(uint64(ref.index), 10))
+			file, err := os.Open(path)
+			if err != nil {
+				pr, pw := io.Pipe()
+				_ = pw.CloseWithError(err)
+				ch <- pr
+				return
+			}
+			ch <- file
+		}
+	}()
+	return m.store.Save(height, types.ParallelFormat, ch)
+}
+
+// spoolChunks writes the chunks of a stream into numbered files of the given directory,
+// returning the number of chunks.
+func spoolChunks(dir string, chunks <-chan io.ReadCloser) (uint32, error) {
+	defer DrainChunks(chunks)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return 0, sdkerrors.Wrapf(err, "failed to create snapshot spool directory %q", dir)
+	}
+	index := uint32(0)
+	for chunk := range chunks {
+		path := filepath.Join(dir, strconv.FormatUint(uint64(index), 10))
+		if err := spoolChunk(path, chunk); err != nil {
+			return 0, sdkerrors.Wrapf(err, "failed to spool snapshot chunk %v", index)
+		}
+		index++
+	}
+	return index, nil
+}
+
+func spoolChunk(path string, chunk io.ReadCloser) error {
+	defer chunk.Close()
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, chunk); err != nil {
+		return err
+	}
+	if err := chunk.Close(); err != nil {
+		return err
+	}
+	return file.Close()
+}
+
+// restoreParallelSnapshot restores a ParallelFormat snapshot, restoring each substore stream
+// concurrently as its chunks arrive.
+func (m *Manager) restoreParallelSnapshot(
+	snapshot types.Snapshot, multistore types.ParallelSnapshotter, chChunks <-chan io.ReadCloser,
+) error {
+	defer DrainChunks(chChunks)
+	manifest, ok := <-chChunks
+	if !ok {
+		return sdkerrors.Wrap(types.ErrInvalidMetadata, "missing snapshot manifest")
+	}
+	bz, err := io.ReadAll(manifest)
+	_ = manifest.Close()
+	if err != nil {
+		return err
+	}
+	streamChunks, err := decodeManifest(bz, snapshot.Chunks)
+	if err != nil {
+		return err
+	}
+
+	streams := len(streamChunks)
+	chStreams := make([]chan io.ReadCloser, streams)
+	for i := range chStreams {
+		chStreams[i] = make(chan io.ReadCloser, chunkBufferSize)
+	}
+	errs := make([]error, streams)
+	var substores, all sync.WaitGroup
+	claims := &substoreClaims{names: map[string]bool{}}
+	restoreStream := func(stream int, chunks <-chan io.ReadCloser, restore func(*StreamReader) error) {
+		defer all.Done()
+		defer DrainChunks(chunks)
+		streamReader, err := NewStreamReader(chunks)
+		if err != nil {
+			errs[stream] = err
+			return
+		}
+		defer streamReader.Close()
+		errs[stream] = restore(streamReader)
+	}
+
+	for i := 0; i < streams-1; i++ {
+		substores.Add(1)
+		all.Add(1)
+		go func(stream int) {
+			defer substores.Done()
+			restoreStream(stream, chStreams[stream], func(streamReader *StreamReader) error {
+				protoReader := &substoreReader{StreamReader: streamReader, claims: claims}
+				return sdkerrors.Wrapf(multistore.RestoreSubstore(snapshot.Height, protoReader),
+					"multistore restore stream %v", stream)
+			})
+		}(i)
+	}
+	all.Add(1)
+	go restoreStream(streams-1, chStreams[streams-1], func(streamReader *StreamReader) error {
+		substores.Wait()
+		for _, err := range errs[:streams-1] {
+			if err != nil {
+				return nil
+			}
+		}
+		if err := multistore.CommitRestore(snapshot.Height); err != nil {
+			return sdkerrors.Wrap(err, "multistore restore")
+		}
+		next := types.SnapshotItem{}
+		err := streamReader.ReadMsg(&next)
+		if err != nil && err != io.EOF {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		return m.restoreExtensions(snapshot.Height, next, streamReader)
+	})
+
+	// Dispatch the chunks to their streams, closing each stream after its last chunk.
+	order := chunkOrder(streamChunks)
+	closed := make([]bool, streams)
+	sent := 0
+	for chunk := range chChunks {
+		if sent >= len(order) {
+			_ = chunk.Close()
+			err = sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot has unexpected chunks")
+			break
+		}
+		ref := order[sent]
+		chStreams[ref.stream] <- chunk
+		if ref.index == streamChunks[ref.stream]-1 {
+			close(chStreams[ref.stream])
+			closed[ref.stream] = true
+		}
+		sent++
+	}
+	for stream, ch := range chStreams {
+		if !closed[stream] {
+			close(ch)
+		}
+	}
+	all.Wait()
+	if err != nil {
+		return err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	if sent < len(order) {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "snapshot ended prematurely")
+	}
+	return nil
+}
+
+// substoreClaims records the names of the substores restored by the streams of a snapshot.
+type substoreClaims struct {
+	mtx   sync.Mutex
+	names map[string]bool
+}
+
+// claim records the name of a restored substore, failing if another stream restores it as well.
+func (c *substoreClaims) claim(name string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.names[name] {
+		return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has several streams for substore %q", name)
+	}
+	c.names[name] = true
+	return nil
+}
+
+// substoreReader reads a substore stream, claiming the substore named by its first item before it
+// is restored, so that several streams can't restore the same substore concurrently.
+type substoreReader struct {
+	*StreamReader
+	claims  *substoreClaims
+	claimed bool
+}
+
+// ReadMsg implements protoio.Reader interface
+func (r *substoreReader) ReadMsg(msg proto.Message) error {
+	if err := r.StreamReader.ReadMsg(msg); err != nil || r.claimed {
+		return err
+	}
+	r.claimed = true
+	item, ok := msg.(*types.SnapshotItem)
+	if !ok || item.GetStore() == nil {
+		return nil
+	}
+	return r.claims.claim(item.GetStore().Name)
+}
@@ -318,6 +318,11 @@ func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
 }
 
+// pathSpool generates the path to a temporary directory used while taking a snapshot.
+func (s *Store) pathSpool(height uint64, format uint32) string {
+	return filepath.Join(s.dir, "spool", strconv.FormatUint(height, 10), strconv.FormatUint(uint64(format), 10))
+}
+
 // decodeKey decodes a snapshot key.
 func decodeKey(k []byte) (uint64, uint32, error) {
 	if len(k) != 13 {
@@ -4,3 +4,10 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 2
+
+// ParallelFormat is the format of snapshots whose substores are serialized concurrently into
+// independent streams of chunks, taken when SnapshotOptions.Parallel is set and the multistore is
+// a ParallelSnapshotter. The first chunk is a manifest holding the number of chunks of each
+// stream, followed by the chunks of the substore streams interleaved in round-robin order, and
+// the chunks of the extension snapshotters stream.
+const ParallelFormat uint32 = 3
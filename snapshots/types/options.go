@@ -8,6 +8,9 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// Parallel takes snapshots in the ParallelFormat, if supported by the multistore.
+	Parallel bool
 }
 
 // SnapshotIntervalOff represents the snapshot interval, at which
@@ -28,6 +28,27 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// ParallelSnapshotter is a Snapshotter which can snapshot and restore each of its substores
+// independently, allowing them to be processed concurrently in the ParallelFormat.
+type ParallelSnapshotter interface {
+	Snapshotter
+
+	// SnapshotSubstores returns the names of the substores to snapshot at the given height, in
+	// the order of their streams.
+	SnapshotSubstores(height uint64) ([]string, error)
+
+	// SnapshotSubstore writes the snapshot items of a single substore into the protobuf writer.
+	// It is called concurrently for different substores.
+	SnapshotSubstore(height uint64, name string, protoWriter protoio.Writer) error
+
+	// RestoreSubstore restores a single substore from the snapshot items written by
+	// SnapshotSubstore. It is called concurrently for different substores.
+	RestoreSubstore(height uint64, protoReader protoio.Reader) error
+
+	// CommitRestore completes a restore once all substores have been restored.
+	CommitRestore(height uint64) error
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -0,0 +1,212 @@
+package archive
+
+import (
+	"fmt"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.WriteListener = (*Archive)(nil)
+
+// Archive records the change sets committed to a multistore in a versioned database, saving
+// a version of the database for each committed version of the multistore. It keeps every
+// version it records, so that the state at versions pruned from the multistore can still be
+// read from it.
+//
+// The Archive is a WriteListener: the multistore reports it the writes flushed to its stores,
+// which the Archive buffers until Commit saves them under the committed version.
+type Archive struct {
+	db dbm.Connection
+
+	mtx     sync.Mutex
+	pending []types.StoreKVPair
+}
+
+// NewArchive returns an Archive recording the change sets in the given database.
+func NewArchive(db dbm.Connection) *Archive {
+	return &Archive{db: db}
+}
+
+// OnWrite implements the WriteListener interface, buffering the write until the next Commit.
+func (a *Archive) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	a.pending = append(a.pending, types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// Commit applies the writes buffered since the last Commit and saves them as the given
+// version. A version which is not greater than the latest version recorded overwrites it, and
+// the versions after it are deleted: the multistore commits such a version again when it replays
+// the blocks after a rollback.
+func (a *Archive) Commit(version int64) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if last := a.LatestVersion(); version <= last {
+		if err := a.revert(version); err != nil {
+			return fmt.Errorf("cannot record version %d in state archive at version %d: %w", version, last, err)
+		}
+	}
+
+	writer := a.db.Writer()
+	for _, pair := range a.pending {
+		var err error
+		if pair.Delete {
+			err = writer.Delete(storeKey(pair.StoreKey, pair.Key))
+		} else {
+			err = writer.Set(storeKey(pair.StoreKey, pair.Key), pair.Value)
+		}
+		if err != nil {
+			writer.Discard()
+			return err
+		}
+	}
+	if err := writer.Commit(); err != nil {
+		return err
+	}
+	a.pending = nil
+
+	return a.db.SaveVersion(uint64(version))
+}
+
+// revert deletes the recorded versions from the given one, and reverts the database to the
+// latest version left.
+func (a *Archive) revert(version int64) error {
+	versions, err := a.db.Versions()
+	if err != nil {
+		return err
+	}
+	var deleted []uint64
+	for iter := versions.Iterator(); iter.Next(); {
+		if iter.Value() >= uint64(version) {
+			deleted = append(deleted, iter.Value())
+		}
+	}
+	for _, v := range deleted {
+		if err := a.db.DeleteVersion(v); err != nil {
+			return err
+		}
+	}
+	return a.db.Revert()
+}
+
+// Import records the given version from the full contents of the stores, keyed by store name,
+// replacing the contents of the latest version recorded, if any. It is used to start recording
+// on an empty archive, or to catch up with the multistore on an archive missing versions, and
+// leaves the buffered writes untouched.
+func (a *Archive) Import(version int64, stores map[string]types.KVStore) error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if last := a.LatestVersion(); version <= last {
+		return fmt.Errorf("cannot import version %d in state archive at version %d", version, last)
+	}
+
+	keys, err := a.keys()
+	if err != nil {
+		return err
+	}
+
+	writer := a.db.Writer()
+	for _, key := range keys {
+		if err := writer.Delete(key); err != nil {
+			writer.Discard()
+			return err
+		}
+	}
+	for name, store := range stores {
+		if err := importStore(writer, name, store); err != nil {
+			writer.Discard()
+			return err
+		}
+	}
+	if err := writer.Commit(); err != nil {
+		return err
+	}
+
+	return a.db.SaveVersion(uint64(version))
+}
+
+// keys returns the keys of the current contents of the database.
+func (a *Archive) keys() ([][]byte, error) {
+	reader := a.db.Reader()
+	defer reader.Discard()
+
+	iter, err := reader.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for iter.Next() {
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	return keys, iter.Error()
+}
+
+func importStore(writer dbm.Writer, name string, store types.KVStore) error {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := writer.Set(storeKey(name, iter.Key()), iter.Value()); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// LatestVersion returns the latest version recorded, or 0 if none.
+func (a *Archive) LatestVersion() int64 {
+	versions, err := a.db.Versions()
+	if err != nil {
+		panic(err)
+	}
+	return int64(versions.Last())
+}
+
+// HasVersion returns whether the given version is recorded.
+func (a *Archive) HasVersion(version int64) bool {
+	if version <= 0 {
+		return false
+	}
+	versions, err := a.db.Versions()
+	if err != nil {
+		panic(err)
+	}
+	return versions.Exists(uint64(version))
+}
+
+// GetStore returns a read-only view of the named store at the given version.
+// Returns ErrVersionDoesNotExist if the version is not recorded.
+func (a *Archive) GetStore(version int64, name string) (*Store, error) {
+	if !a.HasVersion(version) {
+		return nil, dbm.ErrVersionDoesNotExist
+	}
+	return &Store{db: a.db, version: version, prefix: storePrefix(name)}, nil
+}
+
+// Close closes the underlying database.
+func (a *Archive) Close() error {
+	return a.db.Close()
+}
+
+// storePrefix returns the prefix of the keys of the named store, which is the one the
+// multistore uses for the store in its own database.
+func storePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
+func storeKey(name string, key []byte) []byte {
+	return append(storePrefix(name), key...)
+}
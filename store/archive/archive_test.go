@@ -0,0 +1,185 @@
+package archive_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/archive"
+	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	storeKey1 = types.NewKVStoreKey("store1")
+	storeKey2 = types.NewKVStoreKey("store2")
+)
+
+func TestArchiveCommit(t *testing.T) {
+	a := archive.NewArchive(memdb.NewDB())
+	require.Equal(t, int64(0), a.LatestVersion())
+
+	require.NoError(t, a.OnWrite(storeKey1, []byte("a"), []byte("1"), false))
+	require.NoError(t, a.OnWrite(storeKey1, []byte("b"), []byte("2"), false))
+	require.NoError(t, a.OnWrite(storeKey2, []byte("a"), []byte("3"), false))
+	require.NoError(t, a.Commit(1))
+
+	require.NoError(t, a.OnWrite(storeKey1, []byte("a"), []byte("4"), false))
+	require.NoError(t, a.OnWrite(storeKey1, []byte("b"), nil, true))
+	require.NoError(t, a.Commit(3))
+
+	require.Equal(t, int64(3), a.LatestVersion())
+	require.True(t, a.HasVersion(1))
+	require.False(t, a.HasVersion(2))
+	require.True(t, a.HasVersion(3))
+
+	store, err := a.GetStore(1, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+
+	store, err = a.GetStore(3, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("4"), store.Get([]byte("a")))
+	require.False(t, store.Has([]byte("b")))
+
+	// the stores are isolated from each other
+	store, err = a.GetStore(3, storeKey2.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), store.Get([]byte("a")))
+	require.Nil(t, store.Get([]byte("b")))
+
+	_, err = a.GetStore(2, storeKey1.Name())
+	require.ErrorIs(t, err, dbm.ErrVersionDoesNotExist)
+
+	// committing a recorded version again overwrites it from the previous version, and deletes
+	// the next ones
+	require.NoError(t, a.OnWrite(storeKey1, []byte("c"), []byte("5"), false))
+	require.NoError(t, a.Commit(4))
+	require.NoError(t, a.OnWrite(storeKey1, []byte("c"), []byte("6"), false))
+	require.NoError(t, a.Commit(3))
+	require.Equal(t, int64(3), a.LatestVersion())
+	require.False(t, a.HasVersion(4))
+
+	store, err = a.GetStore(3, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.Equal(t, []byte("6"), store.Get([]byte("c")))
+
+	store, err = a.GetStore(1, storeKey1.Name())
+	require.NoError(t, err)
+	require.False(t, store.Has([]byte("c")))
+	require.NoError(t, a.Close())
+}
+
+func TestArchiveImport(t *testing.T) {
+	a := archive.NewArchive(memdb.NewDB())
+
+	store1 := mem.NewStore()
+	store1.Set([]byte("a"), []byte("1"))
+	store1.Set([]byte("b"), []byte("2"))
+
+	// buffered writes are kept for the next commit
+	require.NoError(t, a.OnWrite(storeKey1, []byte("c"), []byte("3"), false))
+	require.NoError(t, a.Import(5, map[string]types.KVStore{storeKey1.Name(): store1}))
+	require.Equal(t, int64(5), a.LatestVersion())
+
+	store, err := a.GetStore(5, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+
+	require.NoError(t, a.Commit(6))
+	store, err = a.GetStore(6, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("3"), store.Get([]byte("c")))
+
+	require.Error(t, a.Import(6, map[string]types.KVStore{storeKey1.Name(): store1}))
+
+	// an archive missing versions imports the state over its latest version
+	store1.Delete([]byte("a"))
+	require.NoError(t, a.Import(8, map[string]types.KVStore{storeKey1.Name(): store1}))
+	require.Equal(t, int64(8), a.LatestVersion())
+	require.False(t, a.HasVersion(7))
+
+	store, err = a.GetStore(8, storeKey1.Name())
+	require.NoError(t, err)
+	require.False(t, store.Has([]byte("a")))
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.False(t, store.Has([]byte("c")))
+
+	store, err = a.GetStore(6, storeKey1.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("3"), store.Get([]byte("c")))
+}
+
+func TestStoreIterators(t *testing.T) {
+	a := archive.NewArchive(memdb.NewDB())
+	for _, key := range []string{"a", "b1", "b2", "c"} {
+		require.NoError(t, a.OnWrite(storeKey1, []byte(key), []byte(key), false))
+	}
+	require.NoError(t, a.OnWrite(storeKey2, []byte("b3"), []byte("b3"), false))
+	require.NoError(t, a.Commit(1))
+
+	store, err := a.GetStore(1, storeKey1.Name())
+	require.NoError(t, err)
+
+	var keys []string
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"a", "b1", "b2", "c"}, keys)
+
+	keys = nil
+	iter = types.KVStoreReversePrefixIterator(store, []byte("b"))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	require.NoError(t, iter.Close())
+	require.Equal(t, []string{"b2", "b1"}, keys)
+
+	require.Panics(t, func() { store.Set([]byte("a"), []byte("x")) })
+	require.Panics(t, func() { store.Delete([]byte("a")) })
+
+	// branches of the store are writable
+	cache := store.CacheWrap().(types.KVStore)
+	cache.Set([]byte("a"), []byte("x"))
+	require.Equal(t, []byte("x"), cache.Get([]byte("a")))
+	require.Equal(t, []byte("a"), store.Get([]byte("a")))
+}
+
+func TestStoreQuery(t *testing.T) {
+	a := archive.NewArchive(memdb.NewDB())
+	require.NoError(t, a.OnWrite(storeKey1, []byte("a1"), []byte("1"), false))
+	require.NoError(t, a.OnWrite(storeKey1, []byte("a2"), []byte("2"), false))
+	require.NoError(t, a.OnWrite(storeKey1, []byte("b"), []byte("3"), false))
+	require.NoError(t, a.Commit(4))
+
+	store, err := a.GetStore(4, storeKey1.Name())
+	require.NoError(t, err)
+
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a2"), Height: 4})
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(4), res.Height)
+	require.Equal(t, []byte("2"), res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("a"), Height: 4})
+	require.True(t, res.IsOK())
+	var pairs kv.Pairs
+	require.NoError(t, pairs.Unmarshal(res.Value))
+	require.Equal(t, []kv.Pair{{Key: []byte("a1"), Value: []byte("1")}, {Key: []byte("a2"), Value: []byte("2")}}, pairs.Pairs)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("a2"), Height: 4, Prove: true})
+	require.False(t, res.IsOK())
+
+	res = store.Query(abci.RequestQuery{Path: "/unknown", Data: []byte("a2"), Height: 4})
+	require.False(t, res.IsOK())
+}
@@ -0,0 +1,176 @@
+package archive
+
+import (
+	"fmt"
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/prefix"
+	dbutil "github.com/cosmos/cosmos-sdk/internal/db"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	_ types.KVStore   = (*Store)(nil)
+	_ types.Queryable = (*Store)(nil)
+)
+
+// Store is a read-only view of a store at a version recorded in an Archive.
+// Each operation reads from its own transaction on the archive database, and
+// iterators hold theirs until they are closed.
+type Store struct {
+	db      dbm.Connection
+	version int64
+	prefix  []byte
+}
+
+func (s *Store) reader() prefix.Reader {
+	reader, err := s.db.ReaderAt(uint64(s.version))
+	if err != nil {
+		panic(err)
+	}
+	return prefix.NewReader(reader, s.prefix)
+}
+
+// Get implements the KVStore interface.
+func (s *Store) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	reader := s.reader()
+	defer reader.Discard()
+
+	value, err := reader.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements the KVStore interface.
+func (s *Store) Has(key []byte) bool {
+	types.AssertValidKey(key)
+	reader := s.reader()
+	defer reader.Discard()
+
+	has, err := reader.Has(key)
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// Set implements the KVStore interface. It panics as archived stores are read-only.
+func (s *Store) Set(key, value []byte) {
+	panic("cannot write to an archived store")
+}
+
+// Delete implements the KVStore interface. It panics as archived stores are read-only.
+func (s *Store) Delete(key []byte) {
+	panic("cannot write to an archived store")
+}
+
+// Iterator implements the KVStore interface.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	reader := s.reader()
+	iter, err := reader.Iterator(start, end)
+	if err != nil {
+		reader.Discard()
+		panic(err)
+	}
+	return &storeIterator{AsStoreIter: dbutil.ToStoreIterator(iter), reader: reader}
+}
+
+// ReverseIterator implements the KVStore interface.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	reader := s.reader()
+	iter, err := reader.ReverseIterator(start, end)
+	if err != nil {
+		reader.Discard()
+		panic(err)
+	}
+	return &storeIterator{AsStoreIter: dbutil.ToStoreIterator(iter), reader: reader}
+}
+
+// GetStoreType implements the Store interface.
+func (s *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements the CacheWrapper interface.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// CacheWrapWithListeners implements the CacheWrapper interface.
+func (s *Store) CacheWrapWithListeners(storeKey types.StoreKey, listeners []types.WriteListener) types.CacheWrap {
+	return cachekv.NewStore(listenkv.NewStore(s, storeKey, listeners))
+}
+
+// Query implements the Queryable interface for the "/key" and "/subspace" paths
+// of the IAVL stores. Proofs cannot be provided for archived versions.
+func (s *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"), false)
+	}
+	if req.Prove {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot prove archived height %d", s.version), false)
+	}
+
+	res.Height = s.version
+
+	switch req.Path {
+	case "/key":
+		res.Key = req.Data
+		res.Value = s.Get(req.Data)
+
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+
+		res.Key = req.Data
+
+		iterator := types.KVStorePrefixIterator(s, req.Data)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		iterator.Close()
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("failed to marshal KV pairs: %w", err))
+		}
+
+		res.Value = bz
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path), false)
+	}
+
+	return res
+}
+
+// storeIterator is a store iterator discarding its transaction when closed.
+type storeIterator struct {
+	*dbutil.AsStoreIter
+	reader prefix.Reader
+}
+
+func (it *storeIterator) Close() error {
+	err := it.AsStoreIter.Close()
+	if derr := it.reader.Discard(); err == nil {
+		err = derr
+	}
+	return err
+}
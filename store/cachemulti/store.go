@@ -167,6 +167,25 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithListener branches the multistore like CacheMultiStore,
+// and streams to the given listener the writes of all the stores of the
+// branch as they are flushed to the multistore by its Write. Unlike the
+// listeners added with AddListeners, the listener is not inherited by the
+// branches of the returned multistore, which stream none of their writes.
+func (cms Store) CacheMultiStoreWithListener(listener types.WriteListener) types.CacheMultiStore {
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(cms.stores))
+	for k, v := range cms.stores {
+		stores[k] = v
+		listeners[k] = []types.WriteListener{listener}
+	}
+
+	branch := NewFromKVStore(cms.db, stores, cms.keys, cms.traceWriter, cms.traceContext, listeners)
+	branch.listeners = make(map[types.StoreKey][]types.WriteListener)
+
+	return branch
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -22,3 +24,40 @@ func TestStoreGetKVStore(t *testing.T) {
 	require.PanicsWithValue(errMsg,
 		func() { s.GetKVStore(key) })
 }
+
+type recordingListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Delete: delete, Key: key, Value: value})
+	return nil
+}
+
+func TestCacheMultiStoreWithListener(t *testing.T) {
+	key := types.NewKVStoreKey("abc")
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	parent.Set([]byte("c"), []byte("3"))
+	s := NewStore(dbm.NewMemDB(), map[types.StoreKey]types.CacheWrapper{key: parent}, nil, nil, nil, nil)
+
+	listener := &recordingListener{}
+	branch := s.CacheMultiStoreWithListener(listener)
+	branch.GetKVStore(key).Set([]byte("b"), []byte("2"))
+
+	// the writes of the branches of the branch are not streamed
+	child := branch.CacheMultiStore()
+	child.GetKVStore(key).Set([]byte("a"), []byte("1"))
+	child.GetKVStore(key).Delete([]byte("c"))
+	child.Write()
+	require.Empty(t, listener.pairs)
+	require.Equal(t, []byte("3"), s.GetKVStore(key).Get([]byte("c")))
+
+	branch.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "abc", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "abc", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "abc", Delete: true, Key: []byte("c")},
+	}, listener.pairs)
+	require.Equal(t, []byte("1"), s.GetKVStore(key).Get([]byte("a")))
+	require.Nil(t, s.GetKVStore(key).Get([]byte("c")))
+}
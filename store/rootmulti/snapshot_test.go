@@ -224,6 +224,44 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreParallelSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 3, 10000)
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	version := uint64(source.LastCommitID().Version)
+
+	opts := snapshottypes.NewSnapshotOptions(1, 0)
+	opts.Parallel = true
+	sourceStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceManager := snapshots.NewManager(sourceStore, opts, source, nil, log.NewNopLogger())
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.ParallelFormat, snapshot.Format)
+
+	targetStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetManager := snapshots.NewManager(targetStore, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/pruning"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
@@ -58,11 +59,14 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	archive *archive.Archive
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore            = (*Store)(nil)
+	_ types.Queryable                   = (*Store)(nil)
+	_ snapshottypes.ParallelSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -105,6 +109,13 @@ func (rs *Store) SetIAVLCacheSize(cacheSize int) {
 	rs.iavlCacheSize = cacheSize
 }
 
+// SetStateArchive sets the state archive recording the committed versions of the IAVL
+// stores, and serving the queries of the versions pruned from them. It must be set
+// before the stores are loaded.
+func (rs *Store) SetStateArchive(archive *archive.Archive) {
+	rs.archive = archive
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -236,7 +247,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
-			if err := deleteKVStore(store.(types.KVStore)); err != nil {
+			if err := deleteKVStore(rs.archivedKVStore(key, store)); err != nil {
 				return errors.Wrapf(err, "failed to delete store %s", key.Name())
 			}
 			rs.removalMap[key] = true
@@ -254,7 +265,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			}
 
 			// move all data
-			if err := moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore)); err != nil {
+			if err := moveKVStoreData(rs.archivedKVStore(oldKey, oldStore), rs.archivedKVStore(key, store)); err != nil {
 				return errors.Wrapf(err, "failed to move store %s -> %s", oldName, key.Name())
 			}
 
@@ -273,7 +284,46 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 		return err
 	}
 
-	return nil
+	return rs.loadStateArchive(ver)
+}
+
+// loadStateArchive checks that the state archive, if any, has recorded the loaded version, so
+// that it can record the next ones. An empty archive, or an archive behind the loaded version,
+// e.g. after a crash between the commits of the multistore and of the archive, imports the
+// state of the loaded version. An archive ahead of the loaded version overwrites the versions
+// after it as they are committed again.
+func (rs *Store) loadStateArchive(ver int64) error {
+	if rs.archive == nil || ver == 0 {
+		return nil
+	}
+
+	last := rs.archive.LatestVersion()
+	if last >= ver {
+		return nil
+	}
+
+	rs.logger.Info("importing state in state archive", "height", ver, "archive_height", last)
+	stores := make(map[string]types.KVStore)
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		iavlStore, err := rs.GetCommitKVStore(key).(*iavl.Store).GetImmutable(ver)
+		if err != nil {
+			return err
+		}
+		stores[key.Name()] = iavlStore
+	}
+	return rs.archive.Import(ver, stores)
+}
+
+// archivedKVStore returns the store reporting its writes to the state archive, if any.
+// Only the IAVL stores are archived.
+func (rs *Store) archivedKVStore(key types.StoreKey, store types.CommitKVStore) types.KVStore {
+	if rs.archive == nil || store.GetStoreType() != types.StoreTypeIAVL {
+		return store
+	}
+	return listenkv.NewStore(store, key, []types.WriteListener{rs.archive})
 }
 
 func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) types.CommitID {
@@ -416,7 +466,15 @@ func (rs *Store) Commit() types.CommitID {
 	}
 
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
-	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+	rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
+
+	// the archive is committed once the version is saved, so that it never records a version
+	// which the multistore would not load after a crash
+	if rs.archive != nil {
+		if err := rs.archive.Commit(version); err != nil {
+			panic(err)
+		}
+	}
 
 	// remove remnants of removed stores
 	for sk := range rs.removalMap {
@@ -456,10 +514,14 @@ func (rs *Store) CacheWrapWithListeners(_ types.StoreKey, _ []types.WriteListene
 
 // CacheMultiStore creates ephemeral branch of the multi-store and returns a CacheMultiStore.
 // It implements the MultiStore interface.
+//
+// If a state archive is set, the branch reports the writes it flushes to the stores to the
+// archive. Unlike the listeners added with AddListeners, the archive does not listen to the
+// branches of the returned multistore, so it only records the writes reaching the stores.
 func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
-		stores[k] = v
+		stores[k] = rs.archivedKVStore(k, v)
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners)
 }
@@ -468,6 +530,9 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // attempts to load stores at a given version (height). An error is returned if
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
+//
+// The IAVL stores at a version they no longer have, e.g. because it was pruned,
+// are read from the state archive if it has recorded the version.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
@@ -477,6 +542,11 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 			// it to get the underlying IAVL store.
 			store = rs.GetCommitKVStore(key)
 
+			if archived := rs.getArchivedStore(store, key.Name(), version); archived != nil {
+				cachedStores[key] = archived
+				continue
+			}
+
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
 			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
@@ -528,10 +598,27 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.ListeningEnabled(key) {
 		store = listenkv.NewStore(store, key, rs.listeners[key])
 	}
+	if rs.archive != nil && s.GetStoreType() == types.StoreTypeIAVL {
+		store = listenkv.NewStore(store, key, []types.WriteListener{rs.archive})
+	}
 
 	return store
 }
 
+// getArchivedStore returns the named IAVL store at the given version from the state archive
+// if the store does not have the version but the archive has recorded it, or nil otherwise.
+func (rs *Store) getArchivedStore(store types.CommitKVStore, name string, version int64) *archive.Store {
+	if rs.archive == nil || store.(*iavl.Store).VersionExists(version) || !rs.archive.HasVersion(version) {
+		return nil
+	}
+
+	archived, err := rs.archive.GetStore(version, name)
+	if err != nil {
+		panic(err)
+	}
+	return archived
+}
+
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
 	if !rs.pruningManager.ShouldPruneAtHeight(version) {
@@ -592,6 +679,8 @@ func (rs *Store) GetStoreByName(name string) types.Store {
 // Query calls substore.Query with the same `req` where `req.Path` is
 // modified to remove the substore prefix.
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
+// Queries of IAVL stores at heights they no longer have are served by the state
+// archive, if it has recorded the height, without proofs.
 // TODO: add proof for `multistore -> substore`.
 func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	path := req.Path
@@ -605,6 +694,12 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no such store: %s", storeName), false)
 	}
 
+	if iavlStore, ok := store.(*iavl.Store); ok && req.Height > 0 {
+		if archived := rs.getArchivedStore(iavlStore, storeName, req.Height); archived != nil {
+			store = archived
+		}
+	}
+
 	queryable, ok := store.(types.Queryable)
 	if !ok {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store), false)
@@ -686,80 +781,132 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
-	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
 	}
-	if height > uint64(getLatestVersion(rs.db)) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		if err := exportStore(store.Store, store.name, height, protoWriter); err != nil {
+			return err
+		}
 	}
 
-	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
+	return nil
+}
+
+// SnapshotSubstores implements snapshottypes.ParallelSnapshotter.
+func (rs *Store) SnapshotSubstores(height uint64) ([]string, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, nil
+}
+
+// SnapshotSubstore implements snapshottypes.ParallelSnapshotter. The substore is serialized as in
+// Snapshot, as a SnapshotStore item followed by its SnapshotNode items.
+func (rs *Store) SnapshotSubstore(height uint64, name string, protoWriter protoio.Writer) error {
+	if err := rs.validateSnapshotHeight(height); err != nil {
+		return err
 	}
-	stores := []namedStore{}
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot non-IAVL store %q", name)
+	}
+	return exportStore(store, name, height, protoWriter)
+}
+
+// snapshotNamedStore is an IAVL store to snapshot, along with its name.
+type snapshotNamedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot at the given height, sorted by name.
+func (rs *Store) snapshotStores(height uint64) ([]snapshotNamedStore, error) {
+	if err := rs.validateSnapshotHeight(height); err != nil {
+		return nil, err
+	}
+
+	// Collect stores to snapshot (only IAVL stores are supported)
+	stores := []snapshotNamedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
 		case *iavl.Store:
-			stores = append(stores, namedStore{name: key.Name(), Store: store})
+			stores = append(stores, snapshotNamedStore{name: key.Name(), Store: store})
 		case *transient.Store, *mem.Store:
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
+	return stores, nil
+}
 
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
-	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+func (rs *Store) validateSnapshotHeight(height uint64) error {
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(getLatestVersion(rs.db)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+	return nil
+}
+
+// exportStore writes a SnapshotStore item for the store, followed by a SnapshotNode item for
+// each node of its IAVL tree at the given height.
+func exportStore(store *iavl.Store, name string, height uint64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
-
 	return nil
 }
 
@@ -792,13 +939,9 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
+			importer, err = rs.importStore(item.Store.Name, height)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, err
 			}
 			defer importer.Close()
 
@@ -806,27 +949,8 @@ loop:
 			if importer == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importNode(importer, item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
@@ -842,8 +966,88 @@ loop:
 		importer.Close()
 	}
 
+	return snapshotItem, rs.CommitRestore(height)
+}
+
+// RestoreSubstore implements snapshottypes.ParallelSnapshotter. The first item must be a
+// SnapshotStoreItem, followed only by the SnapshotNodeItems of that store.
+func (rs *Store) RestoreSubstore(height uint64, protoReader protoio.Reader) error {
+	snapshotItem := snapshottypes.SnapshotItem{}
+	err := protoReader.ReadMsg(&snapshotItem)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+	storeItem := snapshotItem.GetStore()
+	if storeItem == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "expected store item, got %T", snapshotItem.Item)
+	}
+	importer, err := rs.importStore(storeItem.Name, height)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		item := snapshotItem.GetIAVL()
+		if item == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in store %q",
+				snapshotItem.Item, storeItem.Name)
+		}
+		if err := importNode(importer, item); err != nil {
+			return err
+		}
+	}
+
+	return sdkerrors.Wrap(importer.Commit(), "IAVL commit failed")
+}
+
+// CommitRestore implements snapshottypes.ParallelSnapshotter.
+func (rs *Store) CommitRestore(height uint64) error {
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
-	return snapshotItem, rs.LoadLatestVersion()
+	return rs.LoadLatestVersion()
+}
+
+// importStore starts importing the nodes of an IAVL store at the given height.
+func (rs *Store) importStore(name string, height uint64) (*iavltree.Importer, error) {
+	store, ok := rs.GetStoreByName(name).(*iavl.Store)
+	if !ok || store == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", name)
+	}
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+	return importer, nil
+}
+
+// importNode adds a snapshotted IAVL node to an importer.
+func importNode(importer *iavltree.Importer, item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	return sdkerrors.Wrap(importer.Add(node), "IAVL node import failed")
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
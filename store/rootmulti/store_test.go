@@ -13,7 +13,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/archive"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	sdkmaps "github.com/cosmos/cosmos-sdk/store/internal/maps"
@@ -467,6 +469,127 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultiStoreStateArchive(t *testing.T) {
+	db := dbm.NewMemDB()
+	stateArchive := archive.NewArchive(memdb.NewDB())
+	multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	multi.SetStateArchive(stateArchive)
+	require.NoError(t, multi.LoadLatestVersion())
+
+	k, k2 := []byte("wind"), []byte("water")
+	for i := 1; i <= 20; i++ {
+		cms := multi.CacheMultiStore()
+		cms.GetKVStore(testStoreKey1).Set(k, []byte(fmt.Sprintf("blows%d", i)))
+		if i == 5 {
+			cms.GetKVStore(testStoreKey2).Set(k2, []byte("flows"))
+		}
+		if i == 6 {
+			cms.GetKVStore(testStoreKey2).Delete(k2)
+		}
+
+		// the writes of the branches are only archived once written to the stores
+		branch := cms.CacheMultiStore()
+		branch.GetKVStore(testStoreKey3).Set(k, []byte(fmt.Sprintf("branch%d", i)))
+		branch.Write()
+		cms.Write()
+
+		// nor are those of unwritten branches
+		multi.CacheMultiStore().GetKVStore(testStoreKey3).Set(k2, []byte("discarded"))
+
+		multi.Commit()
+	}
+	require.Equal(t, int64(20), stateArchive.LatestVersion())
+
+	// pruned heights are read from the archive
+	cms, err := multi.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Equal(t, []byte("blows5"), cms.GetKVStore(testStoreKey1).Get(k))
+	require.Equal(t, []byte("flows"), cms.GetKVStore(testStoreKey2).Get(k2))
+	require.Equal(t, []byte("branch5"), cms.GetKVStore(testStoreKey3).Get(k))
+	require.Nil(t, cms.GetKVStore(testStoreKey3).Get(k2))
+
+	cms, err = multi.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(testStoreKey2).Get(k2))
+
+	// while the retained ones are read from the stores
+	cms, err = multi.CacheMultiStoreWithVersion(20)
+	require.NoError(t, err)
+	require.Equal(t, []byte("blows20"), cms.GetKVStore(testStoreKey1).Get(k))
+
+	query := abci.RequestQuery{Path: "/store1/key", Data: k, Height: 3}
+	qres := multi.Query(query)
+	require.EqualValues(t, 0, qres.Code)
+	require.Equal(t, []byte("blows3"), qres.Value)
+	require.Equal(t, int64(3), qres.Height)
+
+	query.Prove = true
+	qres = multi.Query(query)
+	require.NotEqualValues(t, 0, qres.Code)
+
+	query.Height = 20
+	qres = multi.Query(query)
+	require.EqualValues(t, 0, qres.Code)
+	require.Equal(t, []byte("blows20"), qres.Value)
+	require.NotNil(t, qres.ProofOps)
+
+	// an empty archive is started from the loaded version
+	stateArchive = archive.NewArchive(memdb.NewDB())
+	multi = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+	multi.SetStateArchive(stateArchive)
+	require.NoError(t, multi.LoadLatestVersion())
+	require.Equal(t, int64(20), stateArchive.LatestVersion())
+	archived, err := stateArchive.GetStore(20, testStoreKey3.Name())
+	require.NoError(t, err)
+	require.Equal(t, []byte("branch20"), archived.Get(k))
+
+}
+
+func TestMultiStoreStateArchiveRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	stateArchive := archive.NewArchive(memdb.NewDB())
+	k := []byte("wind")
+	commit := func(value string, withArchive bool) {
+		multi := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything))
+		if withArchive {
+			multi.SetStateArchive(stateArchive)
+		}
+		require.NoError(t, multi.LoadLatestVersion())
+		multi.GetKVStore(testStoreKey1).Set(k, []byte(value))
+		multi.Commit()
+	}
+	archived := func(version int64) []byte {
+		store, err := stateArchive.GetStore(version, testStoreKey1.Name())
+		require.NoError(t, err)
+		return store.Get(k)
+	}
+
+	commit("blows1", true)
+	commit("blows2", true)
+	require.Equal(t, int64(2), stateArchive.LatestVersion())
+
+	// the node crashed after committing the multistore but before the archive, which imports
+	// the state of the loaded version on restart
+	commit("blows3", false)
+	commit("blows4", true)
+	require.Equal(t, int64(4), stateArchive.LatestVersion())
+	require.Equal(t, []byte("blows2"), archived(2))
+	require.Equal(t, []byte("blows3"), archived(3))
+	require.Equal(t, []byte("blows4"), archived(4))
+
+	// the archive recorded a version which the multistore did not, e.g. before a rollback, so
+	// that the replayed version overwrites it
+	require.NoError(t, stateArchive.OnWrite(testStoreKey1, k, []byte("stale5"), false))
+	require.NoError(t, stateArchive.Commit(5))
+	require.NoError(t, stateArchive.Commit(6))
+	commit("blows5", true)
+	require.Equal(t, int64(5), stateArchive.LatestVersion())
+	require.Equal(t, []byte("blows5"), archived(5))
+	commit("blows6", true)
+	require.Equal(t, []byte("blows6"), archived(6))
+	require.Equal(t, []byte("blows4"), archived(4))
+}
+
 func TestMultiStore_Pruning(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +24,7 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
 	// add more in the future
 )
 
@@ -31,6 +33,8 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "grpc":
+		return GRPC
 	default:
 		return Unknown
 	}
@@ -41,6 +45,8 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case GRPC:
+		return "grpc"
 	default:
 		return "unknown"
 	}
@@ -49,6 +55,7 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
+	GRPC: NewGRPCStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,6 +77,20 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for creating a gRPC StreamingService
+func NewGRPCStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	cfg := grpc.DefaultConfig()
+	if address := cast.ToString(opts.Get("streamers.grpc.address")); address != "" {
+		cfg.Address = address
+	}
+	if bufferSize := opts.Get("streamers.grpc.buffer_size"); bufferSize != nil {
+		cfg.BufferSize = cast.ToInt(bufferSize)
+	}
+	cfg.Required = cast.ToStringSlice(opts.Get("streamers.grpc.required"))
+	cfg.HaltTimeout = cast.ToDuration(opts.Get("streamers.grpc.halt_timeout"))
+	return grpc.NewStreamingService(cfg, keys)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -119,7 +140,14 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 		// kick off the background streaming service loop
-		streamingService.Stream(wg)
+		if err := streamingService.Stream(wg); err != nil {
+			// close any services we may have already spun up, including this one, before returning the error
+			streamingService.Close()
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, err
+		}
 		// add to the list of active streamers
 		activeStreamers = append(activeStreamers, streamingService)
 	}
@@ -9,6 +9,7 @@ import (
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +49,17 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		_, ok := listeners[key]
 		require.True(t, ok)
 	}
+
+	constructor, err = streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+	serv, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners = serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
 }
 
 func TestLoadStreamingServices(t *testing.T) {
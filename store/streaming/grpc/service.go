@@ -0,0 +1,358 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ baseapp.StreamingService   = &StreamingService{}
+	_ baseapp.ABCICommitListener = &StreamingService{}
+	_ types.StreamingServer      = &StreamingService{}
+)
+
+const (
+	// DefaultAddress is the default address the gRPC server of the StreamingService listens on.
+	DefaultAddress = "localhost:9095"
+	// DefaultBufferSize is the default number of blocks buffered for each subscriber.
+	DefaultBufferSize = 16
+)
+
+// Config defines the configuration of a StreamingService.
+type Config struct {
+	// Address is the address the gRPC server listens on.
+	Address string
+	// BufferSize is the number of blocks buffered for each subscriber.
+	BufferSize int
+	// Required is the names of the subscribers the service requires. Their blocks are
+	// buffered across their subscriptions, and the node waits for them to be consumed
+	// when their buffers are full.
+	Required []string
+	// HaltTimeout is how long the node waits for a required subscriber to consume a block
+	// before halting. Zero waits indefinitely.
+	HaltTimeout time.Duration
+}
+
+// DefaultConfig returns the default configuration of a StreamingService.
+func DefaultConfig() Config {
+	return Config{
+		Address:    DefaultAddress,
+		BufferSize: DefaultBufferSize,
+	}
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes the change sets
+// of the committed blocks, along with their ABCI messages, to the subscribers of a gRPC service
+type StreamingService struct {
+	listeners   map[types.StoreKey][]types.WriteListener // the keys of the exposed stores, whose change sets are collected by the BaseApp
+	address     string                                   // address the gRPC server listens on
+	bufferSize  int                                      // number of blocks buffered for each subscriber
+	haltTimeout time.Duration                            // how long to wait for a required subscriber before halting, zero waits indefinitely
+	block       *types.BlockChanges                      // the block being processed
+
+	mtx         sync.Mutex               // mutex for the subscribers
+	required    map[string]*subscriber   // the subscribers required by the service, by name
+	subscribers map[*subscriber]struct{} // the subscribers not required by the service
+	server      *grpc.Server             // the gRPC server, set by Stream
+	listener    net.Listener             // the listener of the gRPC server
+	quitChan    chan struct{}            // channel to synchronize closure
+}
+
+// subscriber is the queue of the blocks to send to a subscriber.
+type subscriber struct {
+	name     string
+	queue    chan *types.BlockChanges
+	dropped  chan struct{}       // closed when a subscriber not required falls behind
+	attached bool                // whether a stream is attached to a required subscriber
+	unsent   *types.BlockChanges // block a required subscriber failed to receive, sent first on its next subscription
+}
+
+// NewStreamingService creates a new StreamingService exposing the change sets of the provided storeKeys
+func NewStreamingService(cfg Config, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if cfg.Address == "" {
+		return nil, errors.New("gRPC streaming service address cannot be empty")
+	}
+	if cfg.BufferSize < 0 {
+		return nil, fmt.Errorf("invalid gRPC streaming service buffer size %d", cfg.BufferSize)
+	}
+	if cfg.HaltTimeout < 0 {
+		return nil, fmt.Errorf("invalid gRPC streaming service halt timeout %s", cfg.HaltTimeout)
+	}
+
+	// the change sets are collected by the BaseApp for the exposed stores,
+	// the service has no listeners of its own
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		listeners[key] = nil
+	}
+	required := make(map[string]*subscriber, len(cfg.Required))
+	for _, name := range cfg.Required {
+		if name == "" {
+			return nil, errors.New("gRPC streaming service required subscriber name cannot be empty")
+		}
+		required[name] = &subscriber{
+			name:  name,
+			queue: make(chan *types.BlockChanges, cfg.BufferSize),
+		}
+	}
+	return &StreamingService{
+		listeners:   listeners,
+		address:     cfg.Address,
+		bufferSize:  cfg.BufferSize,
+		haltTimeout: cfg.HaltTimeout,
+		required:    required,
+		subscribers: make(map[*subscriber]struct{}),
+		quitChan:    make(chan struct{}),
+	}, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the exposed StoreKeys without WriteListeners, as the BaseApp
+// collects the change sets passed to ListenCommit
+func (gss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return gss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts the block with the received BeginBlock request and response
+func (gss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	gss.block = &types.BlockChanges{
+		Height:             req.Header.Height,
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	}
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It adds the received DeliverTx request and response to the block
+func (gss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	block := gss.currentBlock(ctx)
+	block.DeliverTxs = append(block.DeliverTxs, &types.BlockChanges_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It adds the received EndBlock request and response to the block
+func (gss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	block := gss.currentBlock(ctx)
+	block.RequestEndBlock = &req
+	block.ResponseEndBlock = &res
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCICommitListener interface
+// It completes the block with the received Commit response and change set, and pushes it
+// to the subscribers. It waits for the required subscribers whose buffers are full, and
+// returns an error wrapping baseapp.ErrHaltNode if one of them does not catch up within
+// the halt timeout. The subscribers not required are dropped when their buffers are full.
+func (gss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	block := gss.currentBlock(ctx)
+	gss.block = nil
+
+	// sort the change set by store key then by key
+	sort.SliceStable(changeSet, func(i, j int) bool {
+		if changeSet[i].StoreKey != changeSet[j].StoreKey {
+			return changeSet[i].StoreKey < changeSet[j].StoreKey
+		}
+		return bytes.Compare(changeSet[i].Key, changeSet[j].Key) < 0
+	})
+	block.ResponseCommit = &res
+	block.ChangeSet = changeSet
+
+	gss.mtx.Lock()
+	for sub := range gss.subscribers {
+		select {
+		case sub.queue <- block:
+		default:
+			// drop the subscribers falling behind rather than waiting for them
+			delete(gss.subscribers, sub)
+			close(sub.dropped)
+		}
+	}
+	gss.mtx.Unlock()
+
+	// the required subscribers are immutable, no need to lock
+	var err error
+	for _, sub := range gss.required {
+		if perr := gss.push(sub, block); perr != nil && err == nil {
+			err = perr
+		}
+	}
+	return err
+}
+
+// currentBlock returns the block being processed, starting one at the height of the context
+// if the service missed its BeginBlock.
+func (gss *StreamingService) currentBlock(ctx sdk.Context) *types.BlockChanges {
+	if gss.block == nil {
+		gss.block = &types.BlockChanges{Height: ctx.BlockHeight()}
+	}
+	return gss.block
+}
+
+// push queues the block for a required subscriber, waiting for room in its buffer.
+func (gss *StreamingService) push(sub *subscriber, block *types.BlockChanges) error {
+	select {
+	case sub.queue <- block:
+		return nil
+	default:
+	}
+
+	var timeout <-chan time.Time
+	if gss.haltTimeout > 0 {
+		timer := time.NewTimer(gss.haltTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case sub.queue <- block:
+		return nil
+	case <-timeout:
+		return fmt.Errorf("%w: required subscriber %s fell behind at height %d", baseapp.ErrHaltNode, sub.name, block.Height)
+	case <-gss.quitChan:
+		return errors.New("gRPC streaming service closed")
+	}
+}
+
+// Subscribe satisfies the types.StreamingServer interface
+// It streams the blocks committed from the subscription on, or from the last block received
+// by a required subscriber. Only one stream can be attached to a required subscriber at a time.
+func (gss *StreamingService) Subscribe(req *types.SubscribeRequest, stream types.Streaming_SubscribeServer) error {
+	sub, err := gss.subscribe(req.Name)
+	if err != nil {
+		return err
+	}
+	defer gss.unsubscribe(sub)
+
+	if sub.unsent != nil {
+		if err := stream.Send(sub.unsent); err != nil {
+			return err
+		}
+		sub.unsent = nil
+	}
+	for {
+		select {
+		case block := <-sub.queue:
+			if err := stream.Send(block); err != nil {
+				sub.unsent = block
+				return err
+			}
+		case <-sub.dropped:
+			return status.Errorf(codes.ResourceExhausted, "subscriber %s fell behind", sub.name)
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-gss.quitChan:
+			return status.Error(codes.Unavailable, "gRPC streaming service closed")
+		}
+	}
+}
+
+func (gss *StreamingService) subscribe(name string) (*subscriber, error) {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	if sub, ok := gss.required[name]; ok {
+		if sub.attached {
+			return nil, status.Errorf(codes.AlreadyExists, "required subscriber %s is already subscribed", name)
+		}
+		sub.attached = true
+		return sub, nil
+	}
+	sub := &subscriber{
+		name:    name,
+		queue:   make(chan *types.BlockChanges, gss.bufferSize),
+		dropped: make(chan struct{}),
+	}
+	gss.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+func (gss *StreamingService) unsubscribe(sub *subscriber) {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	if _, ok := gss.required[sub.name]; ok {
+		sub.attached = false
+		return
+	}
+	delete(gss.subscribers, sub)
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It starts the gRPC server serving the subscriptions in a goroutine
+// returns an error if it is called twice
+func (gss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	if gss.server != nil {
+		return errors.New("`Stream` has already been called")
+	}
+	listener, err := net.Listen("tcp", gss.address)
+	if err != nil {
+		return err
+	}
+	// the messages streamed hold no interfaces, an empty registry is enough to encode them
+	gss.server = grpc.NewServer(grpc.ForceServerCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec()))
+	gss.listener = listener
+	types.RegisterStreamingServer(gss.server, gss)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = gss.server.Serve(listener)
+	}()
+	return nil
+}
+
+// Addr returns the address the gRPC server listens on, or nil if it is not started.
+func (gss *StreamingService) Addr() net.Addr {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+
+	if gss.listener == nil {
+		return nil
+	}
+	return gss.listener.Addr()
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It ends the subscriptions and stops the gRPC server
+func (gss *StreamingService) Close() error {
+	gss.mtx.Lock()
+	select {
+	case <-gss.quitChan:
+		gss.mtx.Unlock()
+		return nil
+	default:
+		close(gss.quitChan)
+	}
+	server := gss.server
+	gss.mtx.Unlock()
+
+	// the subscriptions being stopped need the lock to unsubscribe
+	if server != nil {
+		server.Stop()
+	}
+	return nil
+}
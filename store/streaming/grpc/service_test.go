@@ -0,0 +1,181 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = types.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = types.NewKVStoreKey("mockStore2")
+	emptyContext  = sdk.Context{}
+
+	// test abci message types
+	testDeliverTxReq = abci.RequestDeliverTx{Tx: []byte{9, 8, 7}}
+	testDeliverTxRes = abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	testEndBlockRes  = abci.ResponseEndBlock{Events: []abci.Event{{Type: "testEventType"}}}
+	testCommitRes    = abci.ResponseCommit{Data: []byte{1, 2, 3}}
+)
+
+func newTestStreamingService(t *testing.T, cfg Config) *StreamingService {
+	cfg.Address = "127.0.0.1:0"
+	gss, err := NewStreamingService(cfg, []types.StoreKey{mockStoreKey1, mockStoreKey2})
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, gss.Stream(wg))
+	require.Error(t, gss.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, gss.Close())
+		wg.Wait()
+	})
+	return gss
+}
+
+func subscribe(t *testing.T, gss *StreamingService, name string) types.Streaming_SubscribeClient {
+	conn, err := grpc.Dial(
+		gss.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec.NewProtoCodec(codecTypes.NewInterfaceRegistry()).GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	stream, err := types.NewStreamingClient(conn).Subscribe(context.Background(), &types.SubscribeRequest{Name: name})
+	require.NoError(t, err)
+	return stream
+}
+
+// commitBlock runs the listening hooks of a block with a tx and the given change set.
+func commitBlock(t *testing.T, gss *StreamingService, height int64, changeSet []*types.StoreKVPair) error {
+	req := abci.RequestBeginBlock{Header: types1.Header{Height: height}}
+	require.NoError(t, gss.ListenBeginBlock(emptyContext, req, abci.ResponseBeginBlock{}))
+	require.NoError(t, gss.ListenDeliverTx(emptyContext, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, gss.ListenEndBlock(emptyContext, abci.RequestEndBlock{Height: height}, testEndBlockRes))
+	return gss.ListenCommit(emptyContext, testCommitRes, changeSet)
+}
+
+func subscribersLen(gss *StreamingService) int {
+	gss.mtx.Lock()
+	defer gss.mtx.Unlock()
+	return len(gss.subscribers)
+}
+
+func TestStreamingService(t *testing.T) {
+	gss := newTestStreamingService(t, DefaultConfig())
+	listeners := gss.Listeners()
+	require.Len(t, listeners, 2)
+	require.Contains(t, listeners, mockStoreKey1)
+	require.Contains(t, listeners, mockStoreKey2)
+
+	stream := subscribe(t, gss, "")
+	require.Eventually(t, func() bool { return subscribersLen(gss) == 1 }, time.Second, 10*time.Millisecond)
+
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey2.Name(), Key: []byte("b"), Value: []byte("1")},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("c"), Delete: true},
+		{StoreKey: mockStoreKey1.Name(), Key: []byte("a"), Value: []byte("2")},
+	}
+	require.NoError(t, commitBlock(t, gss, 1, changeSet))
+
+	block, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+	require.Equal(t, int64(1), block.RequestBeginBlock.Header.Height)
+	require.Len(t, block.DeliverTxs, 1)
+	require.Equal(t, testDeliverTxReq, *block.DeliverTxs[0].Request)
+	require.Equal(t, testDeliverTxRes.Log, block.DeliverTxs[0].Response.Log)
+	require.Equal(t, int64(1), block.RequestEndBlock.Height)
+	require.Equal(t, testEndBlockRes.Events[0].Type, block.ResponseEndBlock.Events[0].Type)
+	require.Equal(t, testCommitRes.Data, block.ResponseCommit.Data)
+
+	// the change set is ordered by store key then by key
+	require.Len(t, block.ChangeSet, 3)
+	require.Equal(t, []byte("a"), block.ChangeSet[0].Key)
+	require.Equal(t, []byte("c"), block.ChangeSet[1].Key)
+	require.True(t, block.ChangeSet[1].Delete)
+	require.Equal(t, mockStoreKey2.Name(), block.ChangeSet[2].StoreKey)
+
+	require.NoError(t, commitBlock(t, gss, 2, nil))
+	block, err = stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), block.Height)
+	require.Empty(t, block.ChangeSet)
+}
+
+func TestRequiredSubscriber(t *testing.T) {
+	gss := newTestStreamingService(t, Config{
+		BufferSize:  1,
+		Required:    []string{"indexer"},
+		HaltTimeout: 50 * time.Millisecond,
+	})
+
+	// the blocks of a required subscriber are buffered until it subscribes,
+	// the node halts once the buffer is full for longer than the timeout
+	require.NoError(t, commitBlock(t, gss, 1, nil))
+	err := commitBlock(t, gss, 2, nil)
+	require.ErrorIs(t, err, baseapp.ErrHaltNode)
+
+	stream := subscribe(t, gss, "indexer")
+	block, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), block.Height)
+
+	// only one stream can be attached to a required subscriber
+	_, err = subscribe(t, gss, "indexer").Recv()
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// the node waits for the required subscriber to catch up
+	require.NoError(t, commitBlock(t, gss, 3, nil))
+	require.NoError(t, commitBlock(t, gss, 4, nil))
+	for _, height := range []int64{3, 4} {
+		block, err = stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, block.Height)
+	}
+}
+
+func TestSubscriberDropped(t *testing.T) {
+	gss, err := NewStreamingService(Config{Address: DefaultAddress, BufferSize: 1}, nil)
+	require.NoError(t, err)
+
+	sub, err := gss.subscribe("")
+	require.NoError(t, err)
+
+	// the subscribers not required are dropped rather than waited for
+	require.NoError(t, commitBlock(t, gss, 1, nil))
+	require.NoError(t, commitBlock(t, gss, 2, nil))
+	require.Equal(t, 0, subscribersLen(gss))
+	select {
+	case <-sub.dropped:
+	default:
+		t.Fatal("subscriber not dropped")
+	}
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := NewStreamingService(Config{}, nil)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Address: DefaultAddress, BufferSize: -1}, nil)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Address: DefaultAddress, HaltTimeout: -time.Second}, nil)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Address: DefaultAddress, Required: []string{""}}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,1282 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/streaming.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/tendermint/tendermint/abci/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeRequest is the request type for the Streaming/Subscribe RPC method.
+//
+// Since: cosmos-sdk 0.47
+type SubscribeRequest struct {
+	// name identifies the subscriber. The blocks of the subscribers the service
+	// requires are buffered across their subscriptions.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{0}
+}
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+}
+func (m *SubscribeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// BlockChanges is the change set of a committed block, along with the ABCI
+// requests and responses of the block.
+//
+// Since: cosmos-sdk 0.47
+type BlockChanges struct {
+	Height             int64                     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	RequestBeginBlock  *types.RequestBeginBlock  `protobuf:"bytes,2,opt,name=request_begin_block,json=requestBeginBlock,proto3" json:"request_begin_block,omitempty"`
+	ResponseBeginBlock *types.ResponseBeginBlock `protobuf:"bytes,3,opt,name=response_begin_block,json=responseBeginBlock,proto3" json:"response_begin_block,omitempty"`
+	DeliverTxs         []*BlockChanges_DeliverTx `protobuf:"bytes,4,rep,name=deliver_txs,json=deliverTxs,proto3" json:"deliver_txs,omitempty"`
+	RequestEndBlock    *types.RequestEndBlock    `protobuf:"bytes,5,opt,name=request_end_block,json=requestEndBlock,proto3" json:"request_end_block,omitempty"`
+	ResponseEndBlock   *types.ResponseEndBlock   `protobuf:"bytes,6,opt,name=response_end_block,json=responseEndBlock,proto3" json:"response_end_block,omitempty"`
+	ResponseCommit     *types.ResponseCommit     `protobuf:"bytes,7,opt,name=response_commit,json=responseCommit,proto3" json:"response_commit,omitempty"`
+	// change_set is the writes and deletes committed to the exposed stores by the
+	// block, ordered by store key then by key.
+	ChangeSet []*StoreKVPair `protobuf:"bytes,8,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *BlockChanges) Reset()         { *m = BlockChanges{} }
+func (m *BlockChanges) String() string { return proto.CompactTextString(m) }
+func (*BlockChanges) ProtoMessage()    {}
+func (*BlockChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{1}
+}
+func (m *BlockChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockChanges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockChanges.Merge(m, src)
+}
+func (m *BlockChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockChanges proto.InternalMessageInfo
+
+func (m *BlockChanges) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockChanges) GetRequestBeginBlock() *types.RequestBeginBlock {
+	if m != nil {
+		return m.RequestBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetResponseBeginBlock() *types.ResponseBeginBlock {
+	if m != nil {
+		return m.ResponseBeginBlock
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetDeliverTxs() []*BlockChanges_DeliverTx {
+	if m != nil {
+		return m.DeliverTxs
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetRequestEndBlock() *types.RequestEndBlock {
+	if m != nil {
+		return m.RequestEndBlock
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetResponseEndBlock() *types.ResponseEndBlock {
+	if m != nil {
+		return m.ResponseEndBlock
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetResponseCommit() *types.ResponseCommit {
+	if m != nil {
+		return m.ResponseCommit
+	}
+	return nil
+}
+
+func (m *BlockChanges) GetChangeSet() []*StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+// DeliverTx is the request and response of a tx of the block.
+type BlockChanges_DeliverTx struct {
+	Request  *types.RequestDeliverTx  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response *types.ResponseDeliverTx `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *BlockChanges_DeliverTx) Reset()         { *m = BlockChanges_DeliverTx{} }
+func (m *BlockChanges_DeliverTx) String() string { return proto.CompactTextString(m) }
+func (*BlockChanges_DeliverTx) ProtoMessage()    {}
+func (*BlockChanges_DeliverTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{1, 0}
+}
+func (m *BlockChanges_DeliverTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockChanges_DeliverTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockChanges_DeliverTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockChanges_DeliverTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockChanges_DeliverTx.Merge(m, src)
+}
+func (m *BlockChanges_DeliverTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockChanges_DeliverTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockChanges_DeliverTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockChanges_DeliverTx proto.InternalMessageInfo
+
+func (m *BlockChanges_DeliverTx) GetRequest() *types.RequestDeliverTx {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *BlockChanges_DeliverTx) GetResponse() *types.ResponseDeliverTx {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeRequest)(nil), "cosmos.base.store.v1beta1.SubscribeRequest")
+	proto.RegisterType((*BlockChanges)(nil), "cosmos.base.store.v1beta1.BlockChanges")
+	proto.RegisterType((*BlockChanges_DeliverTx)(nil), "cosmos.base.store.v1beta1.BlockChanges.DeliverTx")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/streaming.proto", fileDescriptor_20155f3e7501d264)
+}
+
+var fileDescriptor_20155f3e7501d264 = []byte{
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x14, 0x8c, 0x49, 0x9b, 0xd6, 0x2f, 0x88, 0x96, 0x05, 0x21, 0x13, 0x24, 0x13, 0x82, 0x54, 0x82,
+	0x10, 0x6b, 0x12, 0x8e, 0x48, 0x1c, 0x52, 0x2a, 0x21, 0x81, 0x04, 0x72, 0x80, 0x03, 0x17, 0xcb,
+	0x1f, 0x4f, 0xce, 0xaa, 0xf1, 0x3a, 0xec, 0x6e, 0xaa, 0xf2, 0x0f, 0x38, 0xf2, 0xb3, 0x38, 0xf6,
+	0xc8, 0xb1, 0x4a, 0xfe, 0x08, 0xf2, 0xfa, 0x23, 0x1f, 0xe0, 0x88, 0x53, 0xbc, 0x2f, 0x33, 0xb3,
+	0xf3, 0x46, 0x3b, 0xf0, 0x34, 0x4c, 0x65, 0x92, 0x4a, 0x27, 0xf0, 0x25, 0x3a, 0x52, 0xa5, 0x02,
+	0x9d, 0x8b, 0x41, 0x80, 0xca, 0x1f, 0x38, 0x52, 0x09, 0xf4, 0x13, 0xc6, 0x63, 0x3a, 0x13, 0xa9,
+	0x4a, 0xc9, 0xfd, 0x1c, 0x4a, 0x33, 0x28, 0xd5, 0x50, 0x5a, 0x40, 0x3b, 0x0f, 0x14, 0xf2, 0x08,
+	0x45, 0xc2, 0xb8, 0x72, 0xfc, 0x20, 0x64, 0x8e, 0xfa, 0x3e, 0x43, 0x99, 0xf3, 0x3a, 0x3b, 0xae,
+	0x98, 0x32, 0xa9, 0x90, 0x57, 0x57, 0xf4, 0x4e, 0xe0, 0x78, 0x3c, 0x0f, 0x64, 0x28, 0x58, 0x80,
+	0x2e, 0x7e, 0x9b, 0xa3, 0x54, 0x84, 0xc0, 0x1e, 0xf7, 0x13, 0xb4, 0x8c, 0xae, 0xd1, 0x37, 0x5d,
+	0xfd, 0xdd, 0xbb, 0xde, 0x87, 0x9b, 0xa3, 0x69, 0x1a, 0x9e, 0x9f, 0x4e, 0x7c, 0x1e, 0xa3, 0x24,
+	0xf7, 0xa0, 0x35, 0x41, 0x16, 0x4f, 0x94, 0x86, 0x35, 0xdd, 0xe2, 0x44, 0x5c, 0xb8, 0x23, 0x72,
+	0x1d, 0x2f, 0xc0, 0x98, 0x71, 0x2f, 0xc8, 0x58, 0xd6, 0x8d, 0xae, 0xd1, 0x6f, 0x0f, 0x7b, 0x74,
+	0x65, 0x9b, 0x66, 0xb6, 0x69, 0x71, 0xe7, 0x28, 0x83, 0x6a, 0x7d, 0xf7, 0xb6, 0xd8, 0x1e, 0x91,
+	0xcf, 0x70, 0x57, 0xa0, 0x9c, 0xa5, 0x5c, 0xe2, 0x86, 0x68, 0x53, 0x8b, 0x3e, 0xfe, 0x87, 0x68,
+	0x0e, 0x5e, 0x53, 0x25, 0xe2, 0xaf, 0x19, 0x71, 0xa1, 0x1d, 0xe1, 0x94, 0x5d, 0xa0, 0xf0, 0xd4,
+	0xa5, 0xb4, 0xf6, 0xba, 0xcd, 0x7e, 0x7b, 0x38, 0xa0, 0xb5, 0xa1, 0xd3, 0xf5, 0x00, 0xe8, 0x9b,
+	0x9c, 0xfa, 0xe9, 0xd2, 0x85, 0xa8, 0xfc, 0x94, 0xe4, 0x3d, 0x94, 0xfe, 0x3d, 0xe4, 0x51, 0xe1,
+	0x73, 0x5f, 0xfb, 0xec, 0xd6, 0x2d, 0x7f, 0xc6, 0xa3, 0xdc, 0xe4, 0x91, 0xd8, 0x1c, 0x90, 0x0f,
+	0x50, 0xf9, 0x5e, 0x93, 0x6b, 0x69, 0xb9, 0x47, 0xb5, 0x6b, 0x57, 0x7a, 0xc7, 0x62, 0x6b, 0x42,
+	0xde, 0xc2, 0x51, 0x25, 0x18, 0xa6, 0x49, 0xc2, 0x94, 0x75, 0xa0, 0xd5, 0x1e, 0xd6, 0xaa, 0x9d,
+	0x6a, 0x98, 0x7b, 0x4b, 0x6c, 0x9c, 0xc9, 0x19, 0x40, 0xa8, 0x93, 0xf0, 0x24, 0x2a, 0xeb, 0x50,
+	0x67, 0x77, 0xb2, 0x23, 0xbb, 0x71, 0x76, 0x7a, 0xf7, 0xe5, 0xa3, 0xcf, 0x84, 0x6b, 0xe6, 0xcc,
+	0x31, 0xaa, 0xce, 0x0f, 0x03, 0xcc, 0x2a, 0x49, 0xf2, 0x0a, 0x0e, 0x8a, 0x08, 0x2c, 0xa3, 0x76,
+	0x49, 0xfd, 0xff, 0x2a, 0xfd, 0x92, 0x41, 0x5e, 0xc3, 0x61, 0xe9, 0x71, 0xc7, 0x73, 0xcb, 0x01,
+	0x2b, 0x7a, 0xc5, 0x19, 0xce, 0xc0, 0x1c, 0x97, 0x05, 0x24, 0x21, 0x98, 0x55, 0x2f, 0xc8, 0xb3,
+	0x5d, 0x7b, 0x6d, 0xb5, 0xa7, 0xf3, 0xe4, 0x3f, 0x1f, 0xd0, 0x0b, 0x63, 0x34, 0xfa, 0xb5, 0xb0,
+	0x8d, 0xab, 0x85, 0x6d, 0x5c, 0x2f, 0x6c, 0xe3, 0xe7, 0xd2, 0x6e, 0x5c, 0x2d, 0xed, 0xc6, 0xef,
+	0xa5, 0xdd, 0xf8, 0xda, 0x8f, 0x99, 0x9a, 0xcc, 0x03, 0x1a, 0xa6, 0x89, 0x53, 0x94, 0x39, 0xff,
+	0x79, 0x2e, 0xa3, 0xf3, 0xa2, 0xd2, 0xba, 0xf1, 0x41, 0x4b, 0xf7, 0xf8, 0xe5, 0x9f, 0x01, 0x00,
+	0xec, 0xb9, 0xf7, 0xc5, 0x57, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamingClient is the client API for Streaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamingClient interface {
+	// Subscribe streams the changes of the blocks committed from the subscription on, in order.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error)
+}
+
+type streamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamingClient(cc grpc1.ClientConn) StreamingClient {
+	return &streamingClient{cc}
+}
+
+func (c *streamingClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Streaming_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Streaming_serviceDesc.Streams[0], "/cosmos.base.store.v1beta1.Streaming/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamingSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Streaming_SubscribeClient interface {
+	Recv() (*BlockChanges, error)
+	grpc.ClientStream
+}
+
+type streamingSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamingSubscribeClient) Recv() (*BlockChanges, error) {
+	m := new(BlockChanges)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamingServer is the server API for Streaming service.
+type StreamingServer interface {
+	// Subscribe streams the changes of the blocks committed from the subscription on, in order.
+	Subscribe(*SubscribeRequest, Streaming_SubscribeServer) error
+}
+
+// UnimplementedStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamingServer struct {
+}
+
+func (*UnimplementedStreamingServer) Subscribe(req *SubscribeRequest, srv Streaming_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterStreamingServer(s grpc1.Server, srv StreamingServer) {
+	s.RegisterService(&_Streaming_serviceDesc, srv)
+}
+
+func _Streaming_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamingServer).Subscribe(m, &streamingSubscribeServer{stream})
+}
+
+type Streaming_SubscribeServer interface {
+	Send(*BlockChanges) error
+	grpc.ServerStream
+}
+
+type streamingSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamingSubscribeServer) Send(m *BlockChanges) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Streaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.Streaming",
+	HandlerType: (*StreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Streaming_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/store/v1beta1/streaming.proto",
+}
+
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStreaming(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ResponseCommit != nil {
+		{
+			size, err := m.ResponseCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ResponseEndBlock != nil {
+		{
+			size, err := m.ResponseEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RequestEndBlock != nil {
+		{
+			size, err := m.RequestEndBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeliverTxs) > 0 {
+		for iNdEx := len(m.DeliverTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeliverTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ResponseBeginBlock != nil {
+		{
+			size, err := m.ResponseBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RequestBeginBlock != nil {
+		{
+			size, err := m.RequestBeginBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockChanges_DeliverTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockChanges_DeliverTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockChanges_DeliverTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	if m.RequestBeginBlock != nil {
+		l = m.RequestBeginBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.ResponseBeginBlock != nil {
+		l = m.ResponseBeginBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.DeliverTxs) > 0 {
+		for _, e := range m.DeliverTxs {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.RequestEndBlock != nil {
+		l = m.RequestEndBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.ResponseEndBlock != nil {
+		l = m.ResponseEndBlock.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.ResponseCommit != nil {
+		l = m.ResponseCommit.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockChanges_DeliverTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestBeginBlock == nil {
+				m.RequestBeginBlock = &types.RequestBeginBlock{}
+			}
+			if err := m.RequestBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseBeginBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseBeginBlock == nil {
+				m.ResponseBeginBlock = &types.ResponseBeginBlock{}
+			}
+			if err := m.ResponseBeginBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliverTxs = append(m.DeliverTxs, &BlockChanges_DeliverTx{})
+			if err := m.DeliverTxs[len(m.DeliverTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequestEndBlock == nil {
+				m.RequestEndBlock = &types.RequestEndBlock{}
+			}
+			if err := m.RequestEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseEndBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseEndBlock == nil {
+				m.ResponseEndBlock = &types.ResponseEndBlock{}
+			}
+			if err := m.ResponseEndBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResponseCommit == nil {
+				m.ResponseCommit = &types.ResponseCommit{}
+			}
+			if err := m.ResponseCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockChanges_DeliverTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeliverTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeliverTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &types.RequestDeliverTx{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &types.ResponseDeliverTx{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
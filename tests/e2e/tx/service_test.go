@@ -31,6 +31,7 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authtest "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -232,6 +233,35 @@ func (s IntegrationTestSuite) TestSimulateTx_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestSimulateTx_GRPCWithStateDiff() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes, StateDiff: true})
+	s.Require().NoError(err)
+	s.Require().True(res.GetGasInfo().GetGasUsed() > 0)
+	s.Require().NotEmpty(res.StateDiff)
+
+	// The tx sends coins to its sender, so the only balance change of the
+	// validator is the fee paid to the fee collector.
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	balanceChanges := make(map[string]tx.BalanceChange)
+	for _, change := range res.BalanceChanges {
+		balanceChanges[change.Address] = change
+	}
+	s.Require().False(balanceChanges[val.Address.String()].Sent.IsZero())
+	s.Require().True(balanceChanges[val.Address.String()].Received.IsZero())
+	s.Require().Equal(balanceChanges[val.Address.String()].Sent, balanceChanges[feeCollector].Received)
+
+	// the simulation without state diff does not return it
+	res, err = s.queryClient.Simulate(context.Background(), &tx.SimulateRequest{TxBytes: txBytes})
+	s.Require().NoError(err)
+	s.Require().Empty(res.StateDiff)
+	s.Require().Empty(res.BalanceChanges)
+}
+
 func (s IntegrationTestSuite) TestSimulateTx_GRPCGateway() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
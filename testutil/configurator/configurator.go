@@ -4,6 +4,7 @@ import (
 	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	authzmodulev1 "cosmossdk.io/api/cosmos/authz/module/v1"
 	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	distrmodulev1 "cosmossdk.io/api/cosmos/distribution/module/v1"
 	feegrantmodulev1 "cosmossdk.io/api/cosmos/feegrant/module/v1"
@@ -179,6 +180,15 @@ func FeegrantModule() ModuleOption {
 	}
 }
 
+func AuthzModule() ModuleOption {
+	return func(config *appConfig) {
+		config.moduleConfigs["authz"] = &appv1alpha1.ModuleConfig{
+			Name:   "authz",
+			Config: appconfig.WrapAny(&authzmodulev1.Module{}),
+		}
+	}
+}
+
 func VestingModule() ModuleOption {
 	return func(config *appConfig) {
 		config.moduleConfigs["vesting"] = &appv1alpha1.ModuleConfig{
@@ -0,0 +1,98 @@
+package valuerenderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// anyValueRenderer implements ValueRenderer for google.protobuf.Any. An Any is
+// rendered as its type URL, followed by the indented fields of the packed
+// message:
+//
+//	/cosmos.bank.v1beta1.MsgSend
+//	> From address: cosmos1...
+//	> To address: cosmos1...
+//
+// Packed messages rendered by a dedicated value renderer, such as timestamps,
+// are indented as a whole below the type URL.
+type anyValueRenderer struct {
+	tr *Textual
+	md protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = anyValueRenderer{}
+
+func (vr anyValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+	fields := msg.Descriptor().Fields()
+	typeURL := msg.Get(fields.ByName("type_url")).String()
+	value := msg.Get(fields.ByName("value")).Bytes()
+
+	mt, err := vr.tr.typeResolver.FindMessageByURL(typeURL)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve type URL %q: %w", typeURL, err)
+	}
+
+	internal := mt.New()
+	if err := proto.Unmarshal(value, internal.Interface()); err != nil {
+		return nil, err
+	}
+
+	ivr := vr.tr.GetMessageValueRenderer(internal.Descriptor())
+	subscreens, err := ivr.Format(ctx, protoreflect.ValueOfMessage(internal))
+	if err != nil {
+		return nil, err
+	}
+
+	screens := []Screen{{Text: typeURL}}
+	if _, ok := ivr.(messageValueRenderer); ok {
+		// The type URL replaces the header screen of the packed message.
+		return append(screens, subscreens[1:]...), nil
+	}
+
+	return append(screens, prefixScreens(subscreens, "", 1)...), nil
+}
+
+func (vr anyValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 {
+		return protoreflect.Value{}, errors.New("expected a type URL screen")
+	}
+
+	typeURL := screens[0].Text
+	mt, err := vr.tr.typeResolver.FindMessageByURL(typeURL)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("cannot resolve type URL %q: %w", typeURL, err)
+	}
+
+	ivr := vr.tr.GetMessageValueRenderer(mt.Descriptor())
+	var subscreens []Screen
+	if mvr, ok := ivr.(messageValueRenderer); ok {
+		subscreens = append([]Screen{{Text: mvr.header()}}, screens[1:]...)
+	} else {
+		subscreens = make([]Screen, len(screens)-1)
+		for i, screen := range screens[1:] {
+			subscreens[i] = Screen{Text: screen.Text, Indent: screen.Indent - 1, Expert: screen.Expert}
+		}
+	}
+
+	internal, err := ivr.Parse(ctx, subscreens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	value, err := proto.MarshalOptions{Deterministic: true}.Marshal(internal.Message().Interface())
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	msg := newMessage(vr.md)
+	fields := vr.md.Fields()
+	msg.Set(fields.ByName("type_url"), protoreflect.ValueOfString(typeURL))
+	msg.Set(fields.ByName("value"), protoreflect.ValueOfBytes(value))
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
@@ -0,0 +1,43 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	trueText  = "True"
+	falseText = "False"
+)
+
+// boolValueRenderer implements ValueRenderer for booleans, which are rendered
+// as "True" or "False".
+type boolValueRenderer struct{}
+
+var _ ValueRenderer = boolValueRenderer{}
+
+func (vr boolValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	if v.Bool() {
+		return []Screen{{Text: trueText}}, nil
+	}
+
+	return []Screen{{Text: falseText}}, nil
+}
+
+func (vr boolValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	switch text {
+	case trueText:
+		return protoreflect.ValueOfBool(true), nil
+	case falseText:
+		return protoreflect.ValueOfBool(false), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("invalid boolean %q, expected %q or %q", text, trueText, falseText)
+	}
+}
@@ -3,7 +3,6 @@ package valuerenderer
 import (
 	"context"
 	"encoding/base64"
-	"io"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -13,18 +12,17 @@ type bytesValueRenderer struct{}
 
 var _ ValueRenderer = bytesValueRenderer{}
 
-func (vr bytesValueRenderer) Format(ctx context.Context, v protoreflect.Value, w io.Writer) error {
-	_, err := io.WriteString(w, base64.StdEncoding.EncodeToString(v.Bytes()))
-	return err
+func (vr bytesValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: base64.StdEncoding.EncodeToString(v.Bytes())}}, nil
 }
 
-func (vr bytesValueRenderer) Parse(_ context.Context, r io.Reader) (protoreflect.Value, error) {
-	formatted, err := io.ReadAll(r)
+func (vr bytesValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
 	if err != nil {
 		return protoreflect.ValueOfBytes([]byte{}), err
 	}
 
-	data, err := base64.StdEncoding.DecodeString(string(formatted))
+	data, err := base64.StdEncoding.DecodeString(text)
 	if err != nil {
 		return protoreflect.ValueOfBytes([]byte{}), err
 	}
@@ -5,11 +5,12 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"os"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/tx/textual/valuerenderer"
 )
 
 func TestFormatBytes(t *testing.T) {
@@ -27,10 +28,14 @@ func TestFormatBytes(t *testing.T) {
 		r, err := valueRendererOf(data)
 		require.NoError(t, err)
 
-		b := new(strings.Builder)
-		err = r.Format(context.Background(), protoreflect.ValueOfBytes(data), b)
+		screens, err := r.Format(context.Background(), protoreflect.ValueOfBytes(data))
+		require.NoError(t, err)
+		require.Equal(t, []valuerenderer.Screen{{Text: tc.expRes}}, screens)
+
+		// Round trip.
+		value, err := r.Parse(context.Background(), screens)
 		require.NoError(t, err)
-		require.Equal(t, tc.expRes, b.String())
+		require.Equal(t, data, value.Bytes())
 	}
 }
 
@@ -0,0 +1,85 @@
+package valuerenderer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+)
+
+// CBOR major types used to encode screens, see RFC 8949.
+const (
+	cborMajorUint   byte = 0
+	cborMajorText   byte = 3
+	cborMajorArray  byte = 4
+	cborMajorMap    byte = 5
+	cborMajorSimple byte = 7
+
+	cborTrue byte = 21
+)
+
+// CBOR map keys of the fields of a screen.
+const (
+	screenTextKey   = 1
+	screenIndentKey = 2
+	screenExpertKey = 3
+)
+
+// encodeScreens returns the CBOR encoding of the given screens: an array with
+// one map per screen, mapping 1 to the text of the screen, 2 to its indent and
+// 3 to its expert flag. The indent and expert entries are omitted when they
+// have their zero value. The encoding is deterministic, following the core
+// deterministic encoding requirements of RFC 8949.
+func encodeScreens(screens []Screen) []byte {
+	buf := new(bytes.Buffer)
+	writeCBORHead(buf, cborMajorArray, uint64(len(screens)))
+
+	for _, screen := range screens {
+		n := uint64(1)
+		if screen.Indent > 0 {
+			n++
+		}
+		if screen.Expert {
+			n++
+		}
+		writeCBORHead(buf, cborMajorMap, n)
+
+		writeCBORHead(buf, cborMajorUint, screenTextKey)
+		writeCBORHead(buf, cborMajorText, uint64(len(screen.Text)))
+		buf.WriteString(screen.Text)
+
+		if screen.Indent > 0 {
+			writeCBORHead(buf, cborMajorUint, screenIndentKey)
+			writeCBORHead(buf, cborMajorUint, uint64(screen.Indent))
+		}
+
+		if screen.Expert {
+			writeCBORHead(buf, cborMajorUint, screenExpertKey)
+			writeCBORHead(buf, cborMajorSimple, uint64(cborTrue))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// writeCBORHead writes the head of a CBOR data item of the given major type,
+// with the given argument encoded in its shortest form.
+func writeCBORHead(buf *bytes.Buffer, major byte, arg uint64) {
+	major <<= 5
+
+	switch {
+	case arg < 24:
+		buf.WriteByte(major | byte(arg))
+	case arg <= math.MaxUint8:
+		buf.WriteByte(major | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= math.MaxUint16:
+		buf.WriteByte(major | 25)
+		_ = binary.Write(buf, binary.BigEndian, uint16(arg))
+	case arg <= math.MaxUint32:
+		buf.WriteByte(major | 26)
+		_ = binary.Write(buf, binary.BigEndian, uint32(arg))
+	default:
+		buf.WriteByte(major | 27)
+		_ = binary.Write(buf, binary.BigEndian, arg)
+	}
+}
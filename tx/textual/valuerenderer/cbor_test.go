@@ -0,0 +1,38 @@
+package valuerenderer
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeScreens(t *testing.T) {
+	testcases := []struct {
+		name    string
+		screens []Screen
+		expHex  string
+	}{
+		{"no screens", nil, "80"},
+		{"text only", []Screen{{Text: "a"}}, "81a1016161"},
+		{"indent and expert", []Screen{{Text: "", Indent: 2, Expert: true}}, "81a301600202" + "03f5"},
+		{
+			"several screens",
+			[]Screen{{Text: "ab"}, {Text: "c", Indent: 1}},
+			"82a101626162" + "a20161630201",
+		},
+		{
+			"long text",
+			[]Screen{{Text: strings.Repeat("a", 24)}},
+			"81a1017818" + strings.Repeat("61", 24),
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expHex, hex.EncodeToString(encodeScreens(tc.screens)))
+		})
+	}
+}
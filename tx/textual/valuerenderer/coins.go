@@ -0,0 +1,130 @@
+package valuerenderer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// emptyCoinsText is the rendering of an empty list of coins.
+const emptyCoinsText = "zero"
+
+// coinsValueRenderer implements ValueRenderer and RepeatedValueRenderer for
+// cosmos.base.v1beta1.Coin. A coin is rendered in its display denom when the
+// coin metadata query function returns the metadata of its denom, for example
+// "1.5 atom" for "1500000uatom", and in its base denom otherwise. A list of
+// coins is rendered on a single screen, with comma-separated coins.
+type coinsValueRenderer struct {
+	q CoinMetadataQueryFn
+}
+
+var _ RepeatedValueRenderer = coinsValueRenderer{}
+
+func (vr coinsValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	formatted, err := vr.formatCoin(ctx, v.Message())
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatted}}, nil
+}
+
+func (vr coinsValueRenderer) FormatRepeated(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	list := v.List()
+	if list.Len() == 0 {
+		return []Screen{{Text: emptyCoinsText}}, nil
+	}
+
+	formatted := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		var err error
+		formatted[i], err = vr.formatCoin(ctx, list.Get(i).Message())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return []Screen{{Text: strings.Join(formatted, ", ")}}, nil
+}
+
+// Parse is not supported for coins, as the base denom of a coin cannot be
+// recovered from its display denom.
+func (vr coinsValueRenderer) Parse(context.Context, []Screen) (protoreflect.Value, error) {
+	return protoreflect.Value{}, errors.New("parsing coins is not supported")
+}
+
+// ParseRepeated is not supported for coins, see Parse.
+func (vr coinsValueRenderer) ParseRepeated(context.Context, []Screen, protoreflect.List) error {
+	return errors.New("parsing coins is not supported")
+}
+
+func (vr coinsValueRenderer) formatCoin(ctx context.Context, coin protoreflect.Message) (string, error) {
+	fields := coin.Descriptor().Fields()
+	denom := coin.Get(fields.ByName("denom")).String()
+	amount := coin.Get(fields.ByName("amount")).String()
+	if amount == "" {
+		amount = "0"
+	}
+
+	if vr.q != nil {
+		metadata, err := vr.q(ctx, denom)
+		if err != nil {
+			return "", err
+		}
+
+		if metadata != nil {
+			var coinExp, displayExp uint32
+			var foundCoin, foundDisplay bool
+			for _, unit := range metadata.DenomUnits {
+				if unit.Denom == denom {
+					coinExp, foundCoin = unit.Exponent, true
+				}
+				if unit.Denom == metadata.Display {
+					displayExp, foundDisplay = unit.Exponent, true
+				}
+			}
+
+			if foundCoin && foundDisplay && displayExp >= coinExp {
+				shifted, err := shiftDecimal(amount, displayExp-coinExp)
+				if err != nil {
+					return "", err
+				}
+				formatted, err := formatDecimal(shifted)
+				if err != nil {
+					return "", err
+				}
+
+				return formatted + " " + metadata.Display, nil
+			}
+		}
+	}
+
+	formatted, err := formatDecimal(amount)
+	if err != nil {
+		return "", err
+	}
+
+	return formatted + " " + denom, nil
+}
+
+// shiftDecimal divides the given non-negative integer by 10^exp, returning the
+// result as a decimal string.
+func shiftDecimal(amount string, exp uint32) (string, error) {
+	if !hasOnlyDigits(amount) {
+		return "", fmt.Errorf("invalid coin amount %q", amount)
+	}
+
+	if exp == 0 {
+		return amount, nil
+	}
+
+	if n := int(exp) + 1 - len(amount); n > 0 {
+		amount = strings.Repeat("0", n) + amount
+	}
+	point := len(amount) - int(exp)
+
+	return amount[:point] + "." + amount[point:], nil
+}
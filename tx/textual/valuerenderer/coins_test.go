@@ -0,0 +1,91 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/tx/textual/internal/testpb"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+type coinsTestFile struct {
+	Metadata map[string]json.RawMessage
+	Cases    []struct {
+		Coins []*basev1beta1.Coin
+		Text  string
+	}
+}
+
+// loadCoinMetadata returns a coin metadata query function returning the
+// metadata of the coins test file.
+func loadCoinMetadata(t *testing.T) valuerenderer.CoinMetadataQueryFn {
+	var file coinsTestFile
+	raw, err := os.ReadFile("../internal/testdata/coins.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &file))
+
+	metadata := make(map[string]*bankv1beta1.Metadata)
+	for denom, bz := range file.Metadata {
+		m := &bankv1beta1.Metadata{}
+		require.NoError(t, protojson.Unmarshal(bz, m))
+		metadata[denom] = m
+	}
+
+	return func(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		for _, m := range metadata {
+			for _, unit := range m.DenomUnits {
+				if unit.Denom == denom {
+					return m, nil
+				}
+			}
+		}
+
+		return nil, nil
+	}
+}
+
+func TestCoinsJsonTestcases(t *testing.T) {
+	var file coinsTestFile
+	raw, err := os.ReadFile("../internal/testdata/coins.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(raw, &file))
+
+	textual := valuerenderer.NewTextual(loadCoinMetadata(t))
+	fields := (&testpb.A{}).ProtoReflect().Descriptor().Fields()
+
+	for i, tc := range file.Cases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := &testpb.A{COINS: tc.Coins}
+			r, err := textual.GetValueRenderer(fields.ByName("COINS"))
+			require.NoError(t, err)
+			rr, ok := r.(valuerenderer.RepeatedValueRenderer)
+			require.True(t, ok)
+
+			screens, err := rr.FormatRepeated(context.Background(), a.ProtoReflect().Get(fields.ByName("COINS")))
+			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc.Text}}, screens)
+
+			if len(tc.Coins) == 1 {
+				r, err := textual.GetValueRenderer(fields.ByName("COIN"))
+				require.NoError(t, err)
+				screens, err := r.Format(context.Background(), protoreflect.ValueOfMessage(tc.Coins[0].ProtoReflect()))
+				require.NoError(t, err)
+				require.Equal(t, []valuerenderer.Screen{{Text: tc.Text}}, screens)
+			}
+
+			// Coins cannot be parsed back, as their base denom is not rendered.
+			_, err = r.Parse(context.Background(), screens)
+			require.Error(t, err)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package valuerenderer
 import (
 	"context"
 	"fmt"
-	"io"
 	"strings"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -15,18 +14,27 @@ type decValueRenderer struct{}
 
 var _ ValueRenderer = decValueRenderer{}
 
-func (vr decValueRenderer) Format(_ context.Context, v protoreflect.Value, w io.Writer) error {
+func (vr decValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
 	formatted, err := formatDecimal(v.String())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = io.WriteString(w, formatted)
-	return err
+	return []Screen{{Text: formatted}}, nil
 }
 
-func (vr decValueRenderer) Parse(_ context.Context, r io.Reader) (protoreflect.Value, error) {
-	panic("implement me")
+func (vr decValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	v, err := parseDecimal(text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfString(v), nil
 }
 
 // formatDecimal formats a decimal into a value-rendered string. This function
@@ -60,3 +68,27 @@ func formatDecimal(v string) (string, error) {
 
 	return intPart + "." + decPart, nil
 }
+
+// parseDecimal is the inverse of formatDecimal, it removes the thousand
+// separators of a value-rendered decimal.
+func parseDecimal(v string) (string, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) > 2 {
+		return "", fmt.Errorf("invalid decimal: too many points in %s", v)
+	}
+
+	intPart, err := parseInteger(parts[0])
+	if err != nil {
+		return "", err
+	}
+
+	if len(parts) == 1 {
+		return intPart, nil
+	}
+
+	if !hasOnlyDigits(parts[1]) {
+		return "", fmt.Errorf("non-digits detected after decimal point in: %q", parts[1])
+	}
+
+	return intPart + "." + parts[1], nil
+}
@@ -0,0 +1,138 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	secondsPerMinute = 60
+	secondsPerHour   = 60 * secondsPerMinute
+	secondsPerDay    = 24 * secondsPerHour
+)
+
+// durationValueRenderer implements ValueRenderer for google.protobuf.Duration,
+// which is rendered as a list of days, hours, minutes and seconds, omitting
+// the zero components, for example "1 day, 2 hours, 3.5 seconds". Negative
+// durations are prefixed with a minus sign.
+type durationValueRenderer struct {
+	md protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = durationValueRenderer{}
+
+func (vr durationValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	sec, nanos := secondsAndNanos(v.Message())
+	if (sec < 0 && nanos > 0) || (sec > 0 && nanos < 0) {
+		return nil, fmt.Errorf("invalid duration: seconds %d and nanos %d have different signs", sec, nanos)
+	}
+
+	sign := ""
+	if sec < 0 || nanos < 0 {
+		sign = "-"
+		sec, nanos = -sec, -nanos
+	}
+
+	var components []string
+	if days := sec / secondsPerDay; days > 0 {
+		components = append(components, pluralize(strconv.FormatInt(days, 10), "day", days == 1))
+	}
+	if hours := sec % secondsPerDay / secondsPerHour; hours > 0 {
+		components = append(components, pluralize(strconv.FormatInt(hours, 10), "hour", hours == 1))
+	}
+	if minutes := sec % secondsPerHour / secondsPerMinute; minutes > 0 {
+		components = append(components, pluralize(strconv.FormatInt(minutes, 10), "minute", minutes == 1))
+	}
+	if seconds := sec % secondsPerMinute; seconds > 0 || nanos > 0 || len(components) == 0 {
+		text := strconv.FormatInt(seconds, 10)
+		if nanos > 0 {
+			text += "." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0")
+		}
+		components = append(components, pluralize(text, "second", seconds == 1 && nanos == 0))
+	}
+
+	return []Screen{{Text: sign + strings.Join(components, ", ")}}, nil
+}
+
+func (vr durationValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	negative := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+
+	var (
+		sec   int64
+		nanos int32
+	)
+	for _, component := range strings.Split(text, ", ") {
+		parts := strings.Split(component, " ")
+		if len(parts) != 2 {
+			return protoreflect.Value{}, fmt.Errorf("invalid duration component %q", component)
+		}
+
+		var unit int64
+		switch strings.TrimSuffix(parts[1], "s") {
+		case "day":
+			unit = secondsPerDay
+		case "hour":
+			unit = secondsPerHour
+		case "minute":
+			unit = secondsPerMinute
+		case "second":
+			unit = 1
+		default:
+			return protoreflect.Value{}, fmt.Errorf("invalid duration unit %q", parts[1])
+		}
+
+		number := parts[0]
+		if unit == 1 {
+			var frac string
+			number, frac, _ = strings.Cut(number, ".")
+			if len(frac) > 9 || (frac != "" && !hasOnlyDigits(frac)) {
+				return protoreflect.Value{}, fmt.Errorf("invalid fractional seconds %q", frac)
+			}
+			if frac != "" {
+				n, err := strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 32)
+				if err != nil {
+					return protoreflect.Value{}, err
+				}
+				nanos = int32(n)
+			}
+		}
+
+		if !hasOnlyDigits(number) {
+			return protoreflect.Value{}, fmt.Errorf("expecting only digits 0-9, but got non-digits in %q", number)
+		}
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		sec += n * unit
+	}
+
+	if negative {
+		sec, nanos = -sec, -nanos
+	}
+
+	msg := newMessage(vr.md)
+	setSecondsAndNanos(msg, sec, nanos)
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// pluralize appends the given unit to the number, adding an "s" unless the
+// number is one.
+func pluralize(number, unit string, one bool) string {
+	if one {
+		return number + " " + unit
+	}
+
+	return number + " " + unit + "s"
+}
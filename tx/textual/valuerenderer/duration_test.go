@@ -0,0 +1,50 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+type durationTest struct {
+	Proto json.RawMessage
+	Text  string
+}
+
+func TestDurationJsonTestcases(t *testing.T) {
+	var testcases []durationTest
+	raw, err := os.ReadFile("../internal/testdata/duration.json")
+	require.NoError(t, err)
+	err = json.Unmarshal(raw, &testcases)
+	require.NoError(t, err)
+
+	for i, tc := range testcases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			d := &durationpb.Duration{}
+			err := protojson.Unmarshal(tc.Proto, d)
+			require.NoError(t, err)
+
+			r, err := valueRendererOf(d)
+			require.NoError(t, err)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOfMessage(d.ProtoReflect()))
+			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc.Text}}, screens)
+
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(d, value.Message().Interface()))
+		})
+	}
+}
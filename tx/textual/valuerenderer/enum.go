@@ -0,0 +1,44 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumValueRenderer implements ValueRenderer for enums, which are rendered
+// with the name of their value. Unknown values are rendered as integers.
+type enumValueRenderer struct {
+	ed protoreflect.EnumDescriptor
+}
+
+var _ ValueRenderer = enumValueRenderer{}
+
+func (vr enumValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	evd := vr.ed.Values().ByNumber(v.Enum())
+	if evd == nil {
+		return []Screen{{Text: strconv.FormatInt(int64(v.Enum()), 10)}}, nil
+	}
+
+	return []Screen{{Text: string(evd.Name())}}, nil
+}
+
+func (vr enumValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	if evd := vr.ed.Values().ByName(protoreflect.Name(text)); evd != nil {
+		return protoreflect.ValueOfEnum(evd.Number()), nil
+	}
+
+	n, err := strconv.ParseInt(text, 10, 32)
+	if err != nil {
+		return protoreflect.Value{}, fmt.Errorf("invalid value %q for enum %s", text, vr.ed.FullName())
+	}
+
+	return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+}
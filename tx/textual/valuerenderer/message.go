@@ -0,0 +1,256 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// messageValueRenderer implements ValueRenderer for protobuf messages without
+// a dedicated value renderer. A message is rendered as a header screen with
+// its name, followed by one indented screen per populated field, in field
+// number order:
+//
+//	Foo object
+//	> Full name: Alice
+//	> Bar: Bar object
+//	>> Bar id: 1
+//
+// Repeated fields are rendered as a screen with the number of elements,
+// followed by the elements and an end screen:
+//
+//	> Foos: 2 Foo
+//	>> Foos (1/2): Foo object
+//	>>> Full name: Alice
+//	>> Foos (2/2): Foo object
+//	>>> Full name: Bob
+//	> End of Foos
+type messageValueRenderer struct {
+	tr *Textual
+	md protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = messageValueRenderer{}
+
+func (vr messageValueRenderer) header() string {
+	return fmt.Sprintf("%s object", vr.md.Name())
+}
+
+func (vr messageValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+	if msg.Descriptor().FullName() != vr.md.FullName() {
+		return nil, fmt.Errorf("expected message %s, got %s", vr.md.FullName(), msg.Descriptor().FullName())
+	}
+
+	screens := []Screen{{Text: vr.header()}}
+	for _, fd := range sortedFields(vr.md) {
+		if !msg.Has(fd) {
+			continue
+		}
+
+		fieldScreens, err := vr.formatField(ctx, fd, msg.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+// formatField renders a populated field, with the indentation of the fields
+// of a message.
+func (vr messageValueRenderer) formatField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	name := formatFieldName(fd)
+
+	fvr, err := vr.tr.GetValueRenderer(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fd.IsList() {
+		subscreens, err := fvr.Format(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		return prefixScreens(subscreens, name+": ", 1), nil
+	}
+
+	if rvr, ok := fvr.(RepeatedValueRenderer); ok {
+		subscreens, err := rvr.FormatRepeated(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		return prefixScreens(subscreens, name+": ", 1), nil
+	}
+
+	list := v.List()
+	screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", name, list.Len(), elementKindName(fd)), Indent: 1}}
+	for i := 0; i < list.Len(); i++ {
+		subscreens, err := fvr.Format(ctx, list.Get(i))
+		if err != nil {
+			return nil, err
+		}
+
+		screens = append(screens, prefixScreens(subscreens, fmt.Sprintf("%s (%d/%d): ", name, i+1, list.Len()), 2)...)
+	}
+	screens = append(screens, Screen{Text: "End of " + name, Indent: 1})
+
+	return screens, nil
+}
+
+func (vr messageValueRenderer) Parse(ctx context.Context, screens []Screen) (protoreflect.Value, error) {
+	if len(screens) == 0 || screens[0].Text != vr.header() || screens[0].Indent != 0 {
+		return protoreflect.Value{}, fmt.Errorf("expected %q header screen", vr.header())
+	}
+
+	msg := newMessage(vr.md)
+	idx := 1
+	for _, fd := range sortedFields(vr.md) {
+		prefix := formatFieldName(fd) + ": "
+		if idx >= len(screens) || screens[idx].Indent != 1 || !strings.HasPrefix(screens[idx].Text, prefix) {
+			continue
+		}
+
+		var err error
+		idx, err = vr.parseField(ctx, fd, msg, screens, idx)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+	}
+
+	if idx < len(screens) {
+		return protoreflect.Value{}, fmt.Errorf("unexpected screen %q", screens[idx].Text)
+	}
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// parseField parses the field starting at the screen of the given index into
+// msg, and returns the index of the screen following the field.
+func (vr messageValueRenderer) parseField(ctx context.Context, fd protoreflect.FieldDescriptor, msg protoreflect.Message, screens []Screen, idx int) (int, error) {
+	name := formatFieldName(fd)
+
+	fvr, err := vr.tr.GetValueRenderer(fd)
+	if err != nil {
+		return 0, err
+	}
+
+	if !fd.IsList() {
+		subscreens, next := unprefixScreens(screens, idx, name+": ", 1)
+		v, err := fvr.Parse(ctx, subscreens)
+		if err != nil {
+			return 0, err
+		}
+		msg.Set(fd, v)
+
+		return next, nil
+	}
+
+	if rvr, ok := fvr.(RepeatedValueRenderer); ok {
+		subscreens, next := unprefixScreens(screens, idx, name+": ", 1)
+		if err := rvr.ParseRepeated(ctx, subscreens, msg.Mutable(fd).List()); err != nil {
+			return 0, err
+		}
+
+		return next, nil
+	}
+
+	countText := strings.TrimPrefix(screens[idx].Text, name+": ")
+	countText = strings.TrimSuffix(countText, " "+elementKindName(fd))
+	n, err := strconv.Atoi(countText)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number of elements for %s: %w", name, err)
+	}
+	idx++
+
+	list := msg.Mutable(fd).List()
+	for i := 0; i < n; i++ {
+		prefix := fmt.Sprintf("%s (%d/%d): ", name, i+1, n)
+		if idx >= len(screens) || screens[idx].Indent != 2 || !strings.HasPrefix(screens[idx].Text, prefix) {
+			return 0, fmt.Errorf("expected element %d of %s", i+1, name)
+		}
+
+		var subscreens []Screen
+		subscreens, idx = unprefixScreens(screens, idx, prefix, 2)
+		v, err := fvr.Parse(ctx, subscreens)
+		if err != nil {
+			return 0, err
+		}
+		list.Append(v)
+	}
+
+	if idx >= len(screens) || screens[idx].Indent != 1 || screens[idx].Text != "End of "+name {
+		return 0, fmt.Errorf("expected end of %s", name)
+	}
+
+	return idx + 1, nil
+}
+
+// prefixScreens prefixes the first of the given screens with the given text,
+// and indents all of them by the given indentation.
+func prefixScreens(screens []Screen, prefix string, indent int) []Screen {
+	res := make([]Screen, len(screens))
+	for i, screen := range screens {
+		res[i] = Screen{Text: screen.Text, Indent: screen.Indent + indent, Expert: screen.Expert}
+	}
+	if len(res) > 0 {
+		res[0].Text = prefix + res[0].Text
+	}
+
+	return res
+}
+
+// unprefixScreens is the inverse of prefixScreens. It returns the screens of
+// the value starting at the screen of the given index, and the index of the
+// screen following them.
+func unprefixScreens(screens []Screen, idx int, prefix string, indent int) ([]Screen, int) {
+	res := []Screen{{Text: strings.TrimPrefix(screens[idx].Text, prefix), Expert: screens[idx].Expert}}
+	for idx++; idx < len(screens) && screens[idx].Indent > indent; idx++ {
+		res = append(res, Screen{Text: screens[idx].Text, Indent: screens[idx].Indent - indent, Expert: screens[idx].Expert})
+	}
+
+	return res, idx
+}
+
+// sortedFields returns the fields of the given message, sorted by field number.
+func sortedFields(md protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := md.Fields()
+	res := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := range res {
+		res[i] = fields.Get(i)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Number() < res[j].Number() })
+
+	return res
+}
+
+// formatFieldName returns the name of a field in sentence case, for example
+// "Full name" for the "full_name" field.
+func formatFieldName(fd protoreflect.FieldDescriptor) string {
+	name := strings.ReplaceAll(string(fd.Name()), "_", " ")
+	r, size := utf8.DecodeRuneInString(name)
+
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// elementKindName returns the name of the kind of the elements of a repeated
+// field: the message or enum name, or the scalar kind.
+func elementKindName(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().Name())
+	case protoreflect.EnumKind:
+		return string(fd.Enum().Name())
+	default:
+		return fd.Kind().String()
+	}
+}
@@ -0,0 +1,50 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/tx/textual/internal/testpb"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+type messageTest struct {
+	Proto   json.RawMessage
+	Screens []valuerenderer.Screen
+}
+
+func TestMessageJsonTestcases(t *testing.T) {
+	var testcases []messageTest
+	raw, err := os.ReadFile("../internal/testdata/message.json")
+	require.NoError(t, err)
+	err = json.Unmarshal(raw, &testcases)
+	require.NoError(t, err)
+
+	textual := valuerenderer.NewTextual(nil)
+	for i, tc := range testcases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := &testpb.A{}
+			err := protojson.Unmarshal(tc.Proto, a)
+			require.NoError(t, err)
+
+			r := textual.GetMessageValueRenderer(a.ProtoReflect().Descriptor())
+			screens, err := r.Format(context.Background(), protoreflect.ValueOfMessage(a.ProtoReflect()))
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			require.True(t, proto.Equal(a, value.Message().Interface()), "expected %s, got %s", a, value.Message())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package valuerenderer
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// stringValueRenderer implements ValueRenderer for strings, which are rendered
+// as is.
+type stringValueRenderer struct{}
+
+var _ ValueRenderer = stringValueRenderer{}
+
+func (vr stringValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: v.String()}}, nil
+}
+
+func (vr stringValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	return protoreflect.ValueOfString(text), nil
+}
@@ -0,0 +1,55 @@
+package valuerenderer
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// timestampValueRenderer implements ValueRenderer for google.protobuf.Timestamp,
+// which is rendered in RFC 3339 format in UTC, with a nanosecond precision.
+type timestampValueRenderer struct {
+	md protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = timestampValueRenderer{}
+
+func (vr timestampValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	sec, nanos := secondsAndNanos(v.Message())
+	t := time.Unix(sec, int64(nanos)).UTC()
+
+	return []Screen{{Text: t.Format(time.RFC3339Nano)}}, nil
+}
+
+func (vr timestampValueRenderer) Parse(_ context.Context, screens []Screen) (protoreflect.Value, error) {
+	text, err := singleScreenText(screens)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, text)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+
+	msg := newMessage(vr.md)
+	setSecondsAndNanos(msg, t.Unix(), int32(t.Nanosecond()))
+
+	return protoreflect.ValueOfMessage(msg), nil
+}
+
+// secondsAndNanos returns the seconds and nanos fields of a
+// google.protobuf.Timestamp or google.protobuf.Duration message.
+func secondsAndNanos(msg protoreflect.Message) (int64, int32) {
+	fields := msg.Descriptor().Fields()
+	return msg.Get(fields.ByName("seconds")).Int(), int32(msg.Get(fields.ByName("nanos")).Int())
+}
+
+// setSecondsAndNanos sets the seconds and nanos fields of a
+// google.protobuf.Timestamp or google.protobuf.Duration message.
+func setSecondsAndNanos(msg protoreflect.Message, sec int64, nanos int32) {
+	fields := msg.Descriptor().Fields()
+	msg.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(sec))
+	msg.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(nanos))
+}
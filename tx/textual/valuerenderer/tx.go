@@ -0,0 +1,208 @@
+package valuerenderer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+)
+
+// SignerData is the information about the signer of a tx which is rendered
+// along with the tx in SIGN_MODE_TEXTUAL.
+type SignerData struct {
+	// Address is the bech32-encoded address of the signer.
+	Address string
+
+	// ChainID is the chain that this transaction is targeting.
+	ChainID string
+
+	// AccountNumber is the account number of the signer.
+	AccountNumber uint64
+
+	// Sequence is the account sequence of the signer.
+	Sequence uint64
+
+	// PubKey is the public key of the signer, packed in an Any. It may be nil.
+	PubKey *anypb.Any
+}
+
+// GetTxScreens renders the tx with the given body and auth info bytes, as
+// signed by the given signer. The screens contain, in this order:
+//
+//   - the chain id, the account number, the sequence and the address of the
+//     signer, as well as its public key (expert),
+//   - the messages of the tx, rendered as Anys,
+//   - the memo, the fees, the fee payer and granter (expert), the tip, the gas
+//     limit (expert), the timeout height (expert) and the unordered flag,
+//   - the extension options and the signer infos (expert),
+//   - the hash of the body and auth info bytes (expert), so that the sign
+//     bytes commit to the exact bytes of the tx.
+//
+// Empty optional values are omitted.
+func (r *Textual) GetTxScreens(ctx context.Context, bodyBz, authInfoBz []byte, signerData SignerData) ([]Screen, error) {
+	body := &txv1beta1.TxBody{}
+	if err := proto.Unmarshal(bodyBz, body); err != nil {
+		return nil, err
+	}
+
+	authInfo := &txv1beta1.AuthInfo{}
+	if err := proto.Unmarshal(authInfoBz, authInfo); err != nil {
+		return nil, err
+	}
+
+	screens := []Screen{
+		{Text: "Chain id: " + signerData.ChainID},
+		{Text: "Account number: " + mustFormatUint(signerData.AccountNumber)},
+		{Text: "Sequence: " + mustFormatUint(signerData.Sequence)},
+		{Text: "Address: " + signerData.Address},
+	}
+
+	anyVR := r.GetMessageValueRenderer((&anypb.Any{}).ProtoReflect().Descriptor())
+	if signerData.PubKey != nil {
+		subscreens, err := anyVR.Format(ctx, protoreflect.ValueOfMessage(signerData.PubKey.ProtoReflect()))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, expertScreens(prefixScreens(subscreens, "Public key: ", 0))...)
+	}
+
+	screens = append(screens, Screen{Text: fmt.Sprintf("This transaction has %s", pluralize(strconv.Itoa(len(body.Messages)), "Message", len(body.Messages) == 1))})
+	for i, msg := range body.Messages {
+		subscreens, err := anyVR.Format(ctx, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, prefixScreens(subscreens, fmt.Sprintf("Message (%d/%d): ", i+1, len(body.Messages)), 1)...)
+	}
+	screens = append(screens, Screen{Text: "End of Messages"})
+
+	if body.Memo != "" {
+		screens = append(screens, Screen{Text: "Memo: " + body.Memo})
+	}
+
+	coinsVR := coinsValueRenderer{q: r.coinMetadataQuerier}
+	fee := authInfo.Fee
+	if fee == nil {
+		fee = &txv1beta1.Fee{}
+	}
+	feeScreens, err := coinsVR.FormatRepeated(ctx, fee.ProtoReflect().Get(fee.ProtoReflect().Descriptor().Fields().ByName("amount")))
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, prefixScreens(feeScreens, "Fees: ", 0)...)
+	if fee.Payer != "" {
+		screens = append(screens, Screen{Text: "Fee payer: " + fee.Payer, Expert: true})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, Screen{Text: "Fee granter: " + fee.Granter, Expert: true})
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		tipScreens, err := coinsVR.FormatRepeated(ctx, tip.ProtoReflect().Get(tip.ProtoReflect().Descriptor().Fields().ByName("amount")))
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, prefixScreens(tipScreens, "Tip: ", 0)...)
+		screens = append(screens, Screen{Text: "Tipper: " + tip.Tipper})
+	}
+
+	screens = append(screens, Screen{Text: "Gas limit: " + mustFormatUint(fee.GasLimit), Expert: true})
+	if body.TimeoutHeight > 0 {
+		screens = append(screens, Screen{Text: "Timeout height: " + mustFormatUint(body.TimeoutHeight), Expert: true})
+	}
+	if isUnordered(body) {
+		screens = append(screens, Screen{Text: "Unordered: " + trueText})
+	}
+
+	for _, field := range []struct {
+		msg  protoreflect.Message
+		name protoreflect.Name
+	}{
+		{body.ProtoReflect(), "extension_options"},
+		{body.ProtoReflect(), "non_critical_extension_options"},
+		{authInfo.ProtoReflect(), "signer_infos"},
+	} {
+		fieldScreens, err := r.formatExpertField(ctx, field.msg, field.name)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	hash := sha256.New()
+	for _, bz := range [][]byte{bodyBz, authInfoBz} {
+		_ = binary.Write(hash, binary.BigEndian, uint64(len(bz)))
+		hash.Write(bz)
+	}
+	screens = append(screens, Screen{Text: "Hash of raw bytes: " + hex.EncodeToString(hash.Sum(nil)), Expert: true})
+
+	return screens, nil
+}
+
+// GetSignBytes returns the SIGN_MODE_TEXTUAL sign bytes of the tx with the
+// given body and auth info bytes, as signed by the given signer: the CBOR
+// encoding of the screens returned by GetTxScreens.
+func (r *Textual) GetSignBytes(ctx context.Context, bodyBz, authInfoBz []byte, signerData SignerData) ([]byte, error) {
+	screens, err := r.GetTxScreens(ctx, bodyBz, authInfoBz, signerData)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeScreens(screens), nil
+}
+
+// formatExpertField renders the given field of a message as expert screens,
+// at the indentation level of the tx screens. Unpopulated fields are omitted.
+func (r *Textual) formatExpertField(ctx context.Context, msg protoreflect.Message, name protoreflect.Name) ([]Screen, error) {
+	fd := msg.Descriptor().Fields().ByName(name)
+	if !msg.Has(fd) {
+		return nil, nil
+	}
+
+	screens, err := messageValueRenderer{tr: r, md: msg.Descriptor()}.formatField(ctx, fd, msg.Get(fd))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range screens {
+		screens[i].Indent--
+	}
+
+	return expertScreens(screens), nil
+}
+
+// isUnordered returns true if the tx body is unordered. The field is read by
+// reflection, as older versions of the API module do not define it.
+func isUnordered(body *txv1beta1.TxBody) bool {
+	msg := body.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("unordered")
+
+	return fd != nil && msg.Get(fd).Bool()
+}
+
+// expertScreens marks the given screens as expert screens.
+func expertScreens(screens []Screen) []Screen {
+	for i := range screens {
+		screens[i].Expert = true
+	}
+
+	return screens
+}
+
+// mustFormatUint formats an unsigned integer with thousand separators.
+func mustFormatUint(i uint64) string {
+	formatted, err := formatInteger(strconv.FormatUint(i, 10))
+	if err != nil {
+		panic(err)
+	}
+
+	return formatted
+}
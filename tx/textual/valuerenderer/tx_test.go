@@ -0,0 +1,81 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	_ "cosmossdk.io/api/cosmos/bank/v1beta1"
+	_ "cosmossdk.io/api/cosmos/crypto/secp256k1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/tx/textual/valuerenderer"
+)
+
+type txTest struct {
+	Name       string
+	SignerData struct {
+		Address       string
+		ChainID       string `json:"chain_id"`
+		AccountNumber uint64 `json:"account_number"`
+		Sequence      uint64
+		PubKey        json.RawMessage `json:"pub_key"`
+	} `json:"signer_data"`
+	Body     json.RawMessage
+	AuthInfo json.RawMessage `json:"auth_info"`
+	Screens  []valuerenderer.Screen
+}
+
+func TestTxJsonTestcases(t *testing.T) {
+	var testcases []txTest
+	raw, err := os.ReadFile("../internal/testdata/tx.json")
+	require.NoError(t, err)
+	err = json.Unmarshal(raw, &testcases)
+	require.NoError(t, err)
+
+	textual := valuerenderer.NewTextual(loadCoinMetadata(t))
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			body := &txv1beta1.TxBody{}
+			require.NoError(t, protojson.Unmarshal(tc.Body, body))
+			bodyBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(body)
+			require.NoError(t, err)
+
+			authInfo := &txv1beta1.AuthInfo{}
+			require.NoError(t, protojson.Unmarshal(tc.AuthInfo, authInfo))
+			authInfoBz, err := proto.MarshalOptions{Deterministic: true}.Marshal(authInfo)
+			require.NoError(t, err)
+
+			signerData := valuerenderer.SignerData{
+				Address:       tc.SignerData.Address,
+				ChainID:       tc.SignerData.ChainID,
+				AccountNumber: tc.SignerData.AccountNumber,
+				Sequence:      tc.SignerData.Sequence,
+			}
+			if tc.SignerData.PubKey != nil {
+				signerData.PubKey = &anypb.Any{}
+				require.NoError(t, protojson.Unmarshal(tc.SignerData.PubKey, signerData.PubKey))
+			}
+
+			screens, err := textual.GetTxScreens(context.Background(), bodyBz, authInfoBz, signerData)
+			require.NoError(t, err)
+			require.Equal(t, tc.Screens, screens)
+
+			signBytes, err := textual.GetSignBytes(context.Background(), bodyBz, authInfoBz, signerData)
+			require.NoError(t, err)
+			require.NotEmpty(t, signBytes)
+
+			// The sign bytes depend on the signer.
+			signerData.Sequence++
+			otherSignBytes, err := textual.GetSignBytes(context.Background(), bodyBz, authInfoBz, signerData)
+			require.NoError(t, err)
+			require.NotEqual(t, signBytes, otherSignBytes)
+		})
+	}
+}
@@ -2,11 +2,24 @@ package valuerenderer
 
 import (
 	"context"
-	"io"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// Screen is the abstract unit of Textual rendering.
+type Screen struct {
+	// Text is the text to display - a sequence of Unicode code points.
+	Text string
+
+	// Indent is the indentation level, relative to the value being rendered.
+	// Nested values are rendered with a higher indentation level.
+	Indent int
+
+	// Expert indicates that the screen should only be displayed via an opt-in
+	// from the user.
+	Expert bool
+}
+
 // ValueRenderer defines an interface to produce formatted output for all
 // protobuf types as well as parse a string into those protobuf types.
 //
@@ -15,6 +28,25 @@ import (
 // here, so that optionally more value renderers could be built, for example, a
 // separate one for a different language.
 type ValueRenderer interface {
-	Format(context.Context, protoreflect.Value, io.Writer) error
-	Parse(context.Context, io.Reader) (protoreflect.Value, error)
+	// Format renders the Protobuf value to a list of Screens.
+	Format(context.Context, protoreflect.Value) ([]Screen, error)
+
+	// Parse is the inverse of Format. It must be able to parse all valid
+	// screens, meaning only those generated using this renderer's Format method.
+	// However the behavior of Parse on invalid screens is not specified,
+	// and does not necessarily error.
+	Parse(context.Context, []Screen) (protoreflect.Value, error)
+}
+
+// RepeatedValueRenderer defines an interface to produce formatted output for
+// protobuf repeated fields, rendered as a whole instead of element by element.
+type RepeatedValueRenderer interface {
+	ValueRenderer
+
+	// FormatRepeated renders the Protobuf list value to a list of Screens.
+	FormatRepeated(context.Context, protoreflect.Value) ([]Screen, error)
+
+	// ParseRepeated is the inverse of FormatRepeated. It appends the parsed
+	// elements to the given list.
+	ParseRepeated(context.Context, []Screen, protoreflect.List) error
 }
@@ -1,25 +1,63 @@
 package valuerenderer
 
 import (
+	"context"
 	"fmt"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	cosmos_proto "github.com/cosmos/cosmos-proto"
 )
 
+// CoinMetadataQueryFn defines a function that queries state for the coin
+// denom metadata. It returns a nil metadata if the denom has none, in which
+// case coins are rendered in their base denom.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error)
+
+// Textual holds the configuration for dispatching to specific value
+// renderers for SIGN_MODE_TEXTUAL.
 type Textual struct {
+	// coinMetadataQuerier defines a function to query the coin metadata from
+	// state.
+	coinMetadataQuerier CoinMetadataQueryFn
+	// typeResolver resolves the type URLs of Anys.
+	typeResolver protoregistry.MessageTypeResolver
+	// scalars defines a registry for Cosmos scalars.
 	scalars map[string]ValueRenderer
+	// messages defines a registry for value renderers of well-known messages,
+	// keyed by message full name.
+	messages map[protoreflect.FullName]func(protoreflect.MessageDescriptor) ValueRenderer
+}
+
+// NewTextual returns a new Textual which provides value renderers. Coins are
+// rendered using the denom metadata returned by the given query function,
+// which may be nil.
+func NewTextual(q CoinMetadataQueryFn) *Textual {
+	t := &Textual{
+		coinMetadataQuerier: q,
+		typeResolver:        protoregistry.GlobalTypes,
+	}
+	t.init()
+
+	return t
 }
 
-func NewTextual() Textual {
-	return Textual{}
+// SetTypeResolver sets the resolver used to unpack Anys, which defaults to
+// the global protobuf registry.
+func (r *Textual) SetTypeResolver(typeResolver protoregistry.MessageTypeResolver) {
+	r.typeResolver = typeResolver
 }
 
 // GetValueRenderer returns the value renderer for the given FieldDescriptor.
-func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
+func (r *Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
 	switch {
+	case fd.IsMap():
+		return nil, fmt.Errorf("value renderers cannot format maps, got field %s", fd.FullName())
+
 	// Scalars, such as sdk.Int and sdk.Dec.
 	case fd.Kind() == protoreflect.StringKind && proto.GetExtension(fd.Options(), cosmos_proto.E_Scalar) != "":
 		{
@@ -28,10 +66,6 @@ func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRendere
 				return nil, fmt.Errorf("got extension option %s of type %T", scalar, scalar)
 			}
 
-			if r.scalars == nil {
-				r.init()
-			}
-
 			vr := r.scalars[scalar]
 			if vr == nil {
 				return nil, fmt.Errorf("got empty value renderer for scalar %s", scalar)
@@ -41,6 +75,12 @@ func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRendere
 		}
 	case fd.Kind() == protoreflect.BytesKind:
 		return bytesValueRenderer{}, nil
+	case fd.Kind() == protoreflect.StringKind:
+		return stringValueRenderer{}, nil
+	case fd.Kind() == protoreflect.BoolKind:
+		return boolValueRenderer{}, nil
+	case fd.Kind() == protoreflect.EnumKind:
+		return enumValueRenderer{ed: fd.Enum()}, nil
 
 	// Integers
 	case fd.Kind() == protoreflect.Uint32Kind ||
@@ -48,19 +88,52 @@ func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRendere
 		fd.Kind() == protoreflect.Int32Kind ||
 		fd.Kind() == protoreflect.Int64Kind:
 		{
-			return intValueRenderer{}, nil
+			return intValueRenderer{fd: fd}, nil
 		}
 
+	// Messages, such as coins, timestamps, Anys, or any other message.
+	case fd.Kind() == protoreflect.MessageKind:
+		return r.GetMessageValueRenderer(fd.Message()), nil
+
 	default:
 		return nil, fmt.Errorf("value renderers cannot format value of type %s", fd.Kind())
 	}
 }
 
+// GetMessageValueRenderer returns the value renderer for the given message:
+// the dedicated value renderer of a well-known message, or a renderer of the
+// message fields otherwise.
+func (r *Textual) GetMessageValueRenderer(md protoreflect.MessageDescriptor) ValueRenderer {
+	if newVR, ok := r.messages[md.FullName()]; ok {
+		return newVR(md)
+	}
+
+	return messageValueRenderer{tr: r, md: md}
+}
+
 func (r *Textual) init() {
 	if r.scalars == nil {
 		r.scalars = map[string]ValueRenderer{}
 		r.scalars["cosmos.Int"] = intValueRenderer{}
 		r.scalars["cosmos.Dec"] = decValueRenderer{}
+		r.scalars["cosmos.AddressString"] = stringValueRenderer{}
+	}
+
+	if r.messages == nil {
+		r.messages = map[protoreflect.FullName]func(protoreflect.MessageDescriptor) ValueRenderer{
+			"cosmos.base.v1beta1.Coin": func(protoreflect.MessageDescriptor) ValueRenderer {
+				return coinsValueRenderer{q: r.coinMetadataQuerier}
+			},
+			"google.protobuf.Timestamp": func(md protoreflect.MessageDescriptor) ValueRenderer {
+				return timestampValueRenderer{md: md}
+			},
+			"google.protobuf.Duration": func(md protoreflect.MessageDescriptor) ValueRenderer {
+				return durationValueRenderer{md: md}
+			},
+			"google.protobuf.Any": func(md protoreflect.MessageDescriptor) ValueRenderer {
+				return anyValueRenderer{tr: r, md: md}
+			},
+		}
 	}
 }
 
@@ -69,3 +142,25 @@ func (r *Textual) DefineScalar(scalar string, vr ValueRenderer) {
 	r.init()
 	r.scalars[scalar] = vr
 }
+
+// singleScreenText returns the text of the screens of a value rendered on a
+// single screen.
+func singleScreenText(screens []Screen) (string, error) {
+	if len(screens) != 1 {
+		return "", fmt.Errorf("expected single screen, got %d", len(screens))
+	}
+
+	return screens[0].Text, nil
+}
+
+// newMessage returns a new message of the given type, using the generated Go
+// type if the descriptor is the one of a registered type, and a dynamic
+// message otherwise.
+func newMessage(md protoreflect.MessageDescriptor) protoreflect.Message {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName())
+	if err == nil && mt.Descriptor() == md {
+		return mt.New()
+	}
+
+	return dynamicpb.NewMessage(md)
+}
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"cosmossdk.io/math"
 	"cosmossdk.io/tx/textual/internal/testpb"
@@ -31,23 +33,29 @@ func TestFormatInteger(t *testing.T) {
 		if err == nil {
 			r, err := valueRendererOf(i)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(i), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(i))
 			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc[1]}}, screens)
 
-			require.Equal(t, tc[1], b.String())
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			require.Equal(t, i, value.Uint())
 		}
 
 		// Parse test case strings as protobuf uint32
 		i, err = strconv.ParseUint(tc[0], 10, 32)
 		if err == nil {
-			r, err := valueRendererOf(i)
+			r, err := valueRendererOf(uint32(i))
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(i), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(uint32(i)))
 			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc[1]}}, screens)
 
-			require.Equal(t, tc[1], b.String())
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			require.Equal(t, i, value.Uint())
 		}
 
 		// Parse test case strings as sdk.Ints
@@ -55,11 +63,16 @@ func TestFormatInteger(t *testing.T) {
 		if ok {
 			r, err := valueRendererOf(sdkInt)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(tc[0]), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(tc[0]))
 			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc[1]}}, screens)
 
-			require.Equal(t, tc[1], b.String())
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			parsed, ok := math.NewIntFromString(value.String())
+			require.True(t, ok)
+			require.True(t, sdkInt.Equal(parsed))
 		}
 	}
 }
@@ -79,11 +92,16 @@ func TestFormatDecimal(t *testing.T) {
 			require.NoError(t, err)
 			r, err := valueRendererOf(d)
 			require.NoError(t, err)
-			b := new(strings.Builder)
-			err = r.Format(context.Background(), protoreflect.ValueOf(tc[0]), b)
+			screens, err := r.Format(context.Background(), protoreflect.ValueOf(tc[0]))
 			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc[1]}}, screens)
 
-			require.Equal(t, tc[1], b.String())
+			// Round trip.
+			value, err := r.Parse(context.Background(), screens)
+			require.NoError(t, err)
+			parsed, err := math.LegacyNewDecFromStr(value.String())
+			require.NoError(t, err)
+			require.True(t, d.Equal(parsed))
 		})
 	}
 }
@@ -99,8 +117,15 @@ func TestGetADR050ValueRenderer(t *testing.T) {
 		{"sdk.Int", math.NewInt(1), false},
 		{"sdk.Dec", math.LegacyNewDec(1), false},
 		{"[]byte", []byte{1}, false},
+		{"string", "foo", false},
+		{"bool", true, false},
+		{"timestamp", &timestamppb.Timestamp{}, false},
+		{"duration", &durationpb.Duration{}, false},
+		{"any", &anypb.Any{}, false},
+		{"message", &testpb.Foo{}, false},
 		{"float32", float32(1), true},
 		{"float64", float64(1), true},
+		{"map", map[string]*testpb.B{}, true},
 	}
 
 	for _, tc := range testcases {
@@ -121,7 +146,7 @@ func TestGetADR050ValueRenderer(t *testing.T) {
 func valueRendererOf(v interface{}) (valuerenderer.ValueRenderer, error) {
 	a, b := (&testpb.A{}).ProtoReflect().Descriptor().Fields(), (&testpb.B{}).ProtoReflect().Descriptor().Fields()
 
-	textual := valuerenderer.NewTextual()
+	textual := valuerenderer.NewTextual(nil)
 	switch v := v.(type) {
 	// Valid types for SIGN_MODE_TEXTUAL
 	case uint32:
@@ -138,12 +163,26 @@ func valueRendererOf(v interface{}) (valuerenderer.ValueRenderer, error) {
 		return textual.GetValueRenderer(a.ByName(protoreflect.Name("SDKINT")))
 	case math.LegacyDec:
 		return textual.GetValueRenderer(a.ByName(protoreflect.Name("SDKDEC")))
+	case string:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("STRING")))
+	case bool:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("BOOL")))
+	case *timestamppb.Timestamp:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("TIMESTAMP")))
+	case *durationpb.Duration:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("DURATION")))
+	case *anypb.Any:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("ANY")))
+	case *testpb.Foo:
+		return textual.GetValueRenderer(a.ByName(protoreflect.Name("FOO")))
 
 	// Invalid types for SIGN_MODE_TEXTUAL
 	case float32:
 		return textual.GetValueRenderer(b.ByName(protoreflect.Name("FLOAT")))
 	case float64:
-		return textual.GetValueRenderer(b.ByName(protoreflect.Name("FLOAT")))
+		return textual.GetValueRenderer(b.ByName(protoreflect.Name("DOUBLE")))
+	case map[string]*testpb.B:
+		return textual.GetValueRenderer(b.ByName(protoreflect.Name("MAP")))
 
 	default:
 		return nil, fmt.Errorf("value %s of type %T not recognized", v, v)
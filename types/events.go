@@ -218,6 +218,8 @@ const (
 	AttributeKeySignature       = "signature"
 	AttributeKeyFee             = "fee"
 	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyFeeRefund       = "fee_refund"
+	AttributeKeyMsgFee          = "msg_fee"
 
 	EventTypeMessage = "message"
 
@@ -0,0 +1,117 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Mempool defines the application side mempool. It holds the transactions that
+// passed CheckTx and decides in which order they are proposed for inclusion in
+// a block.
+//
+// NOTE: implementations are not required to be safe for concurrent use, BaseApp
+// only calls them while holding the ABCI connection lock.
+type Mempool interface {
+	// Insert attempts to insert a Tx into the app-side mempool returning
+	// an error upon failure.
+	Insert(context.Context, sdk.Tx) error
+
+	// Select returns an Iterator over the app-side mempool. The txs argument
+	// holds raw transactions the caller already knows of, which implementations
+	// may take into account or ignore. It returns nil if there is nothing to
+	// iterate over.
+	Select(context.Context, [][]byte) Iterator
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+
+	// Remove attempts to remove a transaction from the mempool, returning an error
+	// upon failure.
+	Remove(sdk.Tx) error
+}
+
+// Iterator defines an app-side mempool iterator interface that is as minimal as
+// possible. The order of iteration is determined by the app-side mempool
+// implementation.
+type Iterator interface {
+	// Next returns the next transaction from the mempool. If there are no more
+	// transactions, it returns nil.
+	Next() Iterator
+
+	// Tx returns the transaction at the current position of the iterator.
+	Tx() sdk.Tx
+}
+
+var (
+	// ErrTxNotFound is returned when removing a transaction which isn't in the
+	// mempool.
+	ErrTxNotFound = errors.New("tx not found in mempool")
+
+	// ErrMempoolTxMaxCapacity is returned when inserting a transaction in a
+	// mempool which is full and has nothing left to evict in its favor.
+	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	// ErrTxReplacementUnderpriced is returned when inserting a transaction with
+	// the same sender and nonce as a transaction of the mempool, without a
+	// strictly higher priority.
+	ErrTxReplacementUnderpriced = errors.New("tx replacement underpriced")
+)
+
+// senderKey identifies the queue of a transaction in the nonce based mempools.
+// The unordered transactions of a sender are queued apart from its ordered
+// ones, as they all share a zero sequence.
+type senderKey struct {
+	address   string
+	unordered bool
+}
+
+// less returns true if sender a is sorted before sender b.
+func (a senderKey) less(b senderKey) bool {
+	if a.address != b.address {
+		return a.address < b.address
+	}
+
+	return !a.unordered && b.unordered
+}
+
+// txSender returns the sender of a transaction, identified by the address of
+// its first signer, and its nonce. Mempool implementations use them to keep the
+// transactions of a same sender in nonce order. The address is not derived from
+// the public key of the signature, which may differ from the one of the signer
+// account, e.g. for smart accounts or after a public key rotation.
+//
+// The nonce of an ordered transaction is the sequence of its first signer. The
+// nonce of an unordered transaction is its timeout height, so that unordered
+// transactions with distinct timeout heights don't replace each other.
+func txSender(tx sdk.Tx) (senderKey, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return senderKey{}, 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return senderKey{}, 0, err
+	}
+	if len(sigs) == 0 {
+		return senderKey{}, 0, errors.New("tx must have at least one signer")
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) == 0 {
+		return senderKey{}, 0, errors.New("tx must have at least one signer")
+	}
+
+	sig := sigs[0]
+	sender := senderKey{address: signers[0].String()}
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		sender.unordered = true
+		return sender, unorderedTx.GetTimeoutHeight(), nil
+	}
+
+	return sender, sig.Sequence, nil
+}
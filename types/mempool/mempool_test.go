@@ -0,0 +1,178 @@
+package mempool_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ signing.SigVerifiableTx = testTx{}
+	_ sdk.TxWithUnordered     = testTx{}
+)
+
+// testTx is a minimal transaction signed by a single account. The signer is the
+// account of pubKey unless set.
+type testTx struct {
+	id        int
+	priority  int64
+	nonce     uint64
+	pubKey    cryptotypes.PubKey
+	signer    sdk.AccAddress
+	unordered bool
+	timeout   uint64
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg   { return nil }
+func (tx testTx) ValidateBasic() error { return nil }
+func (tx testTx) String() string       { return fmt.Sprintf("tx %d", tx.id) }
+
+func (tx testTx) GetTimeoutHeight() uint64 { return tx.timeout }
+func (tx testTx) GetUnordered() bool       { return tx.unordered }
+
+func (tx testTx) GetSigners() []sdk.AccAddress {
+	if tx.signer != nil {
+		return []sdk.AccAddress{tx.signer}
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(tx.pubKey.Address())}
+}
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	return []cryptotypes.PubKey{tx.pubKey}, nil
+}
+
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{PubKey: tx.pubKey, Sequence: tx.nonce}}, nil
+}
+
+// sigLessTx is a transaction which can't be ordered by sender.
+type sigLessTx struct{}
+
+func (sigLessTx) GetMsgs() []sdk.Msg   { return nil }
+func (sigLessTx) ValidateBasic() error { return nil }
+
+func newTestKeys(n int) []cryptotypes.PubKey {
+	pubKeys := make([]cryptotypes.PubKey, n)
+	for i := range pubKeys {
+		pubKeys[i] = secp256k1.GenPrivKeyFromSecret([]byte{byte(i)}).PubKey()
+	}
+
+	return pubKeys
+}
+
+func newTestContext() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, nil)
+}
+
+func insertTxs(t *testing.T, mp mempool.Mempool, txs []testTx) {
+	ctx := newTestContext()
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+}
+
+func selectIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for it := mp.Select(newTestContext(), nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+
+	return ids
+}
+
+func TestNoOpMempool(t *testing.T) {
+	mp := mempool.NoOpMempool{}
+	tx := testTx{pubKey: newTestKeys(1)[0]}
+
+	require.NoError(t, mp.Insert(newTestContext(), tx))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestContext(), nil))
+	require.NoError(t, mp.Remove(tx))
+}
+
+func TestMempoolRequiresSigner(t *testing.T) {
+	for _, mp := range []mempool.Mempool{mempool.NewPriorityMempool(), mempool.NewSenderNonceMempool()} {
+		require.Error(t, mp.Insert(newTestContext(), sigLessTx{}))
+		require.Error(t, mp.Remove(sigLessTx{}))
+		require.Equal(t, 0, mp.CountTx())
+	}
+}
+
+func TestMempoolReplace(t *testing.T) {
+	key := newTestKeys(1)[0]
+	ctx := newTestContext()
+
+	for _, mp := range []mempool.Mempool{mempool.NewPriorityMempool(), mempool.NewSenderNonceMempool()} {
+		insertTxs(t, mp, []testTx{{id: 0, priority: 10, pubKey: key}})
+
+		// a tx with the same sender and nonce needs a strictly higher priority
+		err := mp.Insert(ctx.WithPriority(10), testTx{id: 1, priority: 10, pubKey: key})
+		require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+		err = mp.Insert(ctx.WithPriority(5), testTx{id: 2, priority: 5, pubKey: key})
+		require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+		require.Equal(t, []int{0}, selectIDs(mp))
+
+		require.NoError(t, mp.Insert(ctx.WithPriority(11), testTx{id: 3, priority: 11, pubKey: key}))
+		require.Equal(t, 1, mp.CountTx())
+		require.Equal(t, []int{3}, selectIDs(mp))
+	}
+}
+
+func TestMempoolUnorderedTxs(t *testing.T) {
+	key := newTestKeys(1)[0]
+	ctx := newTestContext()
+
+	for _, mp := range []mempool.Mempool{mempool.NewPriorityMempool(), mempool.NewSenderNonceMempool()} {
+		// unordered txs all have a zero sequence, they are told apart by their
+		// timeout height and don't replace the ordered txs of their sender
+		insertTxs(t, mp, []testTx{
+			{id: 0, priority: 10, pubKey: key, unordered: true, timeout: 100},
+			{id: 1, priority: 10, pubKey: key, unordered: true, timeout: 50},
+			{id: 2, priority: 10, pubKey: key},
+		})
+		require.Equal(t, 3, mp.CountTx())
+		require.ElementsMatch(t, []int{0, 1, 2}, selectIDs(mp))
+
+		// an unordered tx with the same timeout height needs a higher priority
+		err := mp.Insert(ctx.WithPriority(10), testTx{id: 3, priority: 10, pubKey: key, unordered: true, timeout: 100})
+		require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+		require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{id: 4, priority: 20, pubKey: key, unordered: true, timeout: 100}))
+		require.ElementsMatch(t, []int{1, 2, 4}, selectIDs(mp))
+
+		require.NoError(t, mp.Remove(testTx{pubKey: key, unordered: true, timeout: 50}))
+		require.NoError(t, mp.Remove(testTx{pubKey: key}))
+		require.Equal(t, []int{4}, selectIDs(mp))
+	}
+}
+
+func TestMempoolSenderIsSigner(t *testing.T) {
+	keys := newTestKeys(3)
+	signer := sdk.AccAddress(keys[0].Address())
+	ctx := newTestContext()
+
+	for _, mp := range []mempool.Mempool{mempool.NewPriorityMempool(), mempool.NewSenderNonceMempool()} {
+		// the txs of an account signed with other keys, e.g. the session keys of
+		// a smart account, belong to the account
+		insertTxs(t, mp, []testTx{
+			{id: 0, priority: 10, nonce: 1, pubKey: keys[1], signer: signer},
+			{id: 1, priority: 5, nonce: 0, pubKey: keys[2], signer: signer},
+		})
+		require.Equal(t, []int{1, 0}, selectIDs(mp))
+
+		err := mp.Insert(ctx.WithPriority(5), testTx{id: 2, priority: 5, nonce: 0, pubKey: keys[0]})
+		require.ErrorIs(t, err, mempool.ErrTxReplacementUnderpriced)
+
+		require.NoError(t, mp.Remove(testTx{nonce: 0, pubKey: keys[1], signer: signer}))
+		require.Equal(t, []int{0}, selectIDs(mp))
+	}
+}
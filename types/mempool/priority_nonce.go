@@ -0,0 +1,244 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*PriorityNonceMempool)(nil)
+	_ Iterator = (*priorityNonceIterator)(nil)
+)
+
+// PriorityNonceMempool is a mempool which selects transactions by priority,
+// as set in the context by the AnteHandler (e.g. from the fees they pay), while
+// keeping the transactions of a same sender in nonce order.
+//
+// Transactions are selected in the following order: among the lowest nonce
+// transaction of each sender, the one with the highest priority goes first,
+// ties being broken by sender address. Inserting a transaction with the same
+// sender and nonce as one already in the mempool replaces it if it has a
+// strictly higher priority, and is rejected otherwise.
+//
+// When the mempool is full, a new transaction evicts the transaction that
+// would be selected last among the highest nonce transaction of each sender,
+// provided it has a strictly lower priority than the new one and a different
+// sender. Otherwise the new transaction is rejected.
+//
+// Unordered transactions are queued apart from the ordered transactions of
+// their sender, in timeout height order. Inserting an unordered transaction
+// with the same sender and timeout height as one already in the mempool
+// replaces it under the same conditions.
+type PriorityNonceMempool struct {
+	senderQueues map[senderKey][]*priorityTx
+	count        int
+	maxTx        int
+}
+
+// PriorityNonceMempoolOption is an option for a PriorityNonceMempool.
+type PriorityNonceMempoolOption func(*PriorityNonceMempool)
+
+// PriorityNonceWithMaxTx sets the maximum number of transactions held by the
+// mempool. A value of 0 or less means the mempool is unbounded.
+func PriorityNonceWithMaxTx(maxTx int) PriorityNonceMempoolOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// NewPriorityMempool returns a new, empty, PriorityNonceMempool.
+func NewPriorityMempool(opts ...PriorityNonceMempoolOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senderQueues: make(map[senderKey][]*priorityTx),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+type priorityTx struct {
+	tx       sdk.Tx
+	sender   senderKey
+	nonce    uint64
+	priority int64
+}
+
+// before returns true if tx a must be selected before tx b.
+func (a *priorityTx) before(b *priorityTx) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	if a.sender != b.sender {
+		return a.sender.less(b.sender)
+	}
+
+	return a.nonce < b.nonce
+}
+
+// Insert adds a transaction to the mempool using the priority set in the
+// context, which must wrap an sdk.Context.
+func (mp *PriorityNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	ptx := &priorityTx{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: sdk.UnwrapSDKContext(ctx).Priority(),
+	}
+
+	queue := mp.senderQueues[sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+	if i < len(queue) && queue[i].nonce == nonce {
+		if queue[i].priority >= ptx.priority {
+			return ErrTxReplacementUnderpriced
+		}
+
+		queue[i] = ptx
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		victim := mp.evictionCandidate()
+		if victim == nil || victim.sender.address == sender.address || victim.priority >= ptx.priority {
+			return ErrMempoolTxMaxCapacity
+		}
+
+		mp.remove(victim.sender, victim.nonce)
+	}
+
+	queue = append(queue, nil)
+	copy(queue[i+1:], queue[i:])
+	queue[i] = ptx
+	mp.senderQueues[sender] = queue
+	mp.count++
+
+	return nil
+}
+
+// evictionCandidate returns the highest nonce transaction of a sender which
+// would be selected last, or nil if the mempool is empty.
+func (mp *PriorityNonceMempool) evictionCandidate() *priorityTx {
+	var candidate *priorityTx
+	for _, queue := range mp.senderQueues {
+		tail := queue[len(queue)-1]
+		if candidate == nil || candidate.before(tail) {
+			candidate = tail
+		}
+	}
+
+	return candidate
+}
+
+// Select returns an iterator over the transactions of the mempool in
+// selection order. The iterator works on a snapshot of the mempool, so it is
+// not affected by later insertions or removals. The txs argument is ignored.
+func (mp *PriorityNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	if mp.count == 0 {
+		return nil
+	}
+
+	// walk senders in a deterministic order, the heap takes care of ordering
+	senders := make([]senderKey, 0, len(mp.senderQueues))
+	for sender := range mp.senderQueues {
+		senders = append(senders, sender)
+	}
+	sort.Slice(senders, func(i, j int) bool { return senders[i].less(senders[j]) })
+
+	iterator := &priorityNonceIterator{}
+	for _, sender := range senders {
+		queue := make([]*priorityTx, len(mp.senderQueues[sender]))
+		copy(queue, mp.senderQueues[sender])
+		iterator.heads = append(iterator.heads, queue)
+	}
+	heap.Init(&iterator.heads)
+
+	return iterator.Next()
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool, using its sender and nonce
+// to identify it. It returns ErrTxNotFound if no such transaction is found.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	if !mp.remove(sender, nonce) {
+		return ErrTxNotFound
+	}
+
+	return nil
+}
+
+func (mp *PriorityNonceMempool) remove(sender senderKey, nonce uint64) bool {
+	queue := mp.senderQueues[sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+	if i == len(queue) || queue[i].nonce != nonce {
+		return false
+	}
+
+	if len(queue) == 1 {
+		delete(mp.senderQueues, sender)
+	} else {
+		mp.senderQueues[sender] = append(queue[:i], queue[i+1:]...)
+	}
+	mp.count--
+
+	return true
+}
+
+// priorityNonceIterator iterates over the queues of all senders, always
+// yielding the sender queue head which must be selected first.
+type priorityNonceIterator struct {
+	heads priorityHeads
+	tx    sdk.Tx
+}
+
+func (i *priorityNonceIterator) Next() Iterator {
+	if i.heads.Len() == 0 {
+		return nil
+	}
+
+	queue := heap.Pop(&i.heads).([]*priorityTx)
+	i.tx = queue[0].tx
+	if len(queue) > 1 {
+		heap.Push(&i.heads, queue[1:])
+	}
+
+	return i
+}
+
+func (i *priorityNonceIterator) Tx() sdk.Tx {
+	return i.tx
+}
+
+// priorityHeads is a heap of sender queues ordered by their head transaction.
+type priorityHeads [][]*priorityTx
+
+func (h priorityHeads) Len() int            { return len(h) }
+func (h priorityHeads) Less(i, j int) bool  { return h[i][0].before(h[j][0]) }
+func (h priorityHeads) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *priorityHeads) Push(x interface{}) { *h = append(*h, x.([]*priorityTx)) }
+
+func (h *priorityHeads) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
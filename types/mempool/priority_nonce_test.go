@@ -0,0 +1,154 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestPriorityNonceMempoolOrdering(t *testing.T) {
+	keys := newTestKeys(3)
+
+	testCases := []struct {
+		name string
+		txs  []testTx
+		// expected selection order, as tx ids
+		order []int
+	}{
+		{
+			name: "priority order across senders",
+			txs: []testTx{
+				{id: 0, priority: 5, pubKey: keys[0]},
+				{id: 1, priority: 20, pubKey: keys[1]},
+				{id: 2, priority: 10, pubKey: keys[2]},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "nonce order within a sender",
+			txs: []testTx{
+				{id: 0, priority: 30, nonce: 2, pubKey: keys[0]},
+				{id: 1, priority: 10, nonce: 1, pubKey: keys[0]},
+				{id: 2, priority: 20, nonce: 0, pubKey: keys[1]},
+			},
+			// tx 0 has the highest priority but must wait for tx 1
+			order: []int{2, 1, 0},
+		},
+		{
+			name: "higher nonce waits for lower priority",
+			txs: []testTx{
+				{id: 0, priority: 5, nonce: 0, pubKey: keys[0]},
+				{id: 1, priority: 50, nonce: 1, pubKey: keys[0]},
+				{id: 2, priority: 10, nonce: 0, pubKey: keys[1]},
+				{id: 3, priority: 1, nonce: 0, pubKey: keys[2]},
+			},
+			order: []int{2, 0, 1, 3},
+		},
+		{
+			name: "unordered txs in timeout height order",
+			txs: []testTx{
+				{id: 0, priority: 30, pubKey: keys[0], unordered: true, timeout: 20},
+				{id: 1, priority: 10, pubKey: keys[0], unordered: true, timeout: 10},
+				{id: 2, priority: 20, pubKey: keys[0]},
+			},
+			order: []int{2, 1, 0},
+		},
+		{
+			name: "same sender and nonce replaces",
+			txs: []testTx{
+				{id: 0, priority: 5, nonce: 0, pubKey: keys[0]},
+				{id: 1, priority: 10, nonce: 0, pubKey: keys[1]},
+				{id: 2, priority: 20, nonce: 0, pubKey: keys[0]},
+			},
+			order: []int{2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			insertTxs(t, mp, tc.txs)
+
+			require.Equal(t, len(tc.order), mp.CountTx())
+			require.Equal(t, tc.order, selectIDs(mp))
+		})
+	}
+}
+
+func TestPriorityNonceMempoolDeterministic(t *testing.T) {
+	keys := newTestKeys(10)
+
+	var txs []testTx
+	for i, key := range keys {
+		// all txs share the same priority, ties are broken by sender
+		txs = append(txs, testTx{id: i, priority: 1, pubKey: key})
+	}
+
+	mp := mempool.NewPriorityMempool()
+	insertTxs(t, mp, txs)
+	expected := selectIDs(mp)
+
+	for i := 0; i < 5; i++ {
+		mp := mempool.NewPriorityMempool()
+		insertTxs(t, mp, txs)
+		require.Equal(t, expected, selectIDs(mp))
+	}
+}
+
+func TestPriorityNonceMempoolRemove(t *testing.T) {
+	keys := newTestKeys(2)
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, pubKey: keys[0]},
+		{id: 1, priority: 10, nonce: 1, pubKey: keys[0]},
+		{id: 2, priority: 5, nonce: 0, pubKey: keys[1]},
+	}
+
+	mp := mempool.NewPriorityMempool()
+	insertTxs(t, mp, txs)
+
+	// the iterator isn't affected by removals
+	it := mp.Select(newTestContext(), nil)
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, 0, it.Tx().(testTx).id)
+
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 5, pubKey: keys[1]}), mempool.ErrTxNotFound)
+
+	require.Equal(t, []int{1, 2}, selectIDs(mp))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestContext(), nil))
+}
+
+func TestPriorityNonceMempoolMaxTx(t *testing.T) {
+	keys := newTestKeys(4)
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(3))
+	insertTxs(t, mp, []testTx{
+		{id: 0, priority: 10, nonce: 0, pubKey: keys[0]},
+		{id: 1, priority: 5, nonce: 0, pubKey: keys[1]},
+		{id: 2, priority: 1, nonce: 1, pubKey: keys[1]},
+	})
+	ctx := newTestContext()
+
+	// a tx with a priority not higher than the eviction candidate is rejected
+	err := mp.Insert(ctx.WithPriority(1), testTx{id: 3, priority: 1, pubKey: keys[2]})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// a tx can't evict one of its own sender
+	err = mp.Insert(ctx.WithPriority(50), testTx{id: 3, priority: 50, nonce: 2, pubKey: keys[1]})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// replacing a tx doesn't need room
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{id: 4, priority: 20, nonce: 0, pubKey: keys[0]}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// a higher priority tx evicts the last selected sender tail
+	require.NoError(t, mp.Insert(ctx.WithPriority(7), testTx{id: 5, priority: 7, pubKey: keys[3]}))
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []int{4, 5, 1}, selectIDs(mp))
+}
@@ -0,0 +1,204 @@
+package mempool
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*SenderNonceMempool)(nil)
+	_ Iterator = (*senderNonceIterator)(nil)
+)
+
+// DefaultSenderNonceSeed is the seed used by a SenderNonceMempool to shuffle
+// senders when none is set.
+const DefaultSenderNonceSeed = 1
+
+// SenderNonceMempool is a mempool which keeps the transactions of each sender
+// in nonce order, and selects transactions one sender at a time.
+//
+// Each Select shuffles the senders with a pseudo-random generator seeded at
+// construction, then yields the lowest nonce transaction of each sender in
+// turn until all transactions are exhausted. The order of selection therefore
+// doesn't favor any sender, while being deterministic for a given seed and
+// history of calls. Inserting a transaction with the same sender and nonce as
+// one already in the mempool replaces it if it has a strictly higher priority,
+// as set in the context by the AnteHandler, and is rejected otherwise.
+//
+// Unordered transactions are queued apart from the ordered transactions of
+// their sender, in timeout height order, and replaced the same way.
+//
+// When the mempool is full, new transactions are rejected.
+type SenderNonceMempool struct {
+	senderQueues map[senderKey][]senderTx
+	count        int
+	maxTx        int
+	rnd          *rand.Rand
+}
+
+// SenderNonceOption is an option for a SenderNonceMempool.
+type SenderNonceOption func(*SenderNonceMempool)
+
+// SenderNonceSeedOpt sets the seed used to shuffle senders on Select.
+func SenderNonceSeedOpt(seed int64) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.rnd = rand.New(rand.NewSource(seed)) //nolint:gosec // deterministic randomness is wanted here
+	}
+}
+
+// SenderNonceMaxTxOpt sets the maximum number of transactions held by the
+// mempool. A value of 0 or less means the mempool is unbounded.
+func SenderNonceMaxTxOpt(maxTx int) SenderNonceOption {
+	return func(mp *SenderNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// NewSenderNonceMempool returns a new, empty, SenderNonceMempool.
+func NewSenderNonceMempool(opts ...SenderNonceOption) *SenderNonceMempool {
+	mp := &SenderNonceMempool{
+		senderQueues: make(map[senderKey][]senderTx),
+	}
+	SenderNonceSeedOpt(DefaultSenderNonceSeed)(mp)
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+type senderTx struct {
+	tx       sdk.Tx
+	nonce    uint64
+	priority int64
+}
+
+// Insert adds a transaction to the mempool. The context must wrap an
+// sdk.Context, whose priority is compared when replacing a transaction.
+func (mp *SenderNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	sender, nonce, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	stx := senderTx{
+		tx:       tx,
+		nonce:    nonce,
+		priority: sdk.UnwrapSDKContext(ctx).Priority(),
+	}
+
+	queue := mp.senderQueues[sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+	if i < len(queue) && queue[i].nonce == nonce {
+		if queue[i].priority >= stx.priority {
+			return ErrTxReplacementUnderpriced
+		}
+
+		queue[i] = stx
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	queue = append(queue, senderTx{})
+	copy(queue[i+1:], queue[i:])
+	queue[i] = stx
+	mp.senderQueues[sender] = queue
+	mp.count++
+
+	return nil
+}
+
+// Select returns an iterator over the transactions of the mempool in
+// selection order. The iterator works on a snapshot of the mempool, so it is
+// not affected by later insertions or removals. The txs argument is ignored.
+func (mp *SenderNonceMempool) Select(_ context.Context, _ [][]byte) Iterator {
+	if mp.count == 0 {
+		return nil
+	}
+
+	// sort senders first as map iteration order is random
+	senders := make([]senderKey, 0, len(mp.senderQueues))
+	for sender := range mp.senderQueues {
+		senders = append(senders, sender)
+	}
+	sort.Slice(senders, func(i, j int) bool { return senders[i].less(senders[j]) })
+	mp.rnd.Shuffle(len(senders), func(i, j int) { senders[i], senders[j] = senders[j], senders[i] })
+
+	iterator := &senderNonceIterator{queues: make([][]senderTx, len(senders))}
+	for i, sender := range senders {
+		queue := make([]senderTx, len(mp.senderQueues[sender]))
+		copy(queue, mp.senderQueues[sender])
+		iterator.queues[i] = queue
+	}
+
+	return iterator.Next()
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *SenderNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool, using its sender and nonce
+// to identify it. It returns ErrTxNotFound if no such transaction is found.
+func (mp *SenderNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	queue := mp.senderQueues[sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].nonce >= nonce })
+	if i == len(queue) || queue[i].nonce != nonce {
+		return ErrTxNotFound
+	}
+
+	if len(queue) == 1 {
+		delete(mp.senderQueues, sender)
+	} else {
+		mp.senderQueues[sender] = append(queue[:i], queue[i+1:]...)
+	}
+	mp.count--
+
+	return nil
+}
+
+// senderNonceIterator yields the head of each sender queue in turn.
+type senderNonceIterator struct {
+	queues [][]senderTx
+	pos    int
+	tx     sdk.Tx
+}
+
+func (i *senderNonceIterator) Next() Iterator {
+	if len(i.queues) == 0 {
+		return nil
+	}
+
+	if i.pos >= len(i.queues) {
+		i.pos = 0
+	}
+
+	queue := i.queues[i.pos]
+	i.tx = queue[0].tx
+	if len(queue) > 1 {
+		i.queues[i.pos] = queue[1:]
+		i.pos++
+	} else {
+		// the sender is exhausted, the next sender takes its position
+		i.queues = append(i.queues[:i.pos], i.queues[i.pos+1:]...)
+	}
+
+	return i
+}
+
+func (i *senderNonceIterator) Tx() sdk.Tx {
+	return i.tx
+}
@@ -0,0 +1,101 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+func TestSenderNonceMempoolOrdering(t *testing.T) {
+	keys := newTestKeys(3)
+	txs := []testTx{
+		{id: 0, nonce: 2, pubKey: keys[0]},
+		{id: 1, nonce: 0, pubKey: keys[0]},
+		{id: 2, nonce: 1, pubKey: keys[0]},
+		{id: 3, nonce: 5, pubKey: keys[1]},
+		{id: 4, nonce: 3, pubKey: keys[1]},
+		{id: 5, nonce: 0, pubKey: keys[2]},
+	}
+	senderOf := map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 2}
+
+	mp := mempool.NewSenderNonceMempool()
+	insertTxs(t, mp, txs)
+	require.Equal(t, len(txs), mp.CountTx())
+
+	ids := selectIDs(mp)
+	require.Len(t, ids, len(txs))
+
+	// txs of a same sender are selected in nonce order
+	lastNonce := map[int]uint64{}
+	for _, id := range ids {
+		sender := senderOf[id]
+		if nonce, ok := lastNonce[sender]; ok {
+			require.Greater(t, txs[id].nonce, nonce)
+		}
+		lastNonce[sender] = txs[id].nonce
+	}
+
+	// senders are selected in turn: all three senders go first
+	firstRound := map[int]bool{}
+	for _, id := range ids[:3] {
+		firstRound[senderOf[id]] = true
+	}
+	require.Len(t, firstRound, 3)
+}
+
+func TestSenderNonceMempoolDeterministic(t *testing.T) {
+	keys := newTestKeys(10)
+
+	var txs []testTx
+	for i, key := range keys {
+		txs = append(txs, testTx{id: i, pubKey: key})
+	}
+
+	newMempool := func() mempool.Mempool {
+		mp := mempool.NewSenderNonceMempool(mempool.SenderNonceSeedOpt(42))
+		insertTxs(t, mp, txs)
+		return mp
+	}
+
+	mp := newMempool()
+	first, second := selectIDs(mp), selectIDs(mp)
+
+	for i := 0; i < 5; i++ {
+		mp := newMempool()
+		require.Equal(t, first, selectIDs(mp))
+		require.Equal(t, second, selectIDs(mp))
+	}
+}
+
+func TestSenderNonceMempoolRemove(t *testing.T) {
+	keys := newTestKeys(1)
+	txs := []testTx{
+		{id: 0, nonce: 0, pubKey: keys[0]},
+		{id: 1, nonce: 1, pubKey: keys[0]},
+	}
+
+	mp := mempool.NewSenderNonceMempool()
+	insertTxs(t, mp, txs)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.Equal(t, []int{1}, selectIDs(mp))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.Equal(t, 0, mp.CountTx())
+	require.Nil(t, mp.Select(newTestContext(), nil))
+}
+
+func TestSenderNonceMempoolMaxTx(t *testing.T) {
+	keys := newTestKeys(2)
+	mp := mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(1))
+	insertTxs(t, mp, []testTx{{id: 0, pubKey: keys[0]}})
+
+	require.ErrorIs(t, mp.Insert(newTestContext(), testTx{id: 1, pubKey: keys[1]}), mempool.ErrMempoolTxMaxCapacity)
+
+	// replacing a tx doesn't need room
+	require.NoError(t, mp.Insert(newTestContext().WithPriority(1), testTx{id: 2, priority: 1, pubKey: keys[0]}))
+	require.Equal(t, []int{2}, selectIDs(mp))
+}
@@ -6,8 +6,11 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	types1 "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -375,6 +378,11 @@ type SimulateRequest struct {
 	//
 	// Since: cosmos-sdk 0.43
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// state_diff, when set, requests the state changes of the transaction in
+	// the response.
+	//
+	// Since: cosmos-sdk 0.47
+	StateDiff bool `protobuf:"varint,3,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -425,6 +433,13 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetStateDiff() bool {
+	if m != nil {
+		return m.StateDiff
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -432,6 +447,16 @@ type SimulateResponse struct {
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// state_diff is the list of the writes and deletes the transaction would
+	// make, ordered by store key then by key. It is only set when requested.
+	//
+	// Since: cosmos-sdk 0.47
+	StateDiff []*StateChange `protobuf:"bytes,3,rep,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
+	// balance_changes are the changes of the bank balances decoded from the
+	// state diff. They are only set when the state diff is requested.
+	//
+	// Since: cosmos-sdk 0.47
+	BalanceChanges []BalanceChange `protobuf:"bytes,4,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
@@ -481,6 +506,172 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetStateDiff() []*StateChange {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
+}
+
+func (m *SimulateResponse) GetBalanceChanges() []BalanceChange {
+	if m != nil {
+		return m.BalanceChanges
+	}
+	return nil
+}
+
+// StateChange is a write or a delete made by a simulated transaction.
+//
+// Since: cosmos-sdk 0.47
+type StateChange struct {
+	// store_key is the name of the store key of the KVStore.
+	StoreKey string `protobuf:"bytes,1,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	// delete is true when the key is deleted.
+	Delete bool `protobuf:"varint,2,opt,name=delete,proto3" json:"delete,omitempty"`
+	// key is the written key.
+	Key []byte `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the written value, empty on deletes.
+	Value []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	// old_value is the value of the key before the transaction, empty if it
+	// was not set.
+	OldValue []byte `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+}
+
+func (m *StateChange) Reset()         { *m = StateChange{} }
+func (m *StateChange) String() string { return proto.CompactTextString(m) }
+func (*StateChange) ProtoMessage()    {}
+func (*StateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *StateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateChange.Merge(m, src)
+}
+func (m *StateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateChange proto.InternalMessageInfo
+
+func (m *StateChange) GetStoreKey() string {
+	if m != nil {
+		return m.StoreKey
+	}
+	return ""
+}
+
+func (m *StateChange) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *StateChange) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StateChange) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateChange) GetOldValue() []byte {
+	if m != nil {
+		return m.OldValue
+	}
+	return nil
+}
+
+// BalanceChange is the change of the balances of an account made by a
+// simulated transaction.
+//
+// Since: cosmos-sdk 0.47
+type BalanceChange struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sent are the coins the balances of the account decreased by.
+	Sent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent"`
+	// received are the coins the balances of the account increased by.
+	Received github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
+}
+
+func (m *BalanceChange) Reset()         { *m = BalanceChange{} }
+func (m *BalanceChange) String() string { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()    {}
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *BalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceChange.Merge(m, src)
+}
+func (m *BalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceChange proto.InternalMessageInfo
+
+func (m *BalanceChange) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetSent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Sent
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -492,7 +683,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{8}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,7 +731,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +789,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -657,7 +848,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,6 +914,8 @@ func init() {
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*StateChange)(nil), "cosmos.tx.v1beta1.StateChange")
+	proto.RegisterType((*BalanceChange)(nil), "cosmos.tx.v1beta1.BalanceChange")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -732,70 +925,87 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1a, 0xc7,
-	0x17, 0xf7, 0x2e, 0x60, 0xc8, 0xc3, 0x4e, 0xc8, 0xd8, 0x5f, 0x7b, 0x43, 0xf2, 0xc5, 0x64, 0x53,
-	0x6c, 0x82, 0xe4, 0x5d, 0x85, 0xa6, 0x52, 0x55, 0x55, 0xaa, 0xcc, 0x8f, 0x50, 0x9a, 0x26, 0x44,
-	0x8b, 0xab, 0x28, 0x55, 0x25, 0xb4, 0xc0, 0x04, 0x56, 0x81, 0x1d, 0xcc, 0x0c, 0xd6, 0x22, 0xc7,
-	0xaa, 0xd4, 0x63, 0x4f, 0x55, 0x7b, 0xe8, 0xbf, 0xd4, 0x63, 0xa4, 0x5e, 0xda, 0x5b, 0x65, 0xf7,
-	0xd4, 0x53, 0xff, 0x84, 0x6a, 0x67, 0x07, 0x58, 0x60, 0x89, 0x93, 0x5c, 0xec, 0x19, 0xe6, 0xf3,
-	0xde, 0xfb, 0xbc, 0xcf, 0x9b, 0xf7, 0x66, 0x61, 0xaf, 0x45, 0x68, 0x9f, 0x50, 0x9d, 0x39, 0xfa,
-	0xe9, 0x83, 0x26, 0x66, 0xe6, 0x03, 0x9d, 0xe2, 0xe1, 0xa9, 0xd5, 0xc2, 0xda, 0x60, 0x48, 0x18,
-	0x41, 0x37, 0x3d, 0x80, 0xc6, 0x1c, 0x4d, 0x00, 0x92, 0x77, 0x3a, 0x84, 0x74, 0x7a, 0x58, 0x37,
-	0x07, 0x96, 0x6e, 0xda, 0x36, 0x61, 0x26, 0xb3, 0x88, 0x4d, 0x3d, 0x83, 0xe4, 0x3d, 0xe1, 0xb1,
-	0x69, 0x52, 0xac, 0x9b, 0xcd, 0x96, 0x35, 0x75, 0xec, 0x6e, 0x04, 0x28, 0xb9, 0x1c, 0x96, 0x39,
-	0xe2, 0x2c, 0xe7, 0x77, 0x70, 0x32, 0xc2, 0xc3, 0xf1, 0x14, 0x33, 0x30, 0x3b, 0x96, 0xcd, 0xa3,
-	0x09, 0xec, 0x1d, 0x86, 0xed, 0x36, 0x1e, 0xf6, 0x2d, 0x9b, 0xe9, 0x6c, 0x3c, 0xc0, 0x54, 0x6f,
-	0xf6, 0x48, 0xeb, 0xd5, 0xca, 0x53, 0xfe, 0xd7, 0x3b, 0x55, 0xff, 0x94, 0x00, 0x55, 0x30, 0x3b,
-	0x76, 0x68, 0xf9, 0x14, 0xdb, 0xcc, 0xc0, 0x27, 0x23, 0x4c, 0x19, 0xda, 0x81, 0x75, 0xec, 0xee,
-	0xa9, 0x22, 0xa5, 0x43, 0xd9, 0x6b, 0x86, 0xd8, 0xa1, 0xaf, 0x00, 0x66, 0xe1, 0x15, 0x39, 0x2d,
-	0x65, 0xe3, 0xf9, 0x7d, 0x4d, 0xa8, 0xe3, 0x72, 0xd5, 0x38, 0xd7, 0x89, 0x4a, 0xda, 0x33, 0xb3,
-	0x83, 0x85, 0xcf, 0x82, 0xac, 0x48, 0x86, 0xcf, 0x1a, 0x7d, 0x02, 0x31, 0x32, 0x6c, 0xe3, 0x61,
-	0xa3, 0x39, 0x56, 0x42, 0x69, 0x29, 0x7b, 0x3d, 0x9f, 0xd4, 0x96, 0x74, 0xd6, 0x6a, 0x2e, 0xa4,
-	0x30, 0x36, 0xa2, 0xc4, 0x5b, 0x20, 0x04, 0xe1, 0x81, 0xd9, 0xc1, 0x4a, 0x38, 0x2d, 0x65, 0xc3,
-	0x06, 0x5f, 0xa3, 0x6d, 0x88, 0xf4, 0xac, 0xbe, 0xc5, 0x94, 0x08, 0xff, 0xd1, 0xdb, 0xa8, 0xff,
-	0x48, 0xb0, 0x35, 0x97, 0x1b, 0x1d, 0x10, 0x9b, 0x62, 0x74, 0x00, 0x21, 0xe6, 0x78, 0x99, 0xc5,
-	0xf3, 0xff, 0x0b, 0x88, 0x79, 0xec, 0x18, 0x2e, 0x02, 0x55, 0x60, 0x83, 0x39, 0x8d, 0xa1, 0xb0,
-	0xa3, 0x8a, 0xcc, 0x2d, 0x3e, 0x9a, 0xcb, 0x97, 0xd7, 0xd3, 0x67, 0x28, 0xc0, 0x46, 0x9c, 0x4d,
-	0xd7, 0x14, 0x3d, 0x9e, 0x93, 0x2d, 0xc4, 0x65, 0x3b, 0xb8, 0x52, 0x36, 0xcf, 0x7a, 0x49, 0xb7,
-	0x6d, 0x88, 0x30, 0xc2, 0xcc, 0x9e, 0x50, 0xc0, 0xdb, 0xa8, 0x18, 0x50, 0x61, 0x48, 0xcc, 0x76,
-	0xcb, 0xa4, 0xcc, 0xa5, 0xe1, 0xd5, 0xf1, 0x16, 0xc4, 0x98, 0xd3, 0x68, 0x8e, 0x19, 0x76, 0xf3,
-	0x95, 0xb2, 0x1b, 0x46, 0x94, 0x39, 0x05, 0x77, 0x8b, 0x1e, 0x42, 0xb8, 0x4f, 0xda, 0x98, 0x17,
-	0xf1, 0x7a, 0x3e, 0x1d, 0x20, 0xc3, 0xd4, 0xdf, 0x13, 0xd2, 0xc6, 0x06, 0x47, 0xab, 0xdf, 0xc1,
-	0xd6, 0x5c, 0x18, 0x21, 0x69, 0x19, 0xe2, 0x3e, 0xa5, 0x78, 0xa8, 0x77, 0x15, 0x0a, 0x66, 0x42,
-	0xa9, 0xcf, 0xe1, 0x46, 0xdd, 0xea, 0x8f, 0x7a, 0x26, 0x9b, 0xdc, 0x1a, 0x74, 0x1f, 0x64, 0xe6,
-	0x08, 0x87, 0xc1, 0xb5, 0xe2, 0x02, 0xc9, 0xcc, 0x99, 0x4b, 0x56, 0x9e, 0x4b, 0x56, 0xfd, 0x51,
-	0x82, 0xc4, 0xcc, 0xb3, 0x20, 0xfd, 0x39, 0xc4, 0x3a, 0x26, 0x6d, 0x58, 0xf6, 0x4b, 0x22, 0x02,
-	0xdc, 0x5d, 0xcd, 0xb8, 0x62, 0xd2, 0xaa, 0xfd, 0x92, 0x18, 0xd1, 0x8e, 0xb7, 0x40, 0x9f, 0xc2,
-	0xfa, 0x10, 0xd3, 0x51, 0x8f, 0x89, 0x36, 0x48, 0xaf, 0xb6, 0x35, 0x38, 0xce, 0x10, 0x78, 0x55,
-	0x85, 0x0d, 0x7e, 0x2d, 0x27, 0x29, 0x22, 0x08, 0x77, 0x4d, 0xda, 0xe5, 0x1c, 0xae, 0x19, 0x7c,
-	0xad, 0x9e, 0xc3, 0xa6, 0xc0, 0x08, 0xb2, 0x99, 0x2b, 0x75, 0xe0, 0x1a, 0x2c, 0x14, 0x42, 0xfe,
-	0xc0, 0x42, 0x38, 0xb0, 0x53, 0xc1, 0xac, 0xe0, 0x8e, 0x91, 0xe7, 0x16, 0xeb, 0x1e, 0x3b, 0xd4,
-	0x37, 0x19, 0xba, 0xd8, 0xea, 0x74, 0x19, 0xe7, 0x12, 0x32, 0xc4, 0x0e, 0x3d, 0xfa, 0xf0, 0xc9,
-	0xe0, 0xbf, 0xdd, 0xea, 0xbf, 0x12, 0xec, 0x2e, 0x85, 0x7e, 0xdf, 0xc6, 0x7d, 0x08, 0x31, 0x3e,
-	0x02, 0x1b, 0x56, 0x5b, 0x50, 0xb9, 0xa5, 0xcd, 0xc6, 0xa0, 0xe6, 0x0d, 0x40, 0x1e, 0xa2, 0x5a,
-	0x32, 0xa2, 0x1c, 0x5a, 0x6d, 0xa3, 0x43, 0x88, 0xf0, 0xa5, 0x68, 0xd0, 0xdd, 0x15, 0x26, 0x86,
-	0x87, 0x42, 0x95, 0xb9, 0x8c, 0xc3, 0xef, 0xd5, 0xd4, 0xfe, 0x94, 0x73, 0x5f, 0x42, 0x54, 0x4c,
-	0x39, 0xa4, 0xc0, 0x76, 0xcd, 0x28, 0x95, 0x8d, 0x46, 0xe1, 0x45, 0xe3, 0x9b, 0xa7, 0xf5, 0x67,
-	0xe5, 0x62, 0xf5, 0x51, 0xb5, 0x5c, 0x4a, 0xac, 0xa1, 0x04, 0x6c, 0x4c, 0x4f, 0x8e, 0xea, 0xc5,
-	0x84, 0x84, 0x6e, 0xc2, 0xe6, 0xf4, 0x97, 0x52, 0xb9, 0x5e, 0x4c, 0xc8, 0xb9, 0xd7, 0xb0, 0x39,
-	0xd7, 0xb4, 0x28, 0x05, 0xc9, 0x82, 0x51, 0x3b, 0x2a, 0x15, 0x8f, 0xea, 0xc7, 0x8d, 0x27, 0xb5,
-	0x52, 0x79, 0xc1, 0xab, 0x02, 0xdb, 0x0b, 0xe7, 0x85, 0xaf, 0x6b, 0xc5, 0xc7, 0x09, 0x09, 0xed,
-	0xc2, 0xd6, 0xc2, 0x49, 0xfd, 0xc5, 0xd3, 0x62, 0x42, 0x0e, 0x30, 0x39, 0xe2, 0x27, 0xa1, 0xfc,
-	0xcf, 0x11, 0x88, 0xd6, 0xbd, 0x77, 0x13, 0x9d, 0x41, 0x6c, 0xd2, 0x6f, 0x48, 0x0d, 0xa8, 0xd4,
-	0x42, 0x9b, 0x27, 0xef, 0xbd, 0x15, 0x23, 0x6e, 0xe5, 0xfe, 0x0f, 0xbf, 0xff, 0xfd, 0x8b, 0x9c,
-	0x56, 0x6f, 0xeb, 0x01, 0x0f, 0xb6, 0x00, 0x7f, 0x26, 0xe5, 0xd0, 0x09, 0x44, 0x78, 0xf3, 0xa0,
-	0xbd, 0x00, 0xaf, 0xfe, 0xd6, 0x4b, 0xa6, 0x57, 0x03, 0x44, 0xcc, 0x0c, 0x8f, 0xb9, 0x87, 0xfe,
-	0xaf, 0x07, 0xbd, 0xd6, 0x54, 0x3f, 0x73, 0xdb, 0xf5, 0x1c, 0x7d, 0x0f, 0x71, 0xdf, 0x5c, 0x44,
-	0x99, 0xb7, 0x8d, 0xd3, 0x59, 0xf8, 0xfd, 0xab, 0x60, 0x82, 0xc4, 0x5d, 0x4e, 0xe2, 0xb6, 0xba,
-	0x13, 0x4c, 0xc2, 0xcd, 0xf9, 0x35, 0xc4, 0x7d, 0x6f, 0x5d, 0x20, 0x81, 0xe5, 0x77, 0x3e, 0x90,
-	0x40, 0xc0, 0x93, 0xa9, 0xa6, 0x38, 0x01, 0x05, 0xad, 0x20, 0x80, 0x7e, 0x95, 0xe0, 0xc6, 0x42,
-	0xd7, 0xa2, 0xfb, 0xc1, 0xbe, 0x03, 0x86, 0x4a, 0x32, 0xf7, 0x2e, 0x50, 0x41, 0xe5, 0x90, 0x53,
-	0x39, 0x40, 0x99, 0x15, 0x05, 0xe1, 0xcd, 0xa9, 0x9f, 0x79, 0x63, 0xe9, 0xbc, 0xf0, 0xc5, 0x6f,
-	0x17, 0x29, 0xe9, 0xcd, 0x45, 0x4a, 0xfa, 0xeb, 0x22, 0x25, 0xfd, 0x74, 0x99, 0x5a, 0x7b, 0x73,
-	0x99, 0x5a, 0xfb, 0xe3, 0x32, 0xb5, 0xf6, 0x6d, 0xa6, 0x63, 0xb1, 0xee, 0xa8, 0xa9, 0xb5, 0x48,
-	0x7f, 0xe2, 0xca, 0xfb, 0x77, 0x48, 0xdb, 0xaf, 0x26, 0x9f, 0x4a, 0x4e, 0x73, 0x9d, 0x7f, 0x28,
-	0x7d, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x12, 0x2b, 0xbc, 0x25, 0x0a, 0x00, 0x00,
+	// 1267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0x4f, 0xed, 0x3c, 0x27, 0xad, 0x3b, 0x0d, 0xed, 0xc6, 0xa5, 0x8e, 0xbb, 0x25,
+	0x69, 0x1a, 0x29, 0x5e, 0x1a, 0x8a, 0x84, 0x10, 0x08, 0xc5, 0x7f, 0x1a, 0x42, 0x69, 0x53, 0x8d,
+	0x03, 0xa8, 0x08, 0x69, 0xb5, 0xf6, 0x4e, 0xd6, 0xab, 0x3a, 0x3b, 0xe9, 0xce, 0x24, 0x5a, 0xab,
+	0xad, 0x90, 0xb8, 0x70, 0x42, 0x42, 0x70, 0x40, 0xe2, 0x23, 0x70, 0xe6, 0x43, 0xf4, 0x58, 0xc1,
+	0x05, 0x2e, 0x80, 0x1a, 0x4e, 0x9c, 0xf8, 0x08, 0x68, 0x67, 0xc6, 0xce, 0xda, 0x59, 0x37, 0x6d,
+	0xc5, 0xc5, 0x9e, 0xb7, 0xef, 0xf7, 0xfe, 0xcf, 0x7b, 0x6f, 0x60, 0xa1, 0x43, 0xd9, 0x2e, 0x65,
+	0x26, 0x0f, 0xcd, 0x83, 0xeb, 0x6d, 0xc2, 0xed, 0xeb, 0x26, 0x23, 0xc1, 0x81, 0xd7, 0x21, 0xd5,
+	0xbd, 0x80, 0x72, 0x8a, 0xce, 0x4a, 0x40, 0x95, 0x87, 0x55, 0x05, 0x28, 0xcd, 0xb9, 0xd4, 0xa5,
+	0x82, 0x6b, 0x46, 0x27, 0x09, 0x2c, 0xbd, 0xee, 0x52, 0xea, 0xf6, 0x88, 0x69, 0xef, 0x79, 0xa6,
+	0xed, 0xfb, 0x94, 0xdb, 0xdc, 0xa3, 0x3e, 0x53, 0xdc, 0x79, 0xa9, 0xc6, 0x92, 0x62, 0x4a, 0xa7,
+	0x64, 0x95, 0x95, 0x0b, 0x6d, 0x9b, 0x91, 0xa1, 0x13, 0x1d, 0xea, 0xf9, 0x8a, 0x7f, 0x25, 0xce,
+	0xb7, 0xdb, 0x1d, 0x6f, 0x08, 0x8a, 0x08, 0x05, 0x2a, 0x1d, 0x8f, 0x83, 0x87, 0x8a, 0xb7, 0x12,
+	0x57, 0xf0, 0x60, 0x9f, 0x04, 0xfd, 0x21, 0x66, 0xcf, 0x76, 0x3d, 0x5f, 0x38, 0x3a, 0x88, 0x82,
+	0x13, 0xdf, 0x21, 0xc1, 0xae, 0xe7, 0x73, 0x93, 0xf7, 0xf7, 0x08, 0x33, 0xdb, 0x3d, 0xda, 0xb9,
+	0x3f, 0x91, 0x2b, 0x7e, 0x25, 0xd7, 0xf8, 0x5d, 0x03, 0xb4, 0x41, 0xf8, 0x76, 0xc8, 0x9a, 0x07,
+	0xc4, 0xe7, 0x98, 0x3c, 0xd8, 0x27, 0x8c, 0xa3, 0xf3, 0x70, 0x8a, 0x44, 0x34, 0xd3, 0xb5, 0x4a,
+	0x7a, 0x79, 0x1a, 0x2b, 0x0a, 0x7d, 0x04, 0x70, 0x64, 0x5e, 0x4f, 0x55, 0xb4, 0xe5, 0xc2, 0xda,
+	0x52, 0x55, 0xa5, 0x26, 0xf2, 0xb5, 0x2a, 0x7c, 0x1d, 0xa4, 0xbd, 0x7a, 0xd7, 0x76, 0x89, 0xd2,
+	0x59, 0x4b, 0xe9, 0x1a, 0x8e, 0x49, 0xa3, 0xb7, 0x21, 0x4f, 0x03, 0x87, 0x04, 0x56, 0xbb, 0xaf,
+	0xa7, 0x2b, 0xda, 0xf2, 0xe9, 0xb5, 0x52, 0xf5, 0x58, 0xe1, 0xaa, 0x5b, 0x11, 0xa4, 0xd6, 0xc7,
+	0x39, 0x2a, 0x0f, 0x08, 0x41, 0x66, 0xcf, 0x76, 0x89, 0x9e, 0xa9, 0x68, 0xcb, 0x19, 0x2c, 0xce,
+	0x68, 0x0e, 0xb2, 0x3d, 0x6f, 0xd7, 0xe3, 0x7a, 0x56, 0x7c, 0x94, 0x84, 0xf1, 0x8f, 0x06, 0xe7,
+	0x46, 0x62, 0x63, 0x7b, 0xd4, 0x67, 0x04, 0x5d, 0x85, 0x34, 0x0f, 0x65, 0x64, 0x85, 0xb5, 0xd7,
+	0x12, 0x6c, 0x6e, 0x87, 0x38, 0x42, 0xa0, 0x0d, 0x98, 0xe1, 0xa1, 0x15, 0x28, 0x39, 0xa6, 0xa7,
+	0x84, 0xc4, 0x1b, 0x23, 0xf1, 0x8a, 0x7a, 0xc6, 0x04, 0x15, 0x18, 0x17, 0xf8, 0xf0, 0xcc, 0xd0,
+	0xad, 0x91, 0xb4, 0xa5, 0x45, 0xda, 0xae, 0x9e, 0x98, 0x36, 0x29, 0x7d, 0x2c, 0x6f, 0x73, 0x90,
+	0xe5, 0x94, 0xdb, 0x3d, 0x95, 0x01, 0x49, 0x18, 0x04, 0x50, 0x2d, 0xa0, 0xb6, 0xd3, 0xb1, 0x19,
+	0xdf, 0x0e, 0x55, 0xce, 0xd1, 0x3c, 0xe4, 0x79, 0x68, 0xb5, 0xfb, 0x9c, 0x44, 0xf1, 0x6a, 0xcb,
+	0x33, 0x38, 0xc7, 0xc3, 0x5a, 0x44, 0xa2, 0x1b, 0x90, 0xd9, 0xa5, 0x0e, 0x11, 0x45, 0x3c, 0xbd,
+	0x56, 0x49, 0x48, 0xc3, 0x50, 0xdf, 0x6d, 0xea, 0x10, 0x2c, 0xd0, 0xc6, 0x17, 0x70, 0x6e, 0xc4,
+	0x8c, 0x4a, 0x69, 0x13, 0x0a, 0xb1, 0x4c, 0x09, 0x53, 0x2f, 0x9a, 0x28, 0x38, 0x4a, 0x94, 0x71,
+	0x00, 0x67, 0x5a, 0xde, 0xee, 0x7e, 0xcf, 0xe6, 0x83, 0x5b, 0x83, 0xae, 0x41, 0x8a, 0x87, 0x4a,
+	0x61, 0x72, 0xad, 0x44, 0x82, 0x52, 0x3c, 0x1c, 0x09, 0x36, 0x35, 0x1a, 0xec, 0x25, 0x00, 0xc6,
+	0x6d, 0x4e, 0x2c, 0xc7, 0xdb, 0xd9, 0x11, 0x05, 0xc8, 0xe3, 0x69, 0xf1, 0xa5, 0xe1, 0xed, 0xec,
+	0x18, 0x3f, 0xa6, 0xa0, 0x78, 0x64, 0x58, 0xc5, 0xf4, 0x1e, 0xe4, 0x5d, 0x9b, 0x59, 0x9e, 0xbf,
+	0x43, 0x95, 0xfd, 0xcb, 0x93, 0x03, 0xda, 0xb0, 0xd9, 0xa6, 0xbf, 0x43, 0x71, 0xce, 0x95, 0x07,
+	0xf4, 0x0e, 0x9c, 0x0a, 0x08, 0xdb, 0xef, 0x71, 0xd5, 0x25, 0x95, 0xc9, 0xb2, 0x58, 0xe0, 0xb0,
+	0xc2, 0xa3, 0xf7, 0xc7, 0x7c, 0x8d, 0xee, 0x5c, 0x39, 0x21, 0xf2, 0x56, 0x04, 0xaa, 0x77, 0x6d,
+	0xdf, 0x25, 0xb1, 0x58, 0xd0, 0x16, 0x9c, 0x69, 0xdb, 0x3d, 0xdb, 0xef, 0x10, 0xab, 0x23, 0x98,
+	0x4c, 0xcf, 0x54, 0xd2, 0x71, 0x0f, 0xe2, 0x25, 0x96, 0x48, 0xa9, 0xa5, 0x96, 0x79, 0xf2, 0xc7,
+	0xc2, 0x14, 0x3e, 0xdd, 0x8e, 0x7f, 0x64, 0xc6, 0xd7, 0x1a, 0x14, 0x62, 0xb6, 0xd0, 0x45, 0x98,
+	0x66, 0x9c, 0x06, 0xc4, 0xba, 0x4f, 0xfa, 0x22, 0x31, 0xd3, 0x38, 0x2f, 0x3e, 0xdc, 0x22, 0xfd,
+	0x68, 0x70, 0x38, 0xa4, 0x47, 0xb8, 0xbc, 0x57, 0x79, 0xac, 0x28, 0x54, 0x84, 0x74, 0x04, 0x4f,
+	0x8b, 0xb2, 0x44, 0xc7, 0xe8, 0x1a, 0x1f, 0xd8, 0xbd, 0x7d, 0xd9, 0xc8, 0x33, 0x58, 0x12, 0x91,
+	0x72, 0xda, 0x73, 0x2c, 0xc9, 0xc9, 0x0a, 0x4e, 0x9e, 0xf6, 0x9c, 0x4f, 0x23, 0xda, 0xf8, 0x26,
+	0x05, 0xb3, 0x23, 0x1e, 0xa3, 0x35, 0xc8, 0xd9, 0x8e, 0x13, 0x10, 0x26, 0xaf, 0xf7, 0x74, 0x4d,
+	0xff, 0xe5, 0xe7, 0xd5, 0x39, 0x15, 0xe7, 0xba, 0xe4, 0xb4, 0x78, 0xe0, 0xf9, 0x2e, 0x1e, 0x00,
+	0x91, 0x05, 0x19, 0x46, 0x7c, 0xae, 0xba, 0x79, 0x7e, 0xa4, 0x2e, 0x83, 0xbc, 0xd4, 0xa9, 0xe7,
+	0xd7, 0xde, 0x8c, 0xd2, 0xf1, 0xd3, 0x9f, 0x0b, 0xcb, 0xae, 0xc7, 0xbb, 0xfb, 0xed, 0x6a, 0x87,
+	0xee, 0xaa, 0x2d, 0xa0, 0xfe, 0x56, 0x99, 0x73, 0x5f, 0x4d, 0xd3, 0x48, 0x80, 0x61, 0xa1, 0x18,
+	0xb9, 0x90, 0x0f, 0x48, 0x87, 0x78, 0x07, 0xc4, 0xd1, 0xd3, 0xff, 0xbf, 0x91, 0xa1, 0x72, 0xc3,
+	0x80, 0x19, 0x31, 0xdf, 0x06, 0xbd, 0x82, 0x20, 0xd3, 0xb5, 0x59, 0x57, 0x15, 0x45, 0x9c, 0x8d,
+	0xc7, 0x30, 0xab, 0x30, 0xea, 0x5a, 0x2f, 0x9e, 0xd8, 0x50, 0xa2, 0x99, 0xc6, 0x3a, 0x3a, 0xf5,
+	0x8a, 0x1d, 0x1d, 0xc2, 0xf9, 0x0d, 0xc2, 0x6b, 0xd1, 0x3e, 0xfa, 0xcc, 0xe3, 0xdd, 0xed, 0x90,
+	0xc5, 0x56, 0x4c, 0x97, 0x78, 0x6e, 0x97, 0x0b, 0x5f, 0xd2, 0x58, 0x51, 0xe8, 0xe6, 0xab, 0xaf,
+	0x98, 0xf8, 0x98, 0x34, 0xfe, 0xd5, 0xe0, 0xc2, 0x31, 0xd3, 0x2f, 0xbb, 0x01, 0x6e, 0x40, 0x5e,
+	0xec, 0x52, 0xcb, 0x73, 0x94, 0x2b, 0xf3, 0xd5, 0xa3, 0x7d, 0x5a, 0x95, 0x65, 0x11, 0x26, 0x36,
+	0x1b, 0x38, 0x27, 0xa0, 0x9b, 0x0e, 0x5a, 0x85, 0xac, 0x38, 0xaa, 0x49, 0x7f, 0x61, 0x82, 0x08,
+	0x96, 0x28, 0xb4, 0x31, 0x12, 0x71, 0xe6, 0xa5, 0xb6, 0x43, 0x3c, 0xe4, 0x95, 0x0f, 0x21, 0xa7,
+	0xd6, 0x25, 0xd2, 0x61, 0x6e, 0x0b, 0x37, 0x9a, 0xd8, 0xaa, 0xdd, 0xb3, 0x3e, 0xb9, 0xd3, 0xba,
+	0xdb, 0xac, 0x6f, 0xde, 0xdc, 0x6c, 0x36, 0x8a, 0x53, 0xa8, 0x08, 0x33, 0x43, 0xce, 0x7a, 0xab,
+	0x5e, 0xd4, 0xd0, 0x59, 0x98, 0x1d, 0x7e, 0x69, 0x34, 0x5b, 0xf5, 0x62, 0x6a, 0xe5, 0x11, 0xcc,
+	0x8e, 0x4c, 0x7f, 0x54, 0x86, 0x52, 0x0d, 0x6f, 0xad, 0x37, 0xea, 0xeb, 0xad, 0x6d, 0xeb, 0xf6,
+	0x56, 0xa3, 0x39, 0xa6, 0x55, 0x87, 0xb9, 0x31, 0x7e, 0xed, 0xe3, 0xad, 0xfa, 0xad, 0xa2, 0x86,
+	0x2e, 0xc0, 0xb9, 0x31, 0x4e, 0xeb, 0xde, 0x9d, 0x7a, 0x31, 0x95, 0x20, 0xb2, 0x2e, 0x38, 0xe9,
+	0xb5, 0xef, 0xb2, 0x90, 0x6b, 0xc9, 0x17, 0x1d, 0x7a, 0x08, 0xf9, 0xc1, 0x64, 0x46, 0x46, 0xd2,
+	0x14, 0x1c, 0xdd, 0x17, 0xa5, 0x2b, 0xcf, 0xc5, 0xa8, 0x5b, 0xb9, 0xf4, 0xd5, 0xaf, 0x7f, 0x7f,
+	0x9f, 0xaa, 0xbc, 0xab, 0xad, 0x18, 0x17, 0xcd, 0x84, 0xd7, 0xe4, 0xc0, 0xe0, 0x03, 0xc8, 0x8a,
+	0xe6, 0x41, 0x0b, 0x09, 0x5a, 0xe3, 0xad, 0x57, 0xaa, 0x4c, 0x06, 0x28, 0x9b, 0x8b, 0xc2, 0xe6,
+	0x02, 0xba, 0x64, 0x26, 0x3d, 0xfb, 0x98, 0xf9, 0x30, 0x6a, 0xd7, 0xc7, 0xe8, 0x4b, 0x28, 0xc4,
+	0x16, 0x2c, 0x5a, 0x7c, 0xde, 0x5e, 0x3e, 0x32, 0xbf, 0x74, 0x12, 0x4c, 0x39, 0x71, 0x59, 0x38,
+	0x71, 0x31, 0x0a, 0xfc, 0x7c, 0xb2, 0x1f, 0xe8, 0x11, 0x14, 0x62, 0x8f, 0xa6, 0x44, 0x07, 0x8e,
+	0x3f, 0x18, 0x4b, 0x4b, 0x27, 0xc1, 0x94, 0x03, 0x65, 0xe1, 0x80, 0x8e, 0x26, 0x59, 0xff, 0x41,
+	0x83, 0x33, 0x63, 0x5d, 0x8b, 0xae, 0x25, 0xeb, 0x4e, 0x18, 0x2a, 0xa5, 0x95, 0x17, 0x81, 0x2a,
+	0x57, 0x56, 0x85, 0x2b, 0x57, 0xd1, 0xe2, 0x84, 0x82, 0x88, 0xe6, 0x34, 0x1f, 0xca, 0xb1, 0xf4,
+	0xb8, 0xf6, 0xc1, 0x93, 0x67, 0x65, 0xed, 0xe9, 0xb3, 0xb2, 0xf6, 0xd7, 0xb3, 0xb2, 0xf6, 0xed,
+	0x61, 0x79, 0xea, 0xe9, 0x61, 0x79, 0xea, 0xb7, 0xc3, 0xf2, 0xd4, 0xe7, 0x8b, 0x27, 0x8f, 0x6e,
+	0x93, 0x87, 0xed, 0x53, 0xe2, 0xc5, 0xfd, 0xd6, 0x7f, 0x03, 0x00, 0x6b, 0x44, 0xce, 0x1f, 0xbf,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1253,6 +1463,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StateDiff {
+		i--
+		if m.StateDiff {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1295,6 +1515,34 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BalanceChanges) > 0 {
+		for iNdEx := len(m.BalanceChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StateDiff) > 0 {
+		for iNdEx := len(m.StateDiff) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateDiff[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -1322,6 +1570,125 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintService(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKey) > 0 {
+		i -= len(m.StoreKey)
+		copy(dAtA[i:], m.StoreKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sent) > 0 {
+		for iNdEx := len(m.Sent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1622,6 +1989,9 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.StateDiff {
+		n += 2
+	}
 	return n
 }
 
@@ -1639,62 +2009,127 @@ func (m *SimulateResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.StateDiff) > 0 {
+		for _, e := range m.StateDiff {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.BalanceChanges) > 0 {
+		for _, e := range m.BalanceChanges {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GetTxRequest) Size() (n int) {
+func (m *StateChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Hash)
+	l = len(m.StoreKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.OldValue)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
 	return n
 }
 
-func (m *GetTxResponse) Size() (n int) {
+func (m *BalanceChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Tx != nil {
-		l = m.Tx.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
-	if m.TxResponse != nil {
-		l = m.TxResponse.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Sent) > 0 {
+		for _, e := range m.Sent {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *GetBlockWithTxsRequest) Size() (n int) {
+func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovService(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.Hash)
+	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
 	return n
 }
 
-func (m *GetBlockWithTxsResponse) Size() (n int) {
+func (m *GetTxResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TxResponse != nil {
+		l = m.TxResponse.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockWithTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
 		for _, e := range m.Txs {
 			l = e.Size()
 			n += 1 + l + sovService(uint64(l))
@@ -2357,6 +2792,26 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StateDiff = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2479,6 +2934,428 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateDiff = append(m.StateDiff, &StateChange{})
+			if err := m.StateDiff[len(m.StateDiff)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceChanges = append(m.BalanceChanges, BalanceChange{})
+			if err := m.BalanceChanges[len(m.BalanceChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = append(m.OldValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldValue == nil {
+				m.OldValue = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sent = append(m.Sent, types.Coin{})
+			if err := m.Sent[len(m.Sent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, types.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -67,6 +67,15 @@ type (
 
 		GetTimeoutHeight() uint64
 	}
+
+	// TxWithUnordered extends the TxWithTimeoutHeight interface by allowing a
+	// transaction to opt out of sequence based replay protection. Unordered
+	// transactions are instead deduplicated by hash until their timeout height.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
 )
 
 // TxDecoder unmarshals transaction bytes
@@ -17,6 +17,18 @@ type HandlerOptions struct {
 	SignModeHandler        authsigning.SignModeHandler
 	SigGasConsumer         func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 	TxFeeChecker           TxFeeChecker
+	// UnorderedTxKeeper records the hashes of the unordered txs. Unordered txs
+	// are rejected when it is nil.
+	UnorderedTxKeeper UnorderedTxKeeper
+	// MaxUnorderedTxTTL is the maximum number of blocks an unordered tx can be
+	// valid for. It defaults to DefaultMaxUnorderedTTL.
+	MaxUnorderedTxTTL uint64
+	// MsgFeeKeeper returns the fees charged for each Msg of a type URL. No msg
+	// fees are charged when it is nil.
+	MsgFeeKeeper MsgFeeKeeper
+	// EpochingKeeper returns the msgs that must be wrapped in an epoching msg to
+	// be buffered until the end of the epoch. No msg is rejected when it is nil.
+	EpochingKeeper EpochingKeeper
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -35,14 +47,23 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	maxUnorderedTxTTL := options.MaxUnorderedTxTTL
+	if maxUnorderedTxTTL == 0 {
+		maxUnorderedTxTTL = DefaultMaxUnorderedTTL
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		NewValidateBasicDecorator(),
+		NewEpochedMsgDecorator(options.EpochingKeeper),
+		NewPubKeyRotationDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(maxUnorderedTxTTL, options.UnorderedTxKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewMsgFeeDecorator(options.AccountKeeper, options.BankKeeper, options.MsgFeeKeeper),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
@@ -0,0 +1,79 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EpochedMsgDecorator defines an AnteHandler decorator that rejects the txs
+// holding a msg which must be buffered until the end of the epoch, e.g. the
+// x/staking msgs when epoching is enabled in the x/epoching module. Such msgs
+// are only accepted wrapped in the msgs of the epoching module, so that they
+// cannot bypass the epoch buffering. The msgs nested in another msg, e.g. in
+// an authz MsgExec or in a proposal, are checked as well.
+//
+// The msgs of the genesis txs are accepted, as they are executed before the
+// first epoch starts.
+type EpochedMsgDecorator struct {
+	ek EpochingKeeper
+}
+
+// NewEpochedMsgDecorator returns an EpochedMsgDecorator. No msg is rejected
+// when ek is nil.
+func NewEpochedMsgDecorator(ek EpochingKeeper) EpochedMsgDecorator {
+	return EpochedMsgDecorator{
+		ek: ek,
+	}
+}
+
+func (d EpochedMsgDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.ek == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := d.validateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d EpochedMsgDecorator) validateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if d.ek.IsEpochedMsg(ctx, msg) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "%s is executed at the end of the epoch and must be wrapped in an epoching msg", sdk.MsgTypeURL(msg),
+			)
+		}
+
+		nestedMsgs, err := getNestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+
+		if err := d.validateMsgs(ctx, nestedMsgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// proposalMsgs is implemented by the Msgs submitting a proposal, such as the
+// x/gov and x/group MsgSubmitProposal, whose Msgs are executed once it passes.
+type proposalMsgs interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+// getNestedMsgs returns the Msgs executed by the given Msg, either in the same
+// tx or once the proposal it submits passes.
+func getNestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case nestedMsgs:
+		return msg.GetMessages()
+	case proposalMsgs:
+		return msg.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,96 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// mockEpochingKeeper buffers the Msgs of the type URLs of a set.
+type mockEpochingKeeper map[string]bool
+
+func (ek mockEpochingKeeper) IsEpochedMsg(_ sdk.Context, msg sdk.Msg) bool {
+	return ek[sdk.MsgTypeURL(msg)]
+}
+
+func TestEpochedMsgDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+
+	ek := mockEpochingKeeper{sdk.MsgTypeURL(&testdata.TestMsg{}): true}
+	epochedMsg := testdata.NewTestMsg(addr)
+	otherMsg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{otherMsg, epochedMsg})
+	proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{epochedMsg}, nil, addr.String(), "", false)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		ek      ante.EpochingKeeper
+		genesis bool
+		msgs    []sdk.Msg
+		expErr  error
+	}{
+		{
+			name: "no epoching keeper",
+			msgs: []sdk.Msg{epochedMsg},
+		},
+		{
+			name: "msg executed immediately",
+			ek:   ek,
+			msgs: []sdk.Msg{otherMsg},
+		},
+		{
+			name:   "epoched msg",
+			ek:     ek,
+			msgs:   []sdk.Msg{otherMsg, epochedMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "epoched msg nested in an authz exec",
+			ek:     ek,
+			msgs:   []sdk.Msg{&execMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "epoched msg of a proposal",
+			ek:     ek,
+			msgs:   []sdk.Msg{proposalMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:    "epoched msg of a genesis tx",
+			ek:      ek,
+			genesis: true,
+			msgs:    []sdk.Msg{epochedMsg},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msgs...))
+			tx := suite.txBuilder.GetTx()
+
+			ctx := suite.ctx
+			if tc.genesis {
+				ctx = ctx.WithBlockHeight(0)
+			}
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewEpochedMsgDecorator(tc.ek))
+			_, err := antehandler(ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
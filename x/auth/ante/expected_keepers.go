@@ -18,3 +18,22 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// UnorderedTxKeeper defines the expected keeper recording the hashes of the
+// unordered txs until their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64)
+}
+
+// MsgFeeKeeper defines the expected keeper returning the fees charged for each
+// Msg of a type URL.
+type MsgFeeKeeper interface {
+	MsgFeeCharges(ctx sdk.Context, msgTypeURL string) (gasSurcharge uint64, flatFee sdk.Coins)
+}
+
+// EpochingKeeper defines the expected keeper of the epoching module, which
+// buffers some msgs until the end of the epoch.
+type EpochingKeeper interface {
+	IsEpochedMsg(ctx sdk.Context, msg sdk.Msg) bool
+}
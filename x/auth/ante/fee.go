@@ -12,6 +12,32 @@ import (
 // the effective fee should be deducted later, and the priority should be returned in abci response.
 type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
+// DeductedFee is the fee deducted by the DeductFeeDecorator, recorded in the
+// context passed to the next AnteHandlers, and to the PostHandlers.
+type DeductedFee struct {
+	// Amount is the effective fee returned by the TxFeeChecker.
+	Amount sdk.Coins
+	// DeductedFrom is the account the fee was deducted from: the fee granter if
+	// any, the fee payer otherwise.
+	DeductedFrom sdk.AccAddress
+}
+
+type deductedFeeKey struct{}
+
+// ContextWithDeductedFee returns a context recording the given deducted fee.
+// It is called by the DeductFeeDecorator, and may be called by custom fee
+// decorators so that the PostHandlers can refund the fee.
+func ContextWithDeductedFee(ctx sdk.Context, deductedFee DeductedFee) sdk.Context {
+	return ctx.WithValue(deductedFeeKey{}, deductedFee)
+}
+
+// DeductedFeeFromContext returns the fee deducted by the DeductFeeDecorator,
+// and false if the context was not passed through the DeductFeeDecorator.
+func DeductedFeeFromContext(ctx sdk.Context) (DeductedFee, bool) {
+	deductedFee, ok := ctx.Value(deductedFeeKey{}).(DeductedFee)
+	return deductedFee, ok
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -58,23 +84,25 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 			return ctx, err
 		}
 	}
-	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
+	deductedFrom, err := dfd.checkDeductFee(ctx, tx, fee)
+	if err != nil {
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	newCtx := ContextWithDeductedFee(ctx.WithPriority(priority), DeductedFee{Amount: fee, DeductedFrom: deductedFrom})
 
 	return next(newCtx, tx, simulate)
 }
 
-func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) error {
+// checkDeductFee deducts the fee, and returns the account it was deducted from.
+func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee sdk.Coins) (sdk.AccAddress, error) {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
-		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
 	if addr := dfd.accountKeeper.GetModuleAddress(types.FeeCollectorName); addr == nil {
-		return fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
+		return nil, fmt.Errorf("fee collector module account (%s) has not been set", types.FeeCollectorName)
 	}
 
 	feePayer := feeTx.FeePayer()
@@ -85,11 +113,11 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	// this works with only when feegrant enabled.
 	if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !feeGranter.Equals(feePayer) {
 			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, fee, sdkTx.GetMsgs())
 			if err != nil {
-				return sdkerrors.Wrapf(err, "%s does not not allow to pay fees for %s", feeGranter, feePayer)
+				return nil, sdkerrors.Wrapf(err, "%s does not not allow to pay fees for %s", feeGranter, feePayer)
 			}
 		}
 
@@ -98,14 +126,14 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	deductFeesFromAcc := dfd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
-		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !fee.IsZero() {
 		err := DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fee)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -118,7 +146,7 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	}
 	ctx.EventManager().EmitEvents(events)
 
-	return nil
+	return deductFeesFrom, nil
 }
 
 // DeductFees deducts fees from the given account.
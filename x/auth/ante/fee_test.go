@@ -140,3 +140,34 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeeDecorator_DeductedFee(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	dfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	accs := s.CreateTestAccounts(1)
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(1)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	require.NoError(t, err)
+
+	_, found := ante.DeductedFeeFromContext(s.ctx)
+	require.False(t, found)
+
+	newCtx, err := antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	deductedFee, found := ante.DeductedFeeFromContext(newCtx)
+	require.True(t, found)
+	require.Equal(t, feeAmount, deductedFee.Amount)
+	require.Equal(t, accs[0].acc.GetAddress(), deductedFee.DeductedFrom)
+}
@@ -165,9 +165,18 @@ func TestDeductFeesNoDelegation(t *testing.T) {
 			var defaultGenTxGas uint64 = 10000000
 			tx, err := genTxWithFeeGranter(protoTxCfg, msgs, fee, defaultGenTxGas, suite.ctx.ChainID(), accNums, seqs, feeAcc, privs...)
 			require.NoError(t, err)
-			_, err = feeAnteHandler(suite.ctx, tx, false) // tests only feegrant ante
+			newCtx, err := feeAnteHandler(suite.ctx, tx, false) // tests only feegrant ante
 			if tc.valid {
 				require.NoError(t, err)
+
+				// the fee is deducted from the fee granter if any
+				deductedFee, found := ante.DeductedFeeFromContext(newCtx)
+				require.True(t, found)
+				if feeAcc != nil {
+					require.Equal(t, feeAcc, deductedFee.DeductedFrom)
+				} else {
+					require.Equal(t, signer.acc.GetAddress(), deductedFee.DeductedFrom)
+				}
 			} else {
 				require.ErrorIs(t, err, tc.err)
 			}
@@ -0,0 +1,102 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// nestedMsgs is implemented by the Msgs executing other Msgs in the same tx,
+// such as the x/authz MsgExec.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// MsgFeeDecorator charges the fees set per Msg type URL by the MsgFeeKeeper,
+// for each Msg of a tx, including the Msgs nested in Msgs such as the x/authz
+// MsgExec. The gas surcharges are consumed from the tx gas meter, and the flat
+// fees are deducted from the fee payer to the fee collector, in addition to the
+// fee of the tx. The flat fees are never paid by the fee granter.
+//
+// CONTRACT: the DeductFeeDecorator must run before this decorator.
+type MsgFeeDecorator struct {
+	accountKeeper AccountKeeper
+	bankKeeper    types.BankKeeper
+	mfk           MsgFeeKeeper
+}
+
+// NewMsgFeeDecorator returns a MsgFeeDecorator. No fees are charged when mfk
+// is nil.
+func NewMsgFeeDecorator(ak AccountKeeper, bk types.BankKeeper, mfk MsgFeeKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		accountKeeper: ak,
+		bankKeeper:    bk,
+		mfk:           mfk,
+	}
+}
+
+func (mfd MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if mfd.mfk == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	flatFees, err := mfd.chargeMsgFees(ctx, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	if flatFees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := mfd.accountKeeper.GetAccount(ctx, feeTx.FeePayer())
+	if feePayer == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", feeTx.FeePayer())
+	}
+
+	if err := DeductFees(mfd.bankKeeper, ctx, feePayer, flatFees); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to deduct msg fees %s", flatFees)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyMsgFee, flatFees.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feeTx.FeePayer().String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
+
+// chargeMsgFees consumes the gas surcharges of the given Msgs and of their
+// nested Msgs, and returns the sum of their flat fees.
+func (mfd MsgFeeDecorator) chargeMsgFees(ctx sdk.Context, msgs []sdk.Msg) (sdk.Coins, error) {
+	flatFees := sdk.NewCoins()
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		gasSurcharge, flatFee := mfd.mfk.MsgFeeCharges(ctx, msgTypeURL)
+		ctx.GasMeter().ConsumeGas(gasSurcharge, "msg fee gas surcharge of "+msgTypeURL)
+		flatFees = flatFees.Add(flatFee...)
+
+		if nested, ok := msg.(nestedMsgs); ok {
+			nestedMsgs, err := nested.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+
+			nestedFlatFees, err := mfd.chargeMsgFees(ctx, nestedMsgs)
+			if err != nil {
+				return nil, err
+			}
+			flatFees = flatFees.Add(nestedFlatFees...)
+		}
+	}
+
+	return flatFees, nil
+}
@@ -0,0 +1,133 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type msgFee struct {
+	gasSurcharge uint64
+	flatFee      sdk.Coins
+}
+
+// mockMsgFeeKeeper returns the fees of the Msg type URLs from a map.
+type mockMsgFeeKeeper map[string]msgFee
+
+func (mfk mockMsgFeeKeeper) MsgFeeCharges(_ sdk.Context, msgTypeURL string) (uint64, sdk.Coins) {
+	fee := mfk[msgTypeURL]
+	return fee.gasSurcharge, fee.flatFee
+}
+
+func TestMsgFeeDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+
+	testMsgTypeURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	execMsgTypeURL := sdk.MsgTypeURL(&authz.MsgExec{})
+	testMsg := testdata.NewTestMsg(addr)
+	execMsg := authz.NewMsgExec(addr, []sdk.Msg{testMsg, testMsg})
+
+	testCases := []struct {
+		name        string
+		mfk         ante.MsgFeeKeeper
+		msgs        []sdk.Msg
+		bankErr     error
+		expGas      uint64
+		expFlatFees sdk.Coins
+		expErr      error
+	}{
+		{
+			name: "no msg fee keeper",
+			msgs: []sdk.Msg{testMsg},
+		},
+		{
+			name: "no fees",
+			mfk:  mockMsgFeeKeeper{},
+			msgs: []sdk.Msg{testMsg},
+		},
+		{
+			name:   "gas surcharge per msg",
+			mfk:    mockMsgFeeKeeper{testMsgTypeURL: {gasSurcharge: 1000}},
+			msgs:   []sdk.Msg{testMsg, testMsg},
+			expGas: 2000,
+		},
+		{
+			name:        "flat fee per msg",
+			mfk:         mockMsgFeeKeeper{testMsgTypeURL: {flatFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}},
+			msgs:        []sdk.Msg{testMsg, testMsg},
+			expFlatFees: sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+		},
+		{
+			name: "fees of the nested msgs",
+			mfk: mockMsgFeeKeeper{
+				testMsgTypeURL: {gasSurcharge: 1000, flatFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))},
+				execMsgTypeURL: {gasSurcharge: 500, flatFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+			},
+			msgs:        []sdk.Msg{&execMsg},
+			expGas:      2500,
+			expFlatFees: sdk.NewCoins(sdk.NewInt64Coin("atom", 20), sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			name:        "insufficient funds for the flat fees",
+			mfk:         mockMsgFeeKeeper{testMsgTypeURL: {flatFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))}},
+			msgs:        []sdk.Msg{testMsg},
+			bankErr:     errors.New("insufficient funds"),
+			expFlatFees: sdk.NewCoins(sdk.NewInt64Coin("atom", 10)),
+			expErr:      sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			tx := suite.txBuilder.GetTx()
+
+			if !tc.expFlatFees.IsZero() {
+				suite.bankKeeper.EXPECT().
+					SendCoinsFromAccountToModule(gomock.Any(), addr, types.FeeCollectorName, tc.expFlatFees).
+					Return(tc.bankErr).Times(1)
+			}
+
+			ctx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+			antehandler := sdk.ChainAnteDecorators(ante.NewMsgFeeDecorator(suite.accountKeeper, suite.bankKeeper, tc.mfk))
+			newCtx, err := antehandler(ctx, tx, false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// the fee payer account is read to deduct the flat fees
+			expGas := tc.expGas
+			if !tc.expFlatFees.IsZero() {
+				readCtx := suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+				suite.accountKeeper.GetAccount(readCtx, addr)
+				expGas += readCtx.GasMeter().GasConsumed()
+			}
+			require.Equal(t, expGas, newCtx.GasMeter().GasConsumed())
+
+			if tc.expFlatFees.IsZero() {
+				require.Empty(t, newCtx.EventManager().Events())
+				return
+			}
+			require.Equal(t, sdk.Events{sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(sdk.AttributeKeyMsgFee, tc.expFlatFees.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, addr.String()),
+			)}, newCtx.EventManager().Events())
+		})
+	}
+}
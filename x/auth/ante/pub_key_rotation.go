@@ -0,0 +1,56 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// PubKeyRotationDecorator defines an AnteHandler decorator that rejects the txs
+// holding a MsgRotatePubKey nested in another msg, e.g. in an authz MsgExec or
+// in a proposal. The signer of such a MsgRotatePubKey is the account executing
+// the outer msg, so that a grantee could rotate the public key of the granter
+// and take control of its account. A MsgRotatePubKey is thus only executed as
+// a msg of a tx, signed by the account whose public key is rotated.
+type PubKeyRotationDecorator struct{}
+
+// NewPubKeyRotationDecorator returns a PubKeyRotationDecorator.
+func NewPubKeyRotationDecorator() PubKeyRotationDecorator {
+	return PubKeyRotationDecorator{}
+}
+
+func (PubKeyRotationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		nestedMsgs, err := getNestedMsgs(msg)
+		if err != nil {
+			return ctx, err
+		}
+
+		if err := validateNestedPubKeyRotations(nestedMsgs); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func validateNestedPubKeyRotations(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.MsgRotatePubKey); ok {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "%s must be signed by the account whose public key is rotated and cannot be nested in another msg", sdk.MsgTypeURL(msg),
+			)
+		}
+
+		nestedMsgs, err := getNestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+
+		if err := validateNestedPubKeyRotations(nestedMsgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestPubKeyRotationDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(2)
+	granter := accs[0].acc.GetAddress()
+	grantee := accs[1].acc.GetAddress()
+
+	rotateMsg, err := types.NewMsgRotatePubKey(granter, secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	otherMsg := testdata.NewTestMsg(grantee)
+	execMsg := authz.NewMsgExec(grantee, []sdk.Msg{otherMsg, rotateMsg})
+	nestedExecMsg := authz.NewMsgExec(grantee, []sdk.Msg{&execMsg})
+	proposalMsg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{rotateMsg}, nil, grantee.String(), "", false)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{
+			name: "rotation signed by the account",
+			msgs: []sdk.Msg{otherMsg, rotateMsg},
+		},
+		{
+			name:   "rotation nested in an authz exec",
+			msgs:   []sdk.Msg{&execMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "rotation nested in nested authz execs",
+			msgs:   []sdk.Msg{&nestedExecMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "rotation of a proposal",
+			msgs:   []sdk.Msg{proposalMsg},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(tc.msgs...))
+
+			antehandler := sdk.ChainAnteDecorators(ante.NewPubKeyRotationDecorator())
+			_, err := antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
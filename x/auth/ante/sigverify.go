@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -74,18 +75,23 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
-
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		_, isSmartAcc := acc.(SmartAccount)
+
+		// Only make check if simulate=false. The public keys of the smart
+		// accounts are not derived from their address, and neither are the
+		// public keys rotated with MsgRotatePubKey.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && !isSmartAcc && !isAccountPubKey(acc, pk) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
+
+		// account already has pubkey set,no need to reset, and smart accounts
+		// are authenticated without pubkey
+		if isSmartAcc || acc.GetPubKey() != nil {
 			continue
 		}
 		err = acc.SetPubKey(pk)
@@ -168,6 +174,12 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, err
 		}
 
+		// the gas of the authentication of smart accounts is consumed by the
+		// SigVerificationDecorator
+		if _, ok := signerAcc.(SmartAccount); ok {
+			continue
+		}
+
 		pubKey := signerAcc.GetPubKey()
 
 		// In simulate mode the transaction comes with no signatures, thus if the
@@ -232,6 +244,25 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	}
 }
 
+// hasLegacyAminoSigner checks SignatureData to see if any signer is using
+// SIGN_MODE_LEGACY_AMINO_JSON, or SIGN_MODE_EIP_191 which signs over the
+// legacy amino JSON sign bytes.
+func hasLegacyAminoSigner(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON || v.SignMode == signing.SignMode_SIGN_MODE_EIP_191
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if hasLegacyAminoSigner(s) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
 func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
@@ -252,6 +283,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	unordered := isUnordered(tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -260,15 +293,32 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		smartAcc, isSmartAcc := acc.(SmartAccount)
+		if isSmartAcc {
+			pubKey = nil
+		} else if !simulate && pubKey == nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number. Unordered txs are signed with a zero
+		// sequence, and their replay protection is provided by the
+		// UnorderedTxDecorator instead.
+		accSeq := acc.GetSequence()
+		if unordered {
+			accSeq = 0
+
+			// the legacy amino JSON sign bytes do not cover the unordered field
+			if hasLegacyAminoSigner(sig.Data) {
+				return ctx, sdkerrors.Wrap(
+					sdkerrors.ErrNotSupported, "unordered txs cannot be signed in legacy amino JSON or EIP-191 sign mode",
+				)
+			}
+		}
+
+		if sig.Sequence != accSeq {
 			return ctx, sdkerrors.Wrapf(
 				sdkerrors.ErrWrongSequence,
-				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+				"account sequence mismatch, expected %d, got %d", accSeq, sig.Sequence,
 			)
 		}
 
@@ -283,19 +333,42 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			Address:       acc.GetAddress().String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      accSeq,
 			PubKey:        pubKey,
 		}
 
+		// smart accounts are authenticated instead of verifying their
+		// signature, and are saved as they may be updated
+		if isSmartAcc {
+			if ctx.IsReCheckTx() {
+				continue
+			}
+
+			err := smartAcc.Authenticate(ctx, AuthenticationRequest{
+				Tx:              tx,
+				Signature:       sig,
+				SignerData:      signerData,
+				SignModeHandler: svd.signModeHandler,
+				Params:          svd.ak.GetParams(ctx),
+				Simulate:        simulate,
+			})
+			if err != nil {
+				return ctx, sdkerrors.Wrapf(err, "authentication of smart account %s failed", acc.GetAddress())
+			}
+
+			svd.ak.SetAccount(ctx, smartAcc)
+			continue
+		}
+
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
+			err := authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, svd.signModeHandler, tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, accSeq, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
 				}
@@ -333,6 +406,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered txs leave the sequences of their signers untouched
+	if isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(ctx, addr)
@@ -400,6 +478,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *ethsecp256k1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
@@ -457,6 +539,13 @@ func GetSignerAcc(ctx sdk.Context, ak AccountKeeper, addr sdk.AccAddress) (types
 	return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
 }
 
+// isAccountPubKey returns true if the given public key is the public key set on
+// the account.
+func isAccountPubKey(acc types.AccountI, pk cryptotypes.PubKey) bool {
+	accPubKey := acc.GetPubKey()
+	return accPubKey != nil && accPubKey.Equals(pk)
+}
+
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub cryptotypes.PubKey) int {
 	v, ok := pub.(*kmultisig.LegacyAminoPubKey)
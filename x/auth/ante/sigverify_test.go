@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -14,9 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 )
@@ -64,6 +68,46 @@ func TestSetPubKey(t *testing.T) {
 	}
 }
 
+func TestSetPubKey_RotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// the public key of the account was rotated, so that it does not match its
+	// address anymore
+	oldPriv, _, addr := testdata.KeyTestPubAddr()
+	newPriv, newPub, _ := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(newPub))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler()),
+	)
+
+	// the txs signed with the new public key are accepted
+	tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{newPriv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.NoError(t, err)
+
+	// the txs signed with the old public key are rejected
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{oldPriv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the txs signed with other public keys are rejected
+	tx, err = suite.CreateTestTx([]cryptotypes.PrivKey{secp256k1.GenPrivKey()}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	require.NoError(t, err)
+	_, err = antehandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+}
+
 func TestConsumeSignatureVerificationGas(t *testing.T) {
 	suite := SetupTestSuite(t, true)
 	params := types.DefaultParams()
@@ -98,6 +142,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyEthSecp256k1", args{sdk.NewInfiniteGasMeter(), nil, ethsecp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -295,6 +340,72 @@ func TestSigVerification_ExplicitAmino(t *testing.T) {
 	}
 }
 
+func TestSigVerification_EIP191(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+
+	// make block height non-zero to ensure account numbers part of signBytes
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv := ethsecp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		signMode  signing.SignMode
+		accNum    uint64
+		unordered bool
+		shouldErr bool
+	}{
+		{"valid tx", signing.SignMode_SIGN_MODE_EIP_191, 1, false, false},
+		{"wrong accnum", signing.SignMode_SIGN_MODE_EIP_191, 2, false, true},
+		{"unordered tx", signing.SignMode_SIGN_MODE_EIP_191, 1, true, true},
+		{"valid tx in direct mode", signing.SignMode_SIGN_MODE_DIRECT, 1, false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			if tc.unordered {
+				suite.txBuilder.SetUnordered(true)
+				suite.txBuilder.SetTimeoutHeight(10)
+			}
+
+			sigV2 := signing.SignatureV2{
+				PubKey:   priv.PubKey(),
+				Data:     &signing.SingleSignatureData{SignMode: tc.signMode},
+				Sequence: 0,
+			}
+			require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+
+			signerData := xauthsigning.SignerData{
+				Address:       addr.String(),
+				ChainID:       suite.ctx.ChainID(),
+				AccountNumber: tc.accNum,
+				Sequence:      0,
+			}
+			sigV2, err := clienttx.SignWithPrivKey(tc.signMode, signerData, suite.txBuilder, priv, suite.clientCtx.TxConfig, 0)
+			require.NoError(t, err)
+			require.NoError(t, suite.txBuilder.SetSignatures(sigV2))
+
+			_, err = antehandler(suite.ctx, suite.txBuilder.GetTx(), false)
+			if tc.shouldErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
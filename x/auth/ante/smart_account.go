@@ -0,0 +1,74 @@
+package ante
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// SmartAccount is implemented by the accounts whose authentication is
+// delegated to custom logic, such as the authenticators of the
+// x/auth/smartaccount module, instead of the verification of the signatures of
+// their public key.
+//
+// The signature decorators neither set nor use the public key of a smart
+// account: the SigVerificationDecorator calls its Authenticate method instead
+// of verifying its signature, and saves the account afterwards.
+type SmartAccount interface {
+	types.AccountI
+
+	// Authenticate authenticates the signature of the account over a tx. It
+	// may update the account, for instance to track the coins it spent. It is
+	// not called on ReCheckTx.
+	Authenticate(ctx sdk.Context, req AuthenticationRequest) error
+}
+
+// AuthenticationRequest is the request of the authentication of the signature
+// of a SmartAccount over a tx.
+type AuthenticationRequest struct {
+	// Tx is the authenticated tx.
+	Tx sdk.Tx
+	// Signature is the signature of the account, without public key. It is
+	// empty when simulating.
+	Signature signing.SignatureV2
+	// SignerData is the signer data of the account, without public key.
+	SignerData authsigning.SignerData
+	// SignModeHandler computes the sign bytes of the tx.
+	SignModeHandler authsigning.SignModeHandler
+	// Params are the params of the x/auth module.
+	Params types.Params
+	// Simulate is true when the tx is simulated.
+	Simulate bool
+}
+
+// VerifySignature consumes the gas of the verification of the signature of the
+// request with the given public key, and verifies it unless the tx is
+// simulated.
+func (req AuthenticationRequest) VerifySignature(ctx sdk.Context, pubKey cryptotypes.PubKey) error {
+	// the signatures of the simulated txs are empty, so the gas of a
+	// secp256k1 signature is consumed for each key
+	if req.Simulate {
+		ctx.GasMeter().ConsumeGas(req.Params.SigVerifyCostSecp256k1*uint64(CountSubKeys(pubKey)), "ante verify: smart account")
+		return nil
+	}
+
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     req.Signature.Data,
+		Sequence: req.Signature.Sequence,
+	}
+	if err := DefaultSigVerificationGasConsumer(ctx.GasMeter(), sig, req.Params); err != nil {
+		return err
+	}
+
+	signerData := req.SignerData
+	signerData.PubKey = pubKey
+	if err := authsigning.VerifySignature(sdk.WrapSDKContext(ctx), pubKey, signerData, sig.Data, req.SignModeHandler, req.Tx); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+
+	return nil
+}
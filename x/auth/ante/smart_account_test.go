@@ -0,0 +1,78 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	smartaccounttypes "github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSmartAccountAuthentication(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	smartaccounttypes.RegisterInterfaces(suite.encCfg.InterfaceRegistry)
+
+	// the smart account is authenticated by keys unrelated to its address
+	priv, recoveryPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	authenticator, err := smartaccounttypes.NewRecoveryAuthenticator(priv.PubKey(), recoveryPriv.PubKey(), time.Hour)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress("smart_account_______")
+	baseAccount := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr).(*types.BaseAccount)
+	smartAccount, err := smartaccounttypes.NewSmartAccount(baseAccount, authenticator)
+	require.NoError(t, err)
+	suite.accountKeeper.SetAccount(suite.ctx, smartAccount)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	runTx := func(ctx sdk.Context, priv cryptotypes.PrivKey, seq uint64, simulate bool) (sdk.Context, error) {
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{smartAccount.AccountNumber}, []uint64{seq}, ctx.ChainID())
+		require.NoError(t, err)
+		return suite.anteHandler(ctx, tx, simulate)
+	}
+
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	// the signatures of unknown keys are rejected
+	_, err = runTx(ctx, secp256k1.GenPrivKey(), 0, false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the simulated txs are authenticated without signature
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = runTx(cacheCtx, priv, 0, true)
+	require.NoError(t, err)
+
+	// the authenticator is invoked instead of the signature verification of
+	// the public key, and the updated account is saved
+	ctx, err = runTx(ctx, priv, 0, false)
+	require.NoError(t, err)
+
+	acc, ok := suite.accountKeeper.GetAccount(ctx, addr).(*smartaccounttypes.SmartAccount)
+	require.True(t, ok)
+	require.Nil(t, acc.GetPubKey())
+	require.Equal(t, uint64(1), acc.GetSequence())
+	savedAuthenticator, err := acc.GetAuthenticator()
+	require.NoError(t, err)
+	require.Equal(t, blockTime, savedAuthenticator.(*smartaccounttypes.RecoveryAuthenticator).LastActivityTime)
+
+	// the sequence of the smart accounts is checked
+	_, err = runTx(ctx, priv, 0, false)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// the authentication is skipped on ReCheckTx
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = runTx(cacheCtx.WithIsReCheckTx(true), secp256k1.GenPrivKey(), 1, false)
+	require.NoError(t, err)
+}
@@ -80,11 +80,12 @@ func SetupTestSuite(t *testing.T, isCheckTx bool) *AnteTestSuite {
 
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   suite.accountKeeper,
-			BankKeeper:      suite.bankKeeper,
-			FeegrantKeeper:  suite.feeGrantKeeper,
-			SignModeHandler: suite.encCfg.TxConfig.SignModeHandler(),
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     suite.accountKeeper,
+			BankKeeper:        suite.bankKeeper,
+			FeegrantKeeper:    suite.feeGrantKeeper,
+			SignModeHandler:   suite.encCfg.TxConfig.SignModeHandler(),
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: suite.accountKeeper,
 		},
 	)
 
@@ -0,0 +1,73 @@
+package ante
+
+import (
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxUnorderedTTL defines the default maximum number of blocks an
+// unordered tx can be valid for, i.e. the maximum distance between its timeout
+// height and the current block height.
+const DefaultMaxUnorderedTTL = 1024
+
+// UnorderedTxDecorator defines an AnteHandler decorator that provides replay
+// protection to unordered txs, which are not checked against the sequences of
+// their signers. An unordered tx must set a timeout height at most maxTTL
+// blocks ahead of the current block, and its hash is recorded until that
+// height so that it cannot be included twice.
+//
+// CONTRACT: the TxTimeoutHeightDecorator must run before this decorator, so
+// that expired unordered txs are rejected.
+type UnorderedTxDecorator struct {
+	maxTTL uint64
+	utk    UnorderedTxKeeper
+}
+
+// NewUnorderedTxDecorator returns an UnorderedTxDecorator. Unordered txs are
+// rejected when utk is nil.
+func NewUnorderedTxDecorator(maxTTL uint64, utk UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTTL: maxTTL,
+		utk:    utk,
+	}
+}
+
+func (d UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !isUnordered(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	if d.utk == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrNotSupported, "unordered txs are not enabled")
+	}
+
+	timeout := tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeout == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	if maxTimeout := uint64(ctx.BlockHeight()) + d.maxTTL; timeout > maxTimeout {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout height %d exceeds the maximum of %d", timeout, maxTimeout,
+		)
+	}
+
+	txHash := sha256.Sum256(ctx.TxBytes())
+	if d.utk.ContainsUnorderedTx(ctx, txHash[:], timeout) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X has already been seen", txHash)
+	}
+
+	if !simulate {
+		d.utk.AddUnorderedTx(ctx, txHash[:], timeout)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// isUnordered returns true if the tx is an unordered tx.
+func isUnordered(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
@@ -0,0 +1,91 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func TestUnorderedTx(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	accs := suite.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+	msg := testdata.NewTestMsg(addr)
+	require.NoError(t, suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetUnordered(true)
+
+	runTx := func(ctx sdk.Context, timeout uint64, seq uint64) (sdk.Context, error) {
+		suite.txBuilder.SetTimeoutHeight(timeout)
+		tx, err := suite.CreateTestTx(
+			[]cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{seq}, ctx.ChainID(),
+		)
+		require.NoError(t, err)
+
+		bz, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		return suite.anteHandler(ctx.WithTxBytes(bz), tx, false)
+	}
+
+	// the timeout height is mandatory
+	_, err := runTx(suite.ctx, 0, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the timeout height is bounded
+	_, err = runTx(suite.ctx, uint64(suite.ctx.BlockHeight())+ante.DefaultMaxUnorderedTTL+1, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the signatures must be made with a zero sequence
+	_, err = runTx(suite.ctx, 10, 1)
+	require.ErrorIs(t, err, sdkerrors.ErrWrongSequence)
+
+	// a valid unordered tx leaves the signer sequence untouched
+	ctx, err := runTx(suite.ctx, 10, 0)
+	require.NoError(t, err)
+	seq, err := suite.accountKeeper.GetSequence(ctx, addr)
+	require.NoError(t, err)
+	require.Zero(t, seq)
+
+	// the same tx cannot be replayed
+	_, err = runTx(ctx, 10, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the tx hash is kept until the timeout height, after which the tx expires
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(9))
+	_, err = runTx(ctx.WithBlockHeight(9), 10, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	suite.accountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockHeight(10))
+	_, err = runTx(ctx.WithBlockHeight(11), 10, 0)
+	require.ErrorIs(t, err, sdkerrors.ErrTxTimeoutHeight)
+}
+
+func TestUnorderedTxDisabled(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	accs := suite.CreateTestAccounts(1)
+
+	require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.SetUnordered(true)
+	tx := suite.txBuilder.GetTx()
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(ante.DefaultMaxUnorderedTTL, nil))
+	_, err := anteHandler(suite.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	// ordered txs are not affected
+	suite.txBuilder.SetUnordered(false)
+	_, err = anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+package auth_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestRotatedPubKeyCheckTx(t *testing.T) {
+	privA, privB := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addrA := sdk.AccAddress(privA.PubKey().Address())
+	addrB := sdk.AccAddress(privB.PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+
+	// the account B already signed a tx, so that its next tx has the same
+	// sequence as the first tx of A after its rotation
+	accB := types.NewBaseAccount(addrB, privB.PubKey(), 0, 1)
+
+	mp := mempool.NewPriorityMempool()
+	startupCfg := sims.DefaultStartUpConfig()
+	startupCfg.BaseAppOption = baseapp.SetMempool(mp)
+	startupCfg.GenesisAccounts = []sims.GenesisAccount{
+		{GenesisAccount: &types.BaseAccount{Address: addrA.String()}, Coins: coins},
+		{GenesisAccount: accB, Coins: coins},
+	}
+
+	var accountKeeper keeper.AccountKeeper
+	app, err := sims.SetupWithConfiguration(testutil.AppConfig, startupCfg, &accountKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	accNumA := accountKeeper.GetAccount(ctx, addrA).GetAccountNumber()
+	accNumB := accountKeeper.GetAccount(ctx, addrB).GetAccountNumber()
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+
+	// A rotates its public key to the one of B, whose address is not the
+	// address of A anymore
+	rotateMsg, err := types.NewMsgRotatePubKey(addrA, privB.PubKey())
+	require.NoError(t, err)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = sims.SignCheckDeliver(t, txConfig, app.BaseApp, header, []sdk.Msg{rotateMsg}, "", []uint64{accNumA}, []uint64{0}, true, true, privA)
+	require.NoError(t, err)
+	require.Zero(t, mp.CountTx())
+
+	checkTx := func(from sdk.AccAddress, accNum uint64, priv cryptotypes.PrivKey) abci.ResponseCheckTx {
+		msg := banktypes.NewMsgSend(from, addrA, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		tx, err := sims.GenSignedMockTx(
+			rand.New(rand.NewSource(1)), txConfig, []sdk.Msg{msg}, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)),
+			sims.DefaultGenTxGas, "", []uint64{accNum}, []uint64{1}, priv,
+		)
+		require.NoError(t, err)
+		txBytes, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		return app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+	}
+
+	// the txs of A are signed with its new public key, and are kept in the
+	// mempool apart from the txs of B, which are signed with the same public
+	// key and have the same sequence
+	res := checkTx(addrA, accNumA, privB)
+	require.True(t, res.IsOK(), res.Log)
+	res = checkTx(addrB, accNumB, privB)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the txs of A signed with its old public key are rejected
+	res = checkTx(addrA, accNumA, privA)
+	require.False(t, res.IsOK())
+	require.Equal(t, 2, mp.CountTx())
+}
@@ -46,6 +46,7 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountsCmd(),
 		QueryParamsCmd(),
 		QueryModuleAccountsCmd(),
+		GetPubKeyRotationsCmd(),
 	)
 
 	return cmd
@@ -191,6 +192,44 @@ func GetAccountsCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPubKeyRotationsCmd returns a query of the rotations of the public key of
+// the account at a given address.
+func GetPubKeyRotationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pub-key-rotations [address]",
+		Short: "Query the rotations of the public key of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PubKeyRotations(cmd.Context(), &types.QueryPubKeyRotationsRequest{Address: addr.String(), Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pub-key-rotations")
+
+	return cmd
+}
+
 // QueryAllModuleAccountsCmd returns a list of all the existing module accounts with their account information and permissions
 func QueryModuleAccountsCmd() *cobra.Command {
 	cmd := &cobra.Command{
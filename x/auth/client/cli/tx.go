@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for the auth module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRotatePubKeyCmd(),
+	)
+
+	return txCmd
+}
+
+// NewRotatePubKeyCmd returns a CLI command handler for creating a
+// MsgRotatePubKey transaction.
+func NewRotatePubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-pub-key [new-pub-key]",
+		Short: "Replace the public key of an account",
+		Long: fmt.Sprintf(`Replace the public key of the account of the sender with the given public
+key, in JSON. The address of the account does not change, and the tx must be
+signed with its current public key. The following txs must be signed with the
+new public key.
+
+Example:
+$ %s tx auth rotate-pub-key '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A..."}' --from mykey
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pubKey cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pubKey); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotatePubKey(clientCtx.GetFromAddress(), pubKey)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -25,6 +25,12 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, rotation := range data.PubKeyRotations {
+		if err := ak.AddPubKeyRotation(ctx, rotation); err != nil {
+			panic(err)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -39,5 +45,11 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	ak.IteratePubKeyRotations(ctx, func(rotation types.PubKeyRotation) bool {
+		genState.PubKeyRotations = append(genState.PubKeyRotations, rotation)
+		return false
+	})
+
+	return genState
 }
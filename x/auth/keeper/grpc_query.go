@@ -182,3 +182,29 @@ func (ak AccountKeeper) AddressStringToBytes(ctx context.Context, req *types.Add
 
 	return &types.AddressStringToBytesResponse{AddressBytes: bz}, nil
 }
+
+// PubKeyRotations returns the rotations of the public key of an account
+func (ak AccountKeeper) PubKeyRotations(c context.Context, req *types.QueryPubKeyRotationsRequest) (*types.QueryPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(ak.storeKey), types.PubKeyRotationsKey(addr))
+
+	var rotations []types.PubKeyRotation
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		rotations = append(rotations, ak.decodePubKeyRotation(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryPubKeyRotationsResponse{Rotations: rotations, Pagination: pageRes}, nil
+}
@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -443,3 +447,39 @@ func (suite *KeeperTestSuite) TestAddressStringToBytes() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryPubKeyRotations() {
+	pubKeys := []cryptotypes.PubKey{
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	}
+	addr := sdk.AccAddress(pubKeys[0].Address())
+	for i := 1; i < len(pubKeys); i++ {
+		rotation, err := types.NewPubKeyRotation(addr, pubKeys[i-1], pubKeys[i], int64(i), time.Unix(int64(i), 0).UTC())
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.accountKeeper.AddPubKeyRotation(suite.ctx, rotation))
+	}
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{Address: sdk.AccAddress("no_rotations________").String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Rotations)
+
+	res, err = suite.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Rotations, 2)
+	for i, rotation := range res.Rotations {
+		suite.Require().Equal(int64(i+1), rotation.Height)
+	}
+
+	res, err = suite.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{
+		Address:    addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Rotations, 1)
+	suite.Require().Equal(int64(2), res.Rotations[0].Height)
+}
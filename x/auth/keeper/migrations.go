@@ -8,6 +8,7 @@ import (
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
 	v4 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v5"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -59,6 +60,13 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
 
+// Migrate4to5 migrates the x/auth module state from the consensus version 4 to
+// version 5. Specifically, it sets the new pub key rotation gas cost parameter
+// to its default value.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
@@ -34,3 +34,61 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) RotatePubKey(goCtx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	newPubKey, err := msg.GetNewPubKeyI()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := ms.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, errors.Wrapf(errors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	// the public key of the account is set by the ante handler when the
+	// account signs its first tx, so that it is only missing if the account
+	// never signed a tx. The ante handler rejects the msgs nested in another
+	// msg, e.g. in an authz MsgExec, so that the msg is always signed by the
+	// account itself.
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return nil, errors.Wrapf(errors.ErrInvalidPubKey, "account %s has no public key to rotate", msg.Address)
+	}
+	if oldPubKey.Equals(newPubKey) {
+		return nil, errors.Wrap(errors.ErrInvalidPubKey, "new public key is the current public key of the account")
+	}
+
+	ctx.GasMeter().ConsumeGas(ms.GetParams(ctx).PubKeyRotationGasCost, "pub key rotation")
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return nil, errors.Wrap(errors.ErrInvalidPubKey, err.Error())
+	}
+	ms.SetAccount(ctx, acc)
+
+	rotation, err := types.NewPubKeyRotation(addr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.AddPubKeyRotation(ctx, rotation); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, newPubKey.String()),
+		),
+	)
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
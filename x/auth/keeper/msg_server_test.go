@@ -1,6 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -109,3 +117,86 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockHeader(tmproto.Header{Height: 7, Time: blockTime})
+	params := types.DefaultParams()
+	s.Require().NoError(s.accountKeeper.SetParams(ctx, params))
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	acc := s.accountKeeper.NewAccountWithAddress(ctx, addr)
+	s.Require().NoError(acc.SetPubKey(oldPubKey))
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	noPubKeyAddr := sdk.AccAddress("no_pub_key__________")
+	s.accountKeeper.SetAccount(ctx, s.accountKeeper.NewAccountWithAddress(ctx, noPubKeyAddr))
+
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+
+	testCases := []struct {
+		name   string
+		addr   sdk.AccAddress
+		pubKey cryptotypes.PubKey
+		expErr error
+	}{
+		{"unknown account", sdk.AccAddress("unknown_____________"), newPubKey, sdkerrors.ErrUnknownAddress},
+		{"account without public key", noPubKeyAddr, newPubKey, sdkerrors.ErrInvalidPubKey},
+		{"same public key", addr, oldPubKey, sdkerrors.ErrInvalidPubKey},
+		{"rotation", addr, newPubKey, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			msg, err := types.NewMsgRotatePubKey(tc.addr, tc.pubKey)
+			s.Require().NoError(err)
+			s.Require().NoError(msg.ValidateBasic())
+
+			ctx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			_, err = s.msgServer.RotatePubKey(ctx, msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), params.PubKeyRotationGasCost)
+
+			// the address of the account is unchanged
+			acc := s.accountKeeper.GetAccount(ctx, addr)
+			s.Require().Equal(addr, acc.GetAddress())
+			s.Require().True(newPubKey.Equals(acc.GetPubKey()))
+
+			expRotation, err := types.NewPubKeyRotation(addr, oldPubKey, newPubKey, 7, blockTime)
+			s.Require().NoError(err)
+			rotations := s.accountKeeper.GetPubKeyRotations(ctx, addr)
+			s.Require().Len(rotations, 1)
+			s.Require().Equal(expRotation.OldPubKey.Value, rotations[0].OldPubKey.Value)
+			s.Require().Equal(expRotation.NewPubKey.Value, rotations[0].NewPubKey.Value)
+			s.Require().Equal(expRotation.Height, rotations[0].Height)
+			s.Require().Equal(expRotation.Time, rotations[0].Time)
+		})
+	}
+
+	// the rotations are appended to the history of the account
+	thirdPubKey := secp256k1.GenPrivKey().PubKey()
+	msg, err := types.NewMsgRotatePubKey(addr, thirdPubKey)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotatePubKey(ctx, msg)
+	s.Require().NoError(err)
+
+	rotations := s.accountKeeper.GetPubKeyRotations(ctx, addr)
+	s.Require().Len(rotations, 2)
+	s.Require().True(newPubKey.Equals(rotations[1].OldPubKey.GetCachedValue().(cryptotypes.PubKey)))
+	s.Require().True(thirdPubKey.Equals(rotations[1].NewPubKey.GetCachedValue().(cryptotypes.PubKey)))
+
+	// the rotations are exported and imported with the genesis state
+	genState := s.accountKeeper.ExportGenesis(ctx)
+	s.Require().Len(genState.PubKeyRotations, 2)
+	s.Require().NoError(types.ValidateGenesis(*genState))
+
+	s.SetupTest()
+	s.accountKeeper.InitGenesis(s.ctx, *genState)
+	s.Require().Equal(rotations, s.accountKeeper.GetPubKeyRotations(s.ctx, addr))
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AddPubKeyRotation appends a rotation to the rotations of the public key of
+// its account.
+func (ak AccountKeeper) AddPubKeyRotation(ctx sdk.Context, rotation types.PubKeyRotation) error {
+	addr, err := sdk.AccAddressFromBech32(rotation.Address)
+	if err != nil {
+		return err
+	}
+
+	bz, err := ak.cdc.Marshal(&rotation)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(ak.storeKey)
+	store.Set(types.PubKeyRotationKey(addr, ak.nextPubKeyRotationIndex(ctx, addr)), bz)
+	return nil
+}
+
+// GetPubKeyRotations returns the rotations of the public key of the account
+// with the given address, from the oldest to the newest.
+func (ak AccountKeeper) GetPubKeyRotations(ctx sdk.Context, addr sdk.AccAddress) (rotations []types.PubKeyRotation) {
+	store := prefix.NewStore(ctx.KVStore(ak.storeKey), types.PubKeyRotationsKey(addr))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		rotations = append(rotations, ak.decodePubKeyRotation(iterator.Value()))
+	}
+
+	return rotations
+}
+
+// IteratePubKeyRotations iterates over the rotations of the public keys of all
+// the accounts, ordered by account address and then from the oldest to the
+// newest, and calls the callback on each of them. The iteration stops when the
+// callback returns true.
+func (ak AccountKeeper) IteratePubKeyRotations(ctx sdk.Context, cb func(rotation types.PubKeyRotation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(ak.storeKey), types.PubKeyRotationsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(ak.decodePubKeyRotation(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// nextPubKeyRotationIndex returns the index of the next rotation of the public
+// key of the account with the given address.
+func (ak AccountKeeper) nextPubKeyRotationIndex(ctx sdk.Context, addr sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(ak.storeKey), types.PubKeyRotationsKey(addr))
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(iterator.Key()) + 1
+}
+
+func (ak AccountKeeper) decodePubKeyRotation(bz []byte) types.PubKeyRotation {
+	var rotation types.PubKeyRotation
+	ak.cdc.MustUnmarshal(bz, &rotation)
+	return rotation
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx with the given hash and
+// timeout height has already been seen.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64) bool {
+	store := ctx.KVStore(ak.storeKey)
+	return store.Has(types.UnorderedTxKey(txHash, timeout))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height,
+// so that it cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64) {
+	store := ctx.KVStore(ak.storeKey)
+	store.Set(types.UnorderedTxKey(txHash, timeout), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs whose
+// timeout height has been reached. Such txs are rejected by the tx timeout
+// height check from the next block on, so their hashes are no longer needed.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.storeKey)
+	end := types.UnorderedTxsByTimeoutKey(uint64(ctx.BlockHeight()) + 1)
+	iterator := store.Iterator(types.UnorderedTxsKeyPrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -71,6 +71,13 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+// SetUnordered panics for stdtx, which does not support unordered txs.
+func (s *StdTxBuilder) SetUnordered(unordered bool) {
+	if unordered {
+		panic("StdTxBuilder does not support unordered txs")
+	}
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
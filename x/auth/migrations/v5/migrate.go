@@ -0,0 +1,32 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	ModuleName = "auth"
+)
+
+// ParamsKey is the key the x/auth params are stored under.
+var ParamsKey = []byte{0x00}
+
+// Migrate migrates the x/auth module state from the consensus version 4 to
+// version 5. Specifically, it sets the new pub key rotation gas cost parameter,
+// which reads as zero from the parameters stored by the previous versions, to
+// its default value.
+func Migrate(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec) error {
+	var currParams types.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &currParams); err != nil {
+		return err
+	}
+
+	currParams.PubKeyRotationGasCost = types.DefaultPubKeyRotationGasCost
+
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	v5 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v5.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored by the previous versions, without the pub key rotation gas cost
+	oldParams := types.DefaultParams()
+	oldParams.MaxMemoCharacters = 512
+	oldParams.PubKeyRotationGasCost = 0
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	bz := store.Get(v5.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, uint64(512), res.MaxMemoCharacters)
+	require.Equal(t, types.DefaultPubKeyRotationGasCost, res.PubKeyRotationGasCost)
+	require.NoError(t, res.Validate())
+}
@@ -0,0 +1,12 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the contract needed for supporting the post handlers.
+type BankKeeper interface {
+	types.BankKeeper
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
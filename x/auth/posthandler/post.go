@@ -2,14 +2,35 @@ package posthandler
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	// BankKeeper is required when FeeRefundRatio is set.
+	BankKeeper BankKeeper
+	// FeeRefundRatio is the ratio, in [0, 1], of the fee corresponding to the
+	// unused gas of a tx which is refunded by the RefundFeeDecorator. No fee is
+	// refunded when it is nil or zero.
+	FeeRefundRatio sdk.Dec
+}
 
-// NewPostHandler returns an empty posthandler chain.
+// NewPostHandler returns the default posthandler chain, which refunds the fee
+// corresponding to the unused gas if FeeRefundRatio is set.
 func NewPostHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	postDecorators := []sdk.AnteDecorator{}
 
+	if !options.FeeRefundRatio.IsNil() && !options.FeeRefundRatio.IsZero() {
+		if options.FeeRefundRatio.IsNegative() || options.FeeRefundRatio.GT(sdk.OneDec()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "fee refund ratio must be in [0, 1], got %s", options.FeeRefundRatio)
+		}
+
+		if options.BankKeeper == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for the fee refund")
+		}
+
+		postDecorators = append(postDecorators, NewRefundFeeDecorator(options.BankKeeper, options.FeeRefundRatio))
+	}
+
 	return sdk.ChainAnteDecorators(postDecorators...), nil
 }
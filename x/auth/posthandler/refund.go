@@ -0,0 +1,85 @@
+package posthandler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RefundFeeDecorator refunds a fraction of the fee corresponding to the unused
+// gas of the tx, that is its gas limit minus the gas used when the decorator
+// runs. The refund is sent from the fee collector to the account the fee was
+// deducted from by the ante.DeductFeeDecorator, which is the fee granter if the
+// fee was paid with a fee grant. In that case the fee allowance of the grantee
+// remains charged the whole fee, it is not restored by the refund.
+//
+// The refund is only made when the tx is delivered or simulated: in CheckTx,
+// the messages are not executed, and refunding the fee in the check state would
+// let the payers spend coins they will not get back.
+type RefundFeeDecorator struct {
+	bankKeeper  BankKeeper
+	refundRatio sdk.Dec
+}
+
+// NewRefundFeeDecorator returns a new RefundFeeDecorator refunding the given
+// ratio of the fee corresponding to the unused gas. The ratio must be in [0, 1].
+func NewRefundFeeDecorator(bk BankKeeper, refundRatio sdk.Dec) RefundFeeDecorator {
+	return RefundFeeDecorator{
+		bankKeeper:  bk,
+		refundRatio: refundRatio,
+	}
+}
+
+func (rfd RefundFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() && !simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	deductedFee, ok := ante.DeductedFeeFromContext(ctx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	refund := ComputeFeeRefund(deductedFee.Amount, feeTx.GetGas(), ctx.GasMeter().GasConsumed(), rfd.refundRatio)
+	if refund.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	err := rfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, deductedFee.DeductedFrom, refund)
+	if err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to refund fee %s", refund)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFeeRefund, refund.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductedFee.DeductedFrom.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
+
+// ComputeFeeRefund returns the refund of the given ratio of the fee
+// corresponding to the unused gas, gasWanted - gasUsed. The refunded amounts are
+// rounded down.
+func ComputeFeeRefund(fee sdk.Coins, gasWanted, gasUsed uint64, refundRatio sdk.Dec) sdk.Coins {
+	if fee.IsZero() || gasUsed >= gasWanted || refundRatio.IsNil() || !refundRatio.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	refund, _ := sdk.NewDecCoinsFromCoins(fee...).
+		MulDecTruncate(refundRatio).
+		MulDecTruncate(sdk.NewDecFromInt(sdk.NewIntFromUint64(gasWanted - gasUsed))).
+		QuoDecTruncate(sdk.NewDecFromInt(sdk.NewIntFromUint64(gasWanted))).
+		TruncateDecimal()
+
+	return refund
+}
@@ -0,0 +1,270 @@
+package posthandler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/module"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	_ "github.com/cosmos/cosmos-sdk/x/params"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+type refund struct {
+	module string
+	to     sdk.AccAddress
+	amount sdk.Coins
+}
+
+// mockBankKeeper records the coins sent from module accounts.
+type mockBankKeeper struct {
+	refunds []refund
+	err     error
+}
+
+func (bk *mockBankKeeper) SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+func (bk *mockBankKeeper) SendCoinsFromAccountToModule(sdk.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (bk *mockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if bk.err != nil {
+		return bk.err
+	}
+
+	bk.refunds = append(bk.refunds, refund{senderModule, recipientAddr, amt})
+	return nil
+}
+
+func TestComputeFeeRefund(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 7))
+
+	testCases := []struct {
+		name      string
+		fee       sdk.Coins
+		gasWanted uint64
+		gasUsed   uint64
+		ratio     sdk.Dec
+		expected  sdk.Coins
+	}{
+		{"half of the gas unused", fee, 100, 50, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 3))},
+		{"half of the unused gas refunded", fee, 100, 50, sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 250), sdk.NewInt64Coin("stake", 1))},
+		{"all the gas unused", fee, 100, 0, sdk.OneDec(), fee},
+		{"all the gas used", fee, 100, 100, sdk.OneDec(), sdk.NewCoins()},
+		{"more gas used than wanted", fee, 100, 150, sdk.OneDec(), sdk.NewCoins()},
+		{"refunds rounded down", fee, 3, 2, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 333), sdk.NewInt64Coin("stake", 2))},
+		{"zero ratio", fee, 100, 50, sdk.ZeroDec(), sdk.NewCoins()},
+		{"nil ratio", fee, 100, 50, sdk.Dec{}, sdk.NewCoins()},
+		{"zero fee", sdk.NewCoins(), 100, 50, sdk.OneDec(), sdk.NewCoins()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			refund := posthandler.ComputeFeeRefund(tc.fee, tc.gasWanted, tc.gasUsed, tc.ratio)
+			require.True(t, tc.expected.IsEqual(refund), "expected %s, got %s", tc.expected, refund)
+		})
+	}
+}
+
+func TestRefundFeeDecorator(t *testing.T) {
+	_, _, payer := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txBuilder := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes).NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(100)
+	tx := txBuilder.GetTx()
+
+	testCases := []struct {
+		name           string
+		deductedFee    *ante.DeductedFee
+		checkTx        bool
+		simulate       bool
+		bankErr        error
+		expectedRefund *refund
+		expectErr      bool
+	}{
+		{
+			name:           "refund to the fee payer",
+			deductedFee:    &ante.DeductedFee{Amount: fee, DeductedFrom: payer},
+			expectedRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		},
+		{
+			name:           "refund to the fee granter",
+			deductedFee:    &ante.DeductedFee{Amount: fee, DeductedFrom: granter},
+			expectedRefund: &refund{types.FeeCollectorName, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		},
+		{
+			name:           "refund of the effective fee",
+			deductedFee:    &ante.DeductedFee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), DeductedFrom: payer},
+			expectedRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 30))},
+		},
+		{
+			name:           "refund in simulation",
+			deductedFee:    &ante.DeductedFee{Amount: fee, DeductedFrom: payer},
+			checkTx:        true,
+			simulate:       true,
+			expectedRefund: &refund{types.FeeCollectorName, payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		},
+		{
+			name:        "no refund in CheckTx",
+			deductedFee: &ante.DeductedFee{Amount: fee, DeductedFrom: payer},
+			checkTx:     true,
+		},
+		{
+			name: "no refund without deducted fee",
+		},
+		{
+			name:        "refund failure",
+			deductedFee: &ante.DeductedFee{Amount: fee, DeductedFrom: payer},
+			bankErr:     errors.New("insufficient funds"),
+			expectErr:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bk := &mockBankKeeper{err: tc.bankErr}
+			postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+				BankKeeper:     bk,
+				FeeRefundRatio: sdk.NewDecWithPrec(6, 1),
+			})
+			require.NoError(t, err)
+
+			ctx := sdk.Context{}.
+				WithContext(context.Background()).
+				WithEventManager(sdk.NewEventManager()).
+				WithGasMeter(sdk.NewGasMeter(100)).
+				WithIsCheckTx(tc.checkTx)
+			ctx.GasMeter().ConsumeGas(50, "test")
+			if tc.deductedFee != nil {
+				ctx = ante.ContextWithDeductedFee(ctx, *tc.deductedFee)
+			}
+
+			newCtx, err := postHandler(ctx, tx, tc.simulate)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expectedRefund == nil {
+				require.Empty(t, bk.refunds)
+				require.Empty(t, newCtx.EventManager().Events())
+				return
+			}
+
+			require.Equal(t, []refund{*tc.expectedRefund}, bk.refunds)
+			require.Equal(t, sdk.Events{sdk.NewEvent(
+				sdk.EventTypeTx,
+				sdk.NewAttribute(sdk.AttributeKeyFeeRefund, tc.expectedRefund.amount.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, tc.expectedRefund.to.String()),
+			)}, newCtx.EventManager().Events())
+		})
+	}
+}
+
+func TestRefundFeeDecoratorWithFeeGrant(t *testing.T) {
+	var (
+		accountKeeper  authkeeper.AccountKeeper
+		bankKeeper     bankkeeper.Keeper
+		feegrantKeeper feegrantkeeper.Keeper
+	)
+	app, err := simtestutil.Setup(configurator.NewAppConfig(
+		configurator.ParamsModule(),
+		configurator.AuthModule(),
+		configurator.StakingModule(),
+		configurator.TxModule(),
+		configurator.BankModule(),
+		configurator.FeegrantModule()),
+		&accountKeeper, &bankKeeper, &feegrantKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, grantee := testdata.KeyTestPubAddr()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, granter, fee.Add(fee...)))
+	require.NoError(t, feegrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 1500)),
+	}))
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txBuilder := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes).NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(grantee)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetFeeGranter(granter)
+	txBuilder.SetGasLimit(100000)
+	tx := txBuilder.GetTx()
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewDeductFeeDecorator(accountKeeper, bankKeeper, feegrantKeeper, nil))
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{
+		BankKeeper:     bankKeeper,
+		FeeRefundRatio: sdk.NewDecWithPrec(6, 1),
+	})
+	require.NoError(t, err)
+
+	ctx, err = anteHandler(ctx.WithGasMeter(sdk.NewGasMeter(100000)), tx, false)
+	require.NoError(t, err)
+
+	// half of the gas is unused
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+	ctx.GasMeter().ConsumeGas(50000, "test")
+	_, err = postHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the refund goes to the granter, who paid the fee
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 1300)), bankKeeper.GetAllBalances(ctx, granter))
+	require.True(t, bankKeeper.GetAllBalances(ctx, grantee).IsZero())
+
+	// the allowance of the grantee is charged the whole fee, it is not restored
+	allowance, err := feegrantKeeper.GetAllowance(ctx, granter, grantee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 500)), allowance.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+func TestNewPostHandler(t *testing.T) {
+	// an empty chain without fee refund
+	postHandler, err := posthandler.NewPostHandler(posthandler.HandlerOptions{})
+	require.NoError(t, err)
+	require.Nil(t, postHandler)
+
+	postHandler, err = posthandler.NewPostHandler(posthandler.HandlerOptions{FeeRefundRatio: sdk.ZeroDec()})
+	require.NoError(t, err)
+	require.Nil(t, postHandler)
+
+	_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{FeeRefundRatio: sdk.OneDec()})
+	require.Error(t, err)
+
+	for _, ratio := range []sdk.Dec{sdk.NewDec(-1), sdk.NewDecWithPrec(11, 1)} {
+		_, err = posthandler.NewPostHandler(posthandler.HandlerOptions{BankKeeper: &mockBankKeeper{}, FeeRefundRatio: ratio})
+		require.Error(t, err)
+	}
+}
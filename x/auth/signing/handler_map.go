@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -16,7 +17,7 @@ type SignModeHandlerMap struct {
 	signModeHandlers map[signing.SignMode]SignModeHandler
 }
 
-var _ SignModeHandler = SignModeHandlerMap{}
+var _ SignModeHandlerWithContext = SignModeHandlerMap{}
 
 // NewSignModeHandlerMap returns a new SignModeHandlerMap with the provided defaultMode and handlers
 func NewSignModeHandlerMap(defaultMode signing.SignMode, handlers []SignModeHandler) SignModeHandlerMap {
@@ -58,3 +59,23 @@ func (h SignModeHandlerMap) GetSignBytes(mode signing.SignMode, data SignerData,
 	}
 	return handler.GetSignBytes(mode, data, tx)
 }
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h SignModeHandlerMap) GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, fmt.Errorf("can't verify sign mode %s", mode.String())
+	}
+
+	return GetSignBytesWithContext(ctx, handler, mode, data, tx)
+}
+
+// GetSignBytesWithContext returns the sign bytes of the given handler, passing
+// the context to the handler if it implements SignModeHandlerWithContext.
+func GetSignBytesWithContext(ctx context.Context, h SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if hWithCtx, ok := h.(SignModeHandlerWithContext); ok {
+		return hWithCtx.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return h.GetSignBytes(mode, data, tx)
+}
@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
+)
+
+// GetTxCmd returns smartaccount module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Smart account transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgRegisterAuthenticatorCmd(),
+		NewMsgRotateAuthenticatorCmd(),
+	)
+
+	return txCmd
+}
+
+// NewMsgRegisterAuthenticatorCmd returns a CLI command handler for creating a
+// MsgRegisterAuthenticator transaction.
+func NewMsgRegisterAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-authenticator [authenticator-json-file]",
+		Short: "Convert the sender account into a smart account authenticated by an authenticator",
+		Long: `Convert the base account of the sender into a smart account, whose signatures
+are authenticated by the given authenticator instead of its public key.`,
+		Example: fmt.Sprintf(`%s tx smartaccount register-authenticator authenticator.json --from mykey
+
+Where authenticator.json contains:
+
+{
+  "@type": "/cosmos.smartaccount.v1.MultisigAuthenticator",
+  "threshold": 2,
+  "public_keys": [
+    {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "A0q3..."},
+    {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "Aq5h..."},
+    {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "AoZ1..."}
+  ]
+}`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticator, err := parseAuthenticator(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRegisterAuthenticator(clientCtx.GetFromAddress(), authenticator)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMsgRotateAuthenticatorCmd returns a CLI command handler for creating a
+// MsgRotateAuthenticator transaction.
+func NewMsgRotateAuthenticatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-authenticator [authenticator-json-file]",
+		Short: "Replace the authenticator of the sender smart account",
+		Long: `Replace the authenticator of the smart account of the sender. The tx must be
+authenticated by the current authenticator of the account.`,
+		Example: fmt.Sprintf(`%s tx smartaccount rotate-authenticator authenticator.json --from mysmartaccount`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			authenticator, err := parseAuthenticator(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateAuthenticator(clientCtx.GetFromAddress(), authenticator)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseAuthenticator(cdc codec.Codec, authenticatorFile string) (types.Authenticator, error) {
+	contents, err := os.ReadFile(authenticatorFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var authenticator types.Authenticator
+	if err := cdc.UnmarshalInterfaceJSON(contents, &authenticator); err != nil {
+		return nil, fmt.Errorf("failed to parse authenticator: %w", err)
+	}
+
+	return authenticator, nil
+}
@@ -0,0 +1,104 @@
+package smartaccount
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	types.AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the smartaccount MsgServer
+// interface, wrapping the corresponding AccountKeeper.
+func NewMsgServerImpl(ak types.AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (s msgServer) RegisterAuthenticator(goCtx context.Context, msg *types.MsgRegisterAuthenticator) (*types.MsgRegisterAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator, err := msg.GetAuthenticatorI()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := s.GetAccount(ctx, sender)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", msg.Sender)
+	}
+
+	if _, ok := acc.(*types.SmartAccount); ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is already a smart account", msg.Sender)
+	}
+
+	baseAccount, ok := acc.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("only base accounts can be converted into smart accounts, got %T", acc)
+	}
+
+	types.InitAuthenticator(ctx, authenticator)
+	smartAccount, err := types.NewSmartAccount(baseAccount, authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetAccount(ctx, smartAccount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, msg.Authenticator.TypeUrl),
+		),
+	)
+
+	return &types.MsgRegisterAuthenticatorResponse{}, nil
+}
+
+func (s msgServer) RotateAuthenticator(goCtx context.Context, msg *types.MsgRotateAuthenticator) (*types.MsgRotateAuthenticatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator, err := msg.GetAuthenticatorI()
+	if err != nil {
+		return nil, err
+	}
+
+	smartAccount, ok := s.GetAccount(ctx, sender).(*types.SmartAccount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("account %s is not a smart account", msg.Sender)
+	}
+
+	types.InitAuthenticator(ctx, authenticator)
+	if err := smartAccount.SetAuthenticator(authenticator); err != nil {
+		return nil, err
+	}
+
+	s.SetAccount(ctx, smartAccount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, msg.Authenticator.TypeUrl),
+		),
+	)
+
+	return &types.MsgRotateAuthenticatorResponse{}, nil
+}
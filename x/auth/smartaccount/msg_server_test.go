@@ -0,0 +1,131 @@
+package smartaccount_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/smartaccount"
+	"github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var blockTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	msgServer     types.MsgServer
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(authtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: blockTime})
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, smartaccount.AppModuleBasic{})
+
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		encCfg.Codec, key, authtypes.ProtoBaseAccount, map[string][]string{}, sdk.Bech32MainPrefix, authtypes.NewModuleAddress("gov").String(),
+	)
+	s.msgServer = smartaccount.NewMsgServerImpl(s.accountKeeper)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
+
+func (s *MsgServerTestSuite) newAuthenticator() types.Authenticator {
+	authenticator, err := types.NewRecoveryAuthenticator(secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), time.Hour)
+	s.Require().NoError(err)
+	return authenticator
+}
+
+func (s *MsgServerTestSuite) TestRegisterAuthenticator() {
+	addr := sdk.AccAddress("base_account________")
+	acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr)
+	s.Require().NoError(acc.SetPubKey(secp256k1.GenPrivKey().PubKey()))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	moduleAddr := authtypes.NewModuleAddress("module")
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccount(s.ctx, authtypes.NewEmptyModuleAccount("module")))
+
+	authenticator := s.newAuthenticator()
+
+	testCases := []struct {
+		name   string
+		sender sdk.AccAddress
+		expErr error
+	}{
+		{"unknown account", sdk.AccAddress("unknown_account_____"), sdkerrors.ErrUnknownAddress},
+		{"module account", moduleAddr, sdkerrors.ErrInvalidRequest},
+		{"base account", addr, nil},
+		{"smart account", addr, sdkerrors.ErrInvalidRequest},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			msg, err := types.NewMsgRegisterAuthenticator(tc.sender, authenticator)
+			s.Require().NoError(err)
+			s.Require().NoError(msg.ValidateBasic())
+
+			_, err = s.msgServer.RegisterAuthenticator(s.ctx, msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			smartAccount, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*types.SmartAccount)
+			s.Require().True(ok)
+			s.Require().Equal(acc.GetAccountNumber(), smartAccount.GetAccountNumber())
+			s.Require().Nil(smartAccount.GetPubKey())
+			s.Require().NoError(smartAccount.Validate())
+
+			saved, err := smartAccount.GetAuthenticator()
+			s.Require().NoError(err)
+			s.Require().Equal(blockTime, saved.(*types.RecoveryAuthenticator).LastActivityTime)
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestRotateAuthenticator() {
+	addr := sdk.AccAddress("base_account________")
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, addr))
+
+	msgRotate, err := types.NewMsgRotateAuthenticator(addr, s.newAuthenticator())
+	s.Require().NoError(err)
+	_, err = s.msgServer.RotateAuthenticator(s.ctx, msgRotate)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	msgRegister, err := types.NewMsgRegisterAuthenticator(addr, s.newAuthenticator())
+	s.Require().NoError(err)
+	_, err = s.msgServer.RegisterAuthenticator(s.ctx, msgRegister)
+	s.Require().NoError(err)
+
+	// the members of a multisig are rotated without changing the address
+	members := []cryptotypes.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	multisig, err := types.NewMultisigAuthenticator(2, members)
+	s.Require().NoError(err)
+	msgRotate, err = types.NewMsgRotateAuthenticator(addr, multisig)
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RotateAuthenticator(s.ctx, msgRotate)
+	s.Require().NoError(err)
+
+	smartAccount, ok := s.accountKeeper.GetAccount(s.ctx, addr).(*types.SmartAccount)
+	s.Require().True(ok)
+	saved, err := smartAccount.GetAuthenticator()
+	s.Require().NoError(err)
+	s.Require().Equal(multisig.PublicKeys, saved.(*types.MultisigAuthenticator).PublicKeys)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// Authenticator authenticates the signatures of a smart account. The
+// authenticators are protobuf messages registered as implementations of the
+// Authenticator interface, so that apps can register their own.
+type Authenticator interface {
+	// Authenticate authenticates the signature of the smart account over a tx,
+	// returning an error if it is rejected. The Authenticator may update its
+	// internal state, for instance to track the coins spent by the account,
+	// and is saved again after the authentication.
+	Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error
+
+	// ValidateBasic should evaluate this Authenticator for internal
+	// consistency.
+	ValidateBasic() error
+}
+
+// RegistrationHook is implemented by the Authenticators initializing their
+// internal state when they are registered or rotated on an account.
+type RegistrationHook interface {
+	// OnRegistration is called before the Authenticator is set on the account.
+	OnRegistration(ctx sdk.Context)
+}
+
+// InitAuthenticator calls the RegistrationHook of the authenticator, if any.
+func InitAuthenticator(ctx sdk.Context, authenticator Authenticator) {
+	if hook, ok := authenticator.(RegistrationHook); ok {
+		hook.OnRegistration(ctx)
+	}
+}
@@ -0,0 +1,315 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	_ Authenticator                      = (*MultisigAuthenticator)(nil)
+	_ Authenticator                      = (*SessionKeyAuthenticator)(nil)
+	_ Authenticator                      = (*RecoveryAuthenticator)(nil)
+	_ RegistrationHook                   = (*RecoveryAuthenticator)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MultisigAuthenticator)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*SessionKeyAuthenticator)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*RecoveryAuthenticator)(nil)
+)
+
+// NewMultisigAuthenticator returns a MultisigAuthenticator requiring the
+// signatures of threshold of the given public keys.
+func NewMultisigAuthenticator(threshold uint32, pubKeys []cryptotypes.PubKey) (*MultisigAuthenticator, error) {
+	anyPubKeys := make([]*codectypes.Any, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		any, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		anyPubKeys[i] = any
+	}
+
+	return &MultisigAuthenticator{Threshold: threshold, PublicKeys: anyPubKeys}, nil
+}
+
+// Authenticate implements the Authenticator interface. The signature must be a
+// multisignature of the members.
+func (a *MultisigAuthenticator) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	if err := a.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return req.VerifySignature(ctx, &kmultisig.LegacyAminoPubKey{Threshold: a.Threshold, PubKeys: a.PublicKeys})
+}
+
+// ValidateBasic implements the Authenticator interface.
+func (a MultisigAuthenticator) ValidateBasic() error {
+	if a.Threshold == 0 || int(a.Threshold) > len(a.PublicKeys) {
+		return sdkerrors.Wrapf(ErrInvalidAuthenticator, "threshold must be between 1 and the number of public keys %d, got %d", len(a.PublicKeys), a.Threshold)
+	}
+
+	seen := make(map[string]bool, len(a.PublicKeys))
+	for _, any := range a.PublicKeys {
+		pubKey, err := unpackPubKey(any)
+		if err != nil {
+			return err
+		}
+
+		if seen[string(pubKey.Address())] {
+			return sdkerrors.Wrapf(ErrInvalidAuthenticator, "duplicate public key %s", pubKey)
+		}
+		seen[string(pubKey.Address())] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a MultisigAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range a.PublicKeys {
+		var pubKey cryptotypes.PubKey
+		if err := unpacker.UnpackAny(any, &pubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewSessionKeyAuthenticator returns a SessionKeyAuthenticator of the given
+// owner public key, without session keys.
+func NewSessionKeyAuthenticator(pubKey cryptotypes.PubKey) (*SessionKeyAuthenticator, error) {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionKeyAuthenticator{PublicKey: any}, nil
+}
+
+// AddSessionKey adds a session key spending at most spendLimit until the
+// expiration time.
+func (a *SessionKeyAuthenticator) AddSessionKey(pubKey cryptotypes.PubKey, expiration time.Time, spendLimit sdk.Coins) error {
+	any, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return err
+	}
+
+	a.SessionKeys = append(a.SessionKeys, SessionKey{PublicKey: any, Expiration: expiration, SpendLimit: spendLimit})
+	return nil
+}
+
+// Authenticate implements the Authenticator interface. The signature must be
+// made by the owner key or by a session key, in which case the spend limit of
+// the session key is updated. The owner key is assumed when simulating.
+func (a *SessionKeyAuthenticator) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	ownerKey, err := unpackPubKey(a.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	err = req.VerifySignature(ctx, ownerKey)
+	if err == nil || req.Simulate {
+		return err
+	}
+
+	for i := range a.SessionKeys {
+		sessionKey := &a.SessionKeys[i]
+		pubKey, err := unpackPubKey(sessionKey.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		if req.VerifySignature(ctx, pubKey) == nil {
+			return sessionKey.accept(ctx, req)
+		}
+	}
+
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+}
+
+// accept checks that the session key has not expired and only signs MsgSends
+// from the account within its spend limit, which is updated.
+func (sk *SessionKey) accept(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	if !ctx.BlockTime().Before(sk.Expiration) {
+		return sdkerrors.Wrapf(ErrAuthenticationFailed, "session key expired at %s", sk.Expiration)
+	}
+
+	spent := sdk.NewCoins()
+	for _, msg := range req.Tx.GetMsgs() {
+		msgSend, ok := msg.(*banktypes.MsgSend)
+		if !ok || msgSend.FromAddress != req.SignerData.Address {
+			return sdkerrors.Wrapf(ErrAuthenticationFailed, "session keys can only sign %s from the account, got %s", sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(msg))
+		}
+		spent = spent.Add(msgSend.Amount...)
+	}
+
+	// the fee is spent when it is paid by the account
+	if feeTx, ok := req.Tx.(sdk.FeeTx); ok && feeTx.FeeGranter().Empty() && feeTx.FeePayer().String() == req.SignerData.Address {
+		spent = spent.Add(feeTx.GetFee()...)
+	}
+
+	spendLimit, isNeg := sk.SpendLimit.SafeSub(spent...)
+	if isNeg {
+		return sdkerrors.Wrapf(ErrAuthenticationFailed, "session key spend limit %s exceeded by %s", sk.SpendLimit, spent)
+	}
+	sk.SpendLimit = spendLimit
+
+	return nil
+}
+
+// ValidateBasic implements the Authenticator interface.
+func (a SessionKeyAuthenticator) ValidateBasic() error {
+	if _, err := unpackPubKey(a.PublicKey); err != nil {
+		return err
+	}
+
+	for _, sessionKey := range a.SessionKeys {
+		if _, err := unpackPubKey(sessionKey.PublicKey); err != nil {
+			return err
+		}
+
+		if err := sessionKey.SpendLimit.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidAuthenticator, "invalid session key spend limit: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a SessionKeyAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(a.PublicKey, &pubKey); err != nil {
+		return err
+	}
+
+	for _, sessionKey := range a.SessionKeys {
+		var pubKey cryptotypes.PubKey
+		if err := unpacker.UnpackAny(sessionKey.PublicKey, &pubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewRecoveryAuthenticator returns a RecoveryAuthenticator of the given primary
+// and recovery public keys.
+func NewRecoveryAuthenticator(pubKey, recoveryPubKey cryptotypes.PubKey, recoveryDelay time.Duration) (*RecoveryAuthenticator, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	anyRecoveryPubKey, err := codectypes.NewAnyWithValue(recoveryPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryAuthenticator{
+		PublicKey:         anyPubKey,
+		RecoveryPublicKey: anyRecoveryPubKey,
+		RecoveryDelay:     recoveryDelay,
+	}, nil
+}
+
+// Authenticate implements the Authenticator interface. The signature must be
+// made by the primary key, whose last activity time is updated, or by the
+// recovery key once the primary key has not been used for the recovery delay,
+// in which case the tx must only rotate the authenticator of the account. The
+// primary key is assumed when simulating.
+func (a *RecoveryAuthenticator) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	pubKey, err := unpackPubKey(a.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	err = req.VerifySignature(ctx, pubKey)
+	if err == nil || req.Simulate {
+		if err == nil {
+			a.LastActivityTime = ctx.BlockTime()
+		}
+		return err
+	}
+
+	recoveryPubKey, err := unpackPubKey(a.RecoveryPublicKey)
+	if err != nil {
+		return err
+	}
+
+	if err := req.VerifySignature(ctx, recoveryPubKey); err != nil {
+		return err
+	}
+
+	if unlockTime := a.LastActivityTime.Add(a.RecoveryDelay); ctx.BlockTime().Before(unlockTime) {
+		return sdkerrors.Wrapf(ErrAuthenticationFailed, "recovery key locked until %s", unlockTime)
+	}
+
+	for _, msg := range req.Tx.GetMsgs() {
+		msgRotate, ok := msg.(*MsgRotateAuthenticator)
+		if !ok || msgRotate.Sender != req.SignerData.Address {
+			return sdkerrors.Wrapf(ErrAuthenticationFailed, "recovery keys can only sign %s of the account, got %s", sdk.MsgTypeURL(&MsgRotateAuthenticator{}), sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// OnRegistration implements the RegistrationHook interface. The recovery delay
+// starts at the registration of the authenticator.
+func (a *RecoveryAuthenticator) OnRegistration(ctx sdk.Context) {
+	a.LastActivityTime = ctx.BlockTime()
+}
+
+// ValidateBasic implements the Authenticator interface.
+func (a RecoveryAuthenticator) ValidateBasic() error {
+	pubKey, err := unpackPubKey(a.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	recoveryPubKey, err := unpackPubKey(a.RecoveryPublicKey)
+	if err != nil {
+		return err
+	}
+
+	if pubKey.Equals(recoveryPubKey) {
+		return sdkerrors.Wrap(ErrInvalidAuthenticator, "the recovery public key must differ from the public key")
+	}
+
+	if a.RecoveryDelay <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidAuthenticator, "recovery delay must be positive, got %s", a.RecoveryDelay)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a RecoveryAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey, recoveryPubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(a.PublicKey, &pubKey); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(a.RecoveryPublicKey, &recoveryPubKey)
+}
+
+// unpackPubKey returns the public key cached in the given Any.
+func unpackPubKey(any *codectypes.Any) (cryptotypes.PubKey, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAuthenticator, "empty public key")
+	}
+
+	pubKey, ok := any.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidAuthenticator, "invalid public key type %s", any.TypeUrl)
+	}
+
+	return pubKey, nil
+}
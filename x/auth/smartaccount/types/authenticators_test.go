@@ -0,0 +1,289 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/smartaccount/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	smartAccAddr = sdk.AccAddress("smart_account_______")
+	recipient    = sdk.AccAddress("recipient___________")
+	blockTime    = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newTxConfig() client.TxConfig {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	return authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+}
+
+func newContext(t *testing.T) sdk.Context {
+	key := sdk.NewKVStoreKey(types.ModuleName)
+	return testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test")).
+		WithBlockHeader(tmproto.Header{Height: 1, Time: blockTime})
+}
+
+func newSignerData() authsigning.SignerData {
+	return authsigning.SignerData{
+		Address:       smartAccAddr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+	}
+}
+
+func newTxBuilder(t *testing.T, txConfig client.TxConfig, fee sdk.Coins, msgs ...sdk.Msg) client.TxBuilder {
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(200000)
+	return txBuilder
+}
+
+// signTx signs the tx of the smart account with the given key in
+// SIGN_MODE_DIRECT.
+func signTx(t *testing.T, txConfig client.TxConfig, txBuilder client.TxBuilder, priv cryptotypes.PrivKey) {
+	signerData := newSignerData()
+	sig := signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: signerData.Sequence,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	sig, err := clienttx.SignWithPrivKey(signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder, priv, txConfig, signerData.Sequence)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+}
+
+// signMultisigTx signs the tx of the smart account with the given members of
+// the multisig key in SIGN_MODE_LEGACY_AMINO_JSON.
+func signMultisigTx(t *testing.T, txConfig client.TxConfig, txBuilder client.TxBuilder, multisigKey *kmultisig.LegacyAminoPubKey, privs ...cryptotypes.PrivKey) {
+	signerData := newSignerData()
+	multiSigData := multisig.NewMultisig(len(multisigKey.GetPubKeys()))
+	for _, priv := range privs {
+		sig, err := clienttx.SignWithPrivKey(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder, priv, txConfig, signerData.Sequence)
+		require.NoError(t, err)
+		require.NoError(t, multisig.AddSignatureV2(multiSigData, sig, multisigKey.GetPubKeys()))
+	}
+
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigKey,
+		Data:     multiSigData,
+		Sequence: signerData.Sequence,
+	}))
+}
+
+func newRequest(t *testing.T, txConfig client.TxConfig, txBuilder client.TxBuilder) ante.AuthenticationRequest {
+	tx := txBuilder.GetTx()
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+
+	return ante.AuthenticationRequest{
+		Tx:              tx,
+		Signature:       sigs[0],
+		SignerData:      newSignerData(),
+		SignModeHandler: txConfig.SignModeHandler(),
+		Params:          authtypes.DefaultParams(),
+	}
+}
+
+func TestMultisigAuthenticator(t *testing.T) {
+	txConfig := newTxConfig()
+	ctx := newContext(t)
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey(), privs[2].PubKey()}
+	authenticator, err := types.NewMultisigAuthenticator(2, pubKeys)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.ValidateBasic())
+
+	msgSend := banktypes.NewMsgSend(smartAccAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	txBuilder := newTxBuilder(t, txConfig, nil, msgSend)
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+
+	// a threshold of the members must sign
+	signMultisigTx(t, txConfig, txBuilder, multisigKey, privs[0], privs[2])
+	require.NoError(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+
+	signMultisigTx(t, txConfig, txBuilder, multisigKey, privs[1])
+	require.Error(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+
+	// the signatures of the rotated out members are rejected
+	newPriv := secp256k1.GenPrivKey()
+	rotated, err := types.NewMultisigAuthenticator(2, []cryptotypes.PubKey{pubKeys[0], pubKeys[1], newPriv.PubKey()})
+	require.NoError(t, err)
+
+	signMultisigTx(t, txConfig, txBuilder, multisigKey, privs[0], privs[2])
+	require.Error(t, rotated.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+
+	signMultisigTx(t, txConfig, txBuilder, kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pubKeys[0], pubKeys[1], newPriv.PubKey()}), privs[0], newPriv)
+	require.NoError(t, rotated.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+}
+
+func TestSessionKeyAuthenticator(t *testing.T) {
+	txConfig := newTxConfig()
+	ctx := newContext(t)
+
+	ownerPriv, sessionPriv, expiredPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	authenticator, err := types.NewSessionKeyAuthenticator(ownerPriv.PubKey())
+	require.NoError(t, err)
+	require.NoError(t, authenticator.AddSessionKey(sessionPriv.PubKey(), blockTime.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	require.NoError(t, authenticator.AddSessionKey(expiredPriv.PubKey(), blockTime, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))))
+	require.NoError(t, authenticator.ValidateBasic())
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 5))
+	msgSend := banktypes.NewMsgSend(smartAccAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)))
+	msgMultiSend := banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(smartAccAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))},
+		[]banktypes.Output{banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))},
+	)
+
+	testCases := []struct {
+		name          string
+		priv          cryptotypes.PrivKey
+		msgs          []sdk.Msg
+		expErr        error
+		expSpendLimit sdk.Coins
+	}{
+		{"owner key is not restricted", ownerPriv, []sdk.Msg{msgMultiSend}, nil, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+		{"session key spends its limit", sessionPriv, []sdk.Msg{msgSend}, nil, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+		{"session key spend limit exceeded", sessionPriv, []sdk.Msg{msgSend}, types.ErrAuthenticationFailed, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+		{"session key only signs msg sends", sessionPriv, []sdk.Msg{msgMultiSend}, types.ErrAuthenticationFailed, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+		{"session key only signs msg sends from the account", sessionPriv, []sdk.Msg{banktypes.NewMsgSend(recipient, smartAccAddr, nil)}, types.ErrAuthenticationFailed, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+		{"expired session key", expiredPriv, []sdk.Msg{msgSend}, types.ErrAuthenticationFailed, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+		{"unknown key", secp256k1.GenPrivKey(), []sdk.Msg{msgSend}, sdkerrors.ErrUnauthorized, sdk.NewCoins(sdk.NewInt64Coin("atom", 35))},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := newTxBuilder(t, txConfig, fee, tc.msgs...)
+			txBuilder.SetFeePayer(smartAccAddr)
+			signTx(t, txConfig, txBuilder, tc.priv)
+
+			err := authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expSpendLimit, authenticator.SessionKeys[0].SpendLimit)
+		})
+	}
+}
+
+func TestRecoveryAuthenticator(t *testing.T) {
+	txConfig := newTxConfig()
+	ctx := newContext(t)
+
+	priv, recoveryPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	authenticator, err := types.NewRecoveryAuthenticator(priv.PubKey(), recoveryPriv.PubKey(), 24*time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, authenticator.ValidateBasic())
+	types.InitAuthenticator(ctx, authenticator)
+	require.Equal(t, blockTime, authenticator.LastActivityTime)
+
+	msgSend := banktypes.NewMsgSend(smartAccAddr, recipient, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	newAuthenticator, err := types.NewSessionKeyAuthenticator(recoveryPriv.PubKey())
+	require.NoError(t, err)
+	msgRotate, err := types.NewMsgRotateAuthenticator(smartAccAddr, newAuthenticator)
+	require.NoError(t, err)
+
+	// the primary key is not restricted, and delays the recovery
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	txBuilder := newTxBuilder(t, txConfig, nil, msgSend)
+	signTx(t, txConfig, txBuilder, priv)
+	require.NoError(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+	require.Equal(t, ctx.BlockTime(), authenticator.LastActivityTime)
+
+	// the recovery key is locked for the recovery delay
+	txBuilder = newTxBuilder(t, txConfig, nil, msgRotate)
+	signTx(t, txConfig, txBuilder, recoveryPriv)
+	ctx = ctx.WithBlockTime(blockTime.Add(24 * time.Hour))
+	require.ErrorIs(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)), types.ErrAuthenticationFailed)
+
+	// the recovery key can only rotate the authenticator once unlocked
+	ctx = ctx.WithBlockTime(blockTime.Add(25 * time.Hour))
+	require.NoError(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)))
+
+	txBuilder = newTxBuilder(t, txConfig, nil, msgSend)
+	signTx(t, txConfig, txBuilder, recoveryPriv)
+	require.ErrorIs(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)), types.ErrAuthenticationFailed)
+
+	// unknown keys are rejected
+	signTx(t, txConfig, txBuilder, secp256k1.GenPrivKey())
+	require.ErrorIs(t, authenticator.Authenticate(ctx, newRequest(t, txConfig, txBuilder)), sdkerrors.ErrUnauthorized)
+}
+
+func TestAuthenticatorsValidateBasic(t *testing.T) {
+	pubKey, otherPubKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+
+	multisigAuthenticator := func(threshold uint32, pubKeys ...cryptotypes.PubKey) types.Authenticator {
+		authenticator, err := types.NewMultisigAuthenticator(threshold, pubKeys)
+		require.NoError(t, err)
+		return authenticator
+	}
+	sessionKeyAuthenticator := func(spendLimit sdk.Coins) types.Authenticator {
+		authenticator, err := types.NewSessionKeyAuthenticator(pubKey)
+		require.NoError(t, err)
+		require.NoError(t, authenticator.AddSessionKey(otherPubKey, blockTime, spendLimit))
+		return authenticator
+	}
+	recoveryAuthenticator := func(recoveryPubKey cryptotypes.PubKey, recoveryDelay time.Duration) types.Authenticator {
+		authenticator, err := types.NewRecoveryAuthenticator(pubKey, recoveryPubKey, recoveryDelay)
+		require.NoError(t, err)
+		return authenticator
+	}
+
+	testCases := []struct {
+		name          string
+		authenticator types.Authenticator
+		expErr        bool
+	}{
+		{"valid multisig", multisigAuthenticator(2, pubKey, otherPubKey), false},
+		{"zero multisig threshold", multisigAuthenticator(0, pubKey, otherPubKey), true},
+		{"multisig threshold above the number of keys", multisigAuthenticator(3, pubKey, otherPubKey), true},
+		{"duplicate multisig keys", multisigAuthenticator(1, pubKey, pubKey), true},
+		{"multisig without keys", &types.MultisigAuthenticator{Threshold: 1, PublicKeys: []*codectypes.Any{nil}}, true},
+		{"valid session keys", sessionKeyAuthenticator(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))), false},
+		{"invalid session key spend limit", sessionKeyAuthenticator(sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}}), true},
+		{"session keys without owner key", &types.SessionKeyAuthenticator{}, true},
+		{"valid recovery", recoveryAuthenticator(otherPubKey, time.Hour), false},
+		{"same recovery key", recoveryAuthenticator(pubKey, time.Hour), true},
+		{"zero recovery delay", recoveryAuthenticator(otherPubKey, 0), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authenticator.ValidateBasic()
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidAuthenticator)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
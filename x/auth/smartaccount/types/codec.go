@@ -0,0 +1,70 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// RegisterLegacyAminoCodec registers the smartaccount interfaces and concrete
+// types on the provided LegacyAmino codec. These types are used for Amino JSON
+// serialization
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&SmartAccount{}, "cosmos-sdk/SmartAccount", nil)
+	cdc.RegisterInterface((*Authenticator)(nil), nil)
+	cdc.RegisterConcrete(&MultisigAuthenticator{}, "cosmos-sdk/MultisigAuthenticator", nil)
+	cdc.RegisterConcrete(&SessionKeyAuthenticator{}, "cosmos-sdk/SessionKeyAuthenticator", nil)
+	cdc.RegisterConcrete(&RecoveryAuthenticator{}, "cosmos-sdk/RecoveryAuthenticator", nil)
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterAuthenticator{}, "cosmos-sdk/MsgRegisterAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateAuthenticator{}, "cosmos-sdk/MsgRotateAuthenticator")
+}
+
+// RegisterInterfaces registers the SmartAccount as an implementation of
+// AccountI, and the built-in Authenticators.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*authtypes.AccountI)(nil),
+		&SmartAccount{},
+	)
+
+	registry.RegisterImplementations(
+		(*authtypes.GenesisAccount)(nil),
+		&SmartAccount{},
+	)
+
+	registry.RegisterInterface(
+		"cosmos.smartaccount.v1.Authenticator",
+		(*Authenticator)(nil),
+		&MultisigAuthenticator{},
+		&SessionKeyAuthenticator{},
+		&RecoveryAuthenticator{},
+	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgRegisterAuthenticator{},
+		&MsgRotateAuthenticator{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
@@ -0,0 +1,9 @@
+package types
+
+const (
+	// ModuleName defines the module's name.
+	ModuleName = "smartaccount"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
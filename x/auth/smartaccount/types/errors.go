@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth/smartaccount module sentinel errors
+var (
+	ErrInvalidAuthenticator = sdkerrors.Register(ModuleName, 2, "invalid authenticator")
+	ErrAuthenticationFailed = sdkerrors.Register(ModuleName, 3, "authentication failed")
+)
@@ -0,0 +1,10 @@
+package types
+
+// smartaccount module event types
+const (
+	EventTypeRegisterAuthenticator = "register_authenticator"
+	EventTypeRotateAuthenticator   = "rotate_authenticator"
+
+	AttributeKeyAccount           = "account"
+	AttributeKeyAuthenticatorType = "authenticator_type"
+)
@@ -0,0 +1,132 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg                            = &MsgRegisterAuthenticator{}
+	_ sdk.Msg                            = &MsgRotateAuthenticator{}
+	_ legacytx.LegacyMsg                 = &MsgRegisterAuthenticator{}
+	_ legacytx.LegacyMsg                 = &MsgRotateAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRegisterAuthenticator{}
+	_ codectypes.UnpackInterfacesMessage = &MsgRotateAuthenticator{}
+)
+
+// NewMsgRegisterAuthenticator returns a message converting the base account of
+// the sender into a smart account authenticated by the given authenticator.
+//
+//nolint:interfacer
+func NewMsgRegisterAuthenticator(sender sdk.AccAddress, authenticator Authenticator) (*MsgRegisterAuthenticator, error) {
+	any, err := PackAuthenticator(authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRegisterAuthenticator{Sender: sender.String(), Authenticator: any}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRegisterAuthenticator) ValidateBasic() error {
+	return validateAuthenticatorMsg(msg.Sender, msg.Authenticator)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRegisterAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRegisterAuthenticator) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRegisterAuthenticator) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRegisterAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetAuthenticator returns the unpacked authenticator.
+func (msg MsgRegisterAuthenticator) GetAuthenticatorI() (Authenticator, error) {
+	return unpackAuthenticator(msg.Authenticator)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRegisterAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authenticator Authenticator
+	return unpacker.UnpackAny(msg.Authenticator, &authenticator)
+}
+
+// NewMsgRotateAuthenticator returns a message replacing the authenticator of
+// the smart account of the sender.
+//
+//nolint:interfacer
+func NewMsgRotateAuthenticator(sender sdk.AccAddress, authenticator Authenticator) (*MsgRotateAuthenticator, error) {
+	any, err := PackAuthenticator(authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotateAuthenticator{Sender: sender.String(), Authenticator: any}, nil
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateAuthenticator) ValidateBasic() error {
+	return validateAuthenticatorMsg(msg.Sender, msg.Authenticator)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateAuthenticator) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRotateAuthenticator) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRotateAuthenticator) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRotateAuthenticator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetAuthenticator returns the unpacked authenticator.
+func (msg MsgRotateAuthenticator) GetAuthenticatorI() (Authenticator, error) {
+	return unpackAuthenticator(msg.Authenticator)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var authenticator Authenticator
+	return unpacker.UnpackAny(msg.Authenticator, &authenticator)
+}
+
+// validateAuthenticatorMsg validates the sender and the authenticator of the
+// smartaccount messages.
+func validateAuthenticatorMsg(sender string, any *codectypes.Any) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	authenticator, err := unpackAuthenticator(any)
+	if err != nil {
+		return err
+	}
+
+	return authenticator.ValidateBasic()
+}
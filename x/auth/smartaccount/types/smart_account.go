@@ -0,0 +1,118 @@
+package types
+
+import (
+	"errors"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var (
+	_ ante.SmartAccount                  = (*SmartAccount)(nil)
+	_ authtypes.GenesisAccount           = (*SmartAccount)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*SmartAccount)(nil)
+)
+
+// NewSmartAccount returns a SmartAccount converted from the given base account,
+// and authenticated by the given authenticator. The public key of the base
+// account is removed, as it does not authenticate the smart account.
+func NewSmartAccount(baseAccount *authtypes.BaseAccount, authenticator Authenticator) (*SmartAccount, error) {
+	baseAccount.PubKey = nil
+	smartAccount := &SmartAccount{BaseAccount: baseAccount}
+	if err := smartAccount.SetAuthenticator(authenticator); err != nil {
+		return nil, err
+	}
+
+	return smartAccount, nil
+}
+
+// GetAuthenticator returns the unpacked authenticator of the account.
+func (sa SmartAccount) GetAuthenticator() (Authenticator, error) {
+	return unpackAuthenticator(sa.Authenticator)
+}
+
+// SetAuthenticator sets the authenticator of the account.
+func (sa *SmartAccount) SetAuthenticator(authenticator Authenticator) error {
+	any, err := PackAuthenticator(authenticator)
+	if err != nil {
+		return err
+	}
+
+	sa.Authenticator = any
+	return nil
+}
+
+// Authenticate implements the ante.SmartAccount interface. It authenticates
+// the signature of the account with its authenticator, which is saved in the
+// account as it may have been updated.
+func (sa *SmartAccount) Authenticate(ctx sdk.Context, req ante.AuthenticationRequest) error {
+	authenticator, err := sa.GetAuthenticator()
+	if err != nil {
+		return err
+	}
+
+	if err := authenticator.Authenticate(ctx, req); err != nil {
+		return err
+	}
+
+	return sa.SetAuthenticator(authenticator)
+}
+
+// Validate checks for errors on the account fields.
+func (sa SmartAccount) Validate() error {
+	if sa.BaseAccount == nil {
+		return errors.New("uninitialized SmartAccount: BaseAccount is nil")
+	}
+
+	if err := sa.BaseAccount.Validate(); err != nil {
+		return err
+	}
+
+	authenticator, err := sa.GetAuthenticator()
+	if err != nil {
+		return err
+	}
+
+	return authenticator.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (sa SmartAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if sa.BaseAccount != nil {
+		if err := sa.BaseAccount.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	var authenticator Authenticator
+	return unpacker.UnpackAny(sa.Authenticator, &authenticator)
+}
+
+// PackAuthenticator packs an Authenticator into an Any.
+func PackAuthenticator(authenticator Authenticator) (*codectypes.Any, error) {
+	msg, ok := authenticator.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", authenticator)
+	}
+
+	return codectypes.NewAnyWithValue(msg)
+}
+
+// unpackAuthenticator returns the Authenticator cached in the given Any.
+func unpackAuthenticator(any *codectypes.Any) (Authenticator, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrInvalidAuthenticator, "empty authenticator")
+	}
+
+	authenticator, ok := any.GetCachedValue().(Authenticator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(ErrInvalidAuthenticator, "invalid authenticator type %s", any.TypeUrl)
+	}
+
+	return authenticator, nil
+}
@@ -0,0 +1,1533 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/smartaccount/v1/smartaccount.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SmartAccount is an account whose authentication is delegated to its
+// authenticator instead of the verification of the signatures of its public
+// key.
+type SmartAccount struct {
+	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
+	// authenticator authenticates the signatures of the account.
+	Authenticator *types1.Any `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *SmartAccount) Reset()         { *m = SmartAccount{} }
+func (m *SmartAccount) String() string { return proto.CompactTextString(m) }
+func (*SmartAccount) ProtoMessage()    {}
+func (*SmartAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4942a19931c386ed, []int{0}
+}
+func (m *SmartAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SmartAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SmartAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartAccount.Merge(m, src)
+}
+func (m *SmartAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartAccount proto.InternalMessageInfo
+
+// MultisigAuthenticator authenticates the signatures of a threshold of its
+// members. The members can be rotated without changing the address of the
+// account.
+type MultisigAuthenticator struct {
+	// threshold is the number of members whose signatures are required.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// public_keys are the public keys of the members.
+	PublicKeys []*types1.Any `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+}
+
+func (m *MultisigAuthenticator) Reset()         { *m = MultisigAuthenticator{} }
+func (m *MultisigAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MultisigAuthenticator) ProtoMessage()    {}
+func (*MultisigAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4942a19931c386ed, []int{1}
+}
+func (m *MultisigAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigAuthenticator.Merge(m, src)
+}
+func (m *MultisigAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigAuthenticator proto.InternalMessageInfo
+
+func (m *MultisigAuthenticator) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigAuthenticator) GetPublicKeys() []*types1.Any {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+// SessionKeyAuthenticator authenticates the signatures of its owner key, and of
+// session keys restricted to sending limited amounts of coins until they expire.
+type SessionKeyAuthenticator struct {
+	// public_key is the public key of the owner of the account, which is not
+	// restricted.
+	PublicKey *types1.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// session_keys are the session keys of the account.
+	SessionKeys []SessionKey `protobuf:"bytes,2,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+}
+
+func (m *SessionKeyAuthenticator) Reset()         { *m = SessionKeyAuthenticator{} }
+func (m *SessionKeyAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*SessionKeyAuthenticator) ProtoMessage()    {}
+func (*SessionKeyAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4942a19931c386ed, []int{2}
+}
+func (m *SessionKeyAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKeyAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKeyAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKeyAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKeyAuthenticator.Merge(m, src)
+}
+func (m *SessionKeyAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKeyAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKeyAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKeyAuthenticator proto.InternalMessageInfo
+
+func (m *SessionKeyAuthenticator) GetPublicKey() *types1.Any {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SessionKeyAuthenticator) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+// SessionKey is a key which can only sign the txs whose Msgs are bank MsgSends
+// from the account, until it expires.
+type SessionKey struct {
+	// public_key is the public key of the session key.
+	PublicKey *types1.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// expiration is the time after which the session key is rejected.
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// spend_limit is the amount of coins the session key can still spend, in
+	// MsgSends and in the fees paid by the account. It is updated as coins are
+	// spent.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4942a19931c386ed, []int{3}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetPublicKey() *types1.Any {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *SessionKey) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+// RecoveryAuthenticator authenticates the signatures of its primary key, and
+// of a recovery key which can only rotate the authenticator of the account,
+// once the primary key has not been used for the recovery delay.
+type RecoveryAuthenticator struct {
+	// public_key is the primary public key of the account.
+	PublicKey *types1.Any `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// recovery_public_key is the public key recovering the account.
+	RecoveryPublicKey *types1.Any `protobuf:"bytes,2,opt,name=recovery_public_key,json=recoveryPublicKey,proto3" json:"recovery_public_key,omitempty"`
+	// recovery_delay is the time the primary key must not be used for before the
+	// recovery key can be used.
+	RecoveryDelay time.Duration `protobuf:"bytes,3,opt,name=recovery_delay,json=recoveryDelay,proto3,stdduration" json:"recovery_delay"`
+	// last_activity_time is the time of the last use of the primary key, or of
+	// the registration of the authenticator. It is set by the module.
+	LastActivityTime time.Time `protobuf:"bytes,4,opt,name=last_activity_time,json=lastActivityTime,proto3,stdtime" json:"last_activity_time"`
+}
+
+func (m *RecoveryAuthenticator) Reset()         { *m = RecoveryAuthenticator{} }
+func (m *RecoveryAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*RecoveryAuthenticator) ProtoMessage()    {}
+func (*RecoveryAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4942a19931c386ed, []int{4}
+}
+func (m *RecoveryAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryAuthenticator.Merge(m, src)
+}
+func (m *RecoveryAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryAuthenticator proto.InternalMessageInfo
+
+func (m *RecoveryAuthenticator) GetPublicKey() *types1.Any {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *RecoveryAuthenticator) GetRecoveryPublicKey() *types1.Any {
+	if m != nil {
+		return m.RecoveryPublicKey
+	}
+	return nil
+}
+
+func (m *RecoveryAuthenticator) GetRecoveryDelay() time.Duration {
+	if m != nil {
+		return m.RecoveryDelay
+	}
+	return 0
+}
+
+func (m *RecoveryAuthenticator) GetLastActivityTime() time.Time {
+	if m != nil {
+		return m.LastActivityTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*SmartAccount)(nil), "cosmos.smartaccount.v1.SmartAccount")
+	proto.RegisterType((*MultisigAuthenticator)(nil), "cosmos.smartaccount.v1.MultisigAuthenticator")
+	proto.RegisterType((*SessionKeyAuthenticator)(nil), "cosmos.smartaccount.v1.SessionKeyAuthenticator")
+	proto.RegisterType((*SessionKey)(nil), "cosmos.smartaccount.v1.SessionKey")
+	proto.RegisterType((*RecoveryAuthenticator)(nil), "cosmos.smartaccount.v1.RecoveryAuthenticator")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/smartaccount/v1/smartaccount.proto", fileDescriptor_4942a19931c386ed)
+}
+
+var fileDescriptor_4942a19931c386ed = []byte{
+	// 654 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x3f, 0x6f, 0xd4, 0x3e,
+	0x18, 0xc7, 0x2f, 0x77, 0xd5, 0x4f, 0xbf, 0xfa, 0x5a, 0x04, 0xa1, 0x85, 0xb4, 0xaa, 0x72, 0xd5,
+	0xa9, 0x43, 0x19, 0x2e, 0xe1, 0x40, 0x62, 0xe8, 0x76, 0xa1, 0x4b, 0x39, 0x2a, 0xaa, 0x94, 0x89,
+	0x81, 0xc8, 0x49, 0x4c, 0xce, 0x6a, 0x12, 0x47, 0xb1, 0x73, 0x6a, 0xde, 0x01, 0x1b, 0x1d, 0x19,
+	0x99, 0x99, 0x18, 0xfa, 0x22, 0xaa, 0x4e, 0x1d, 0x99, 0x5a, 0xd4, 0x8a, 0xf7, 0x81, 0xec, 0x38,
+	0xb9, 0x4b, 0xff, 0xa0, 0x16, 0xc1, 0x74, 0x67, 0x3f, 0xcf, 0xf3, 0xf1, 0xf7, 0xeb, 0xc7, 0x76,
+	0xc0, 0x13, 0x8f, 0xd0, 0x88, 0x50, 0x93, 0x46, 0x30, 0x65, 0xd0, 0xf3, 0x48, 0x16, 0x33, 0x73,
+	0xdc, 0xaf, 0x8d, 0x8d, 0x24, 0x25, 0x8c, 0xa8, 0x8f, 0x8a, 0x54, 0xa3, 0x16, 0x1a, 0xf7, 0x97,
+	0x17, 0x02, 0x12, 0x10, 0x91, 0x62, 0xf2, 0x7f, 0x45, 0xf6, 0xf2, 0x52, 0x40, 0x48, 0x10, 0x22,
+	0x53, 0x8c, 0xdc, 0xec, 0x83, 0x09, 0xe3, 0x5c, 0x86, 0xf4, 0xcb, 0x21, 0x3f, 0x4b, 0x21, 0xc3,
+	0x24, 0x96, 0xf1, 0xce, 0xe5, 0x38, 0xc3, 0x11, 0xa2, 0x0c, 0x46, 0x49, 0xc9, 0x2e, 0x94, 0x38,
+	0xc5, 0xa2, 0x52, 0x96, 0x64, 0x4b, 0x3f, 0x30, 0x63, 0x23, 0x73, 0xdc, 0x77, 0x11, 0x83, 0x7d,
+	0x31, 0xb8, 0x14, 0x77, 0x21, 0x45, 0x55, 0xdc, 0x23, 0x58, 0xae, 0xdd, 0xfd, 0xa9, 0x80, 0xb9,
+	0x5d, 0x6e, 0x70, 0x50, 0x18, 0x54, 0xb7, 0xc0, 0x1c, 0xcf, 0x75, 0xa4, 0x61, 0x4d, 0x59, 0x55,
+	0xd6, 0xdb, 0xcf, 0x56, 0x0d, 0xb9, 0xaa, 0x40, 0x4b, 0x8e, 0x61, 0x41, 0x8a, 0x64, 0x9d, 0x35,
+	0x73, 0x72, 0xda, 0x51, 0xec, 0xb6, 0x3b, 0x99, 0x52, 0x7d, 0x30, 0xcf, 0xd3, 0x51, 0xcc, 0xb0,
+	0x07, 0x19, 0x49, 0xb5, 0xa6, 0x60, 0x2d, 0x18, 0x85, 0x5f, 0xa3, 0xf4, 0x6b, 0x0c, 0xe2, 0xdc,
+	0x5a, 0x3f, 0x3e, 0xec, 0xad, 0x5d, 0xbf, 0xe3, 0xc6, 0x60, 0x9a, 0x62, 0xd7, 0xa1, 0x1b, 0x6b,
+	0x1f, 0xbf, 0x74, 0x1a, 0xc7, 0x87, 0xbd, 0x95, 0xeb, 0x14, 0x4a, 0x29, 0x5b, 0xdd, 0x6f, 0x0a,
+	0x58, 0xdc, 0xce, 0x42, 0x86, 0x29, 0x0e, 0x6a, 0x38, 0x75, 0x05, 0xcc, 0xb2, 0x51, 0x8a, 0xe8,
+	0x88, 0x84, 0xbe, 0x70, 0x3b, 0x6f, 0x4f, 0x26, 0xd4, 0x37, 0xa0, 0x9d, 0x64, 0x6e, 0x88, 0x3d,
+	0x67, 0x0f, 0xe5, 0x54, 0x6b, 0xae, 0xb6, 0x6e, 0x74, 0xa0, 0x1d, 0x1f, 0xf6, 0x16, 0xa4, 0x08,
+	0x2f, 0xcd, 0x13, 0x46, 0x8c, 0x9d, 0xcc, 0x1d, 0xa2, 0xdc, 0x06, 0x05, 0x62, 0x88, 0x72, 0xba,
+	0x71, 0x6b, 0x9f, 0xdd, 0x33, 0x05, 0x3c, 0xde, 0x45, 0x94, 0x62, 0x12, 0x0f, 0x51, 0x5e, 0x17,
+	0xbd, 0x0d, 0xc0, 0x44, 0x96, 0xec, 0xd1, 0x5d, 0x55, 0xcd, 0x56, 0xaa, 0xd4, 0x21, 0x98, 0xa3,
+	0xc5, 0x4a, 0xd3, 0x36, 0xbb, 0xc6, 0x0d, 0x3a, 0x27, 0xaa, 0xac, 0x99, 0xa3, 0xd3, 0x4e, 0xc3,
+	0x6e, 0xd3, 0x6a, 0xe6, 0x2e, 0x0e, 0x3f, 0x35, 0x01, 0x98, 0xb0, 0xfe, 0xb6, 0xa9, 0x4d, 0x00,
+	0xd0, 0x7e, 0x82, 0x8b, 0xab, 0x26, 0xcf, 0xde, 0xf2, 0x15, 0xdc, 0xdb, 0xf2, 0xae, 0x59, 0xff,
+	0x73, 0x2b, 0x07, 0x67, 0x1d, 0xc5, 0x9e, 0xaa, 0x53, 0x43, 0xd0, 0xa6, 0x09, 0x8a, 0x7d, 0x27,
+	0xc4, 0x11, 0x66, 0x5a, 0x4b, 0xec, 0xcc, 0x52, 0xb9, 0x33, 0xfc, 0xb8, 0x57, 0x87, 0xed, 0x25,
+	0xc1, 0xb1, 0xf5, 0x94, 0x53, 0xbe, 0x9e, 0x75, 0xd6, 0x03, 0xcc, 0x46, 0x99, 0x6b, 0x78, 0x24,
+	0x92, 0x37, 0x56, 0xfe, 0xf4, 0xa8, 0xbf, 0x67, 0xb2, 0x3c, 0x41, 0x54, 0x14, 0x50, 0x1b, 0x08,
+	0xfe, 0x6b, 0x8e, 0xef, 0x1e, 0xb4, 0xc0, 0xa2, 0x8d, 0x3c, 0x32, 0x46, 0xe9, 0xbf, 0xed, 0xf8,
+	0x7b, 0xf0, 0x30, 0x95, 0xeb, 0x38, 0x53, 0xdc, 0xe6, 0x1f, 0x71, 0x1f, 0x94, 0xa8, 0x9d, 0x8a,
+	0xff, 0x0a, 0xdc, 0xab, 0xf8, 0x3e, 0x0a, 0x61, 0xae, 0xb5, 0x04, 0x7a, 0xe9, 0x0a, 0x7a, 0x53,
+	0x3e, 0x86, 0xc5, 0xfe, 0x7f, 0xe6, 0xfb, 0x3f, 0x5f, 0x96, 0x6e, 0xf2, 0x4a, 0xd5, 0x06, 0x6a,
+	0x08, 0x29, 0x73, 0xa0, 0xc7, 0xf0, 0x18, 0xb3, 0xdc, 0xe1, 0xef, 0xa3, 0x36, 0x73, 0x87, 0x86,
+	0xde, 0xe7, 0xf5, 0x03, 0x59, 0xce, 0x13, 0x6e, 0x7f, 0x48, 0xad, 0x9d, 0xa3, 0x73, 0x5d, 0x39,
+	0x39, 0xd7, 0x95, 0x1f, 0xe7, 0xba, 0x72, 0x70, 0xa1, 0x37, 0x4e, 0x2e, 0xf4, 0xc6, 0xf7, 0x0b,
+	0xbd, 0xf1, 0xee, 0xc5, 0x6f, 0x5b, 0xbc, 0x5f, 0xbc, 0xc9, 0xb5, 0x0f, 0x8d, 0x68, 0xbb, 0xfb,
+	0x9f, 0xd0, 0xfa, 0xfc, 0xd7, 0x00, 0x5f, 0x74, 0xa5, 0x81, 0x8c, 0x06, 0x00, 0x00,
+}
+
+func (m *SmartAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authenticator != nil {
+		{
+			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultisigAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultisigAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for iNdEx := len(m.PublicKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublicKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintSmartaccount(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionKeyAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKeyAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKeyAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSmartaccount(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveryAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastActivityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActivityTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSmartaccount(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RecoveryDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecoveryDelay):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintSmartaccount(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.RecoveryPublicKey != nil {
+		{
+			size, err := m.RecoveryPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSmartaccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSmartaccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSmartaccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SmartAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	if m.Authenticator != nil {
+		l = m.Authenticator.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	return n
+}
+
+func (m *MultisigAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovSmartaccount(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovSmartaccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SessionKeyAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovSmartaccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovSmartaccount(uint64(l))
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSmartaccount(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecoveryAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	if m.RecoveryPublicKey != nil {
+		l = m.RecoveryPublicKey.Size()
+		n += 1 + l + sovSmartaccount(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RecoveryDelay)
+	n += 1 + l + sovSmartaccount(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastActivityTime)
+	n += 1 + l + sovSmartaccount(uint64(l))
+	return n
+}
+
+func sovSmartaccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSmartaccount(x uint64) (n int) {
+	return sovSmartaccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SmartAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticator == nil {
+				m.Authenticator = &types1.Any{}
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, &types1.Any{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionKeyAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKeyAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKeyAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types1.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types1.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types2.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types1.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecoveryPublicKey == nil {
+				m.RecoveryPublicKey = &types1.Any{}
+			}
+			if err := m.RecoveryPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RecoveryDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastActivityTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSmartaccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSmartaccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSmartaccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSmartaccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSmartaccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSmartaccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSmartaccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSmartaccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSmartaccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSmartaccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSmartaccount = fmt.Errorf("proto: unexpected end of group")
+)
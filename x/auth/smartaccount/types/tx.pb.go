@@ -0,0 +1,998 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/smartaccount/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterAuthenticator is the Msg/RegisterAuthenticator request type.
+type MsgRegisterAuthenticator struct {
+	// sender is the address of the base account converted into a smart account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// authenticator is the authenticator of the smart account.
+	Authenticator *types.Any `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *MsgRegisterAuthenticator) Reset()         { *m = MsgRegisterAuthenticator{} }
+func (m *MsgRegisterAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuthenticator) ProtoMessage()    {}
+func (*MsgRegisterAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f386273baafdae3d, []int{0}
+}
+func (m *MsgRegisterAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuthenticator.Merge(m, src)
+}
+func (m *MsgRegisterAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuthenticator proto.InternalMessageInfo
+
+func (m *MsgRegisterAuthenticator) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterAuthenticator) GetAuthenticator() *types.Any {
+	if m != nil {
+		return m.Authenticator
+	}
+	return nil
+}
+
+// MsgRegisterAuthenticatorResponse defines the Msg/RegisterAuthenticator
+// response type.
+type MsgRegisterAuthenticatorResponse struct {
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Reset()         { *m = MsgRegisterAuthenticatorResponse{} }
+func (m *MsgRegisterAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgRegisterAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f386273baafdae3d, []int{1}
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAuthenticatorResponse proto.InternalMessageInfo
+
+// MsgRotateAuthenticator is the Msg/RotateAuthenticator request type.
+type MsgRotateAuthenticator struct {
+	// sender is the address of the smart account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// authenticator is the new authenticator of the smart account.
+	Authenticator *types.Any `protobuf:"bytes,2,opt,name=authenticator,proto3" json:"authenticator,omitempty"`
+}
+
+func (m *MsgRotateAuthenticator) Reset()         { *m = MsgRotateAuthenticator{} }
+func (m *MsgRotateAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAuthenticator) ProtoMessage()    {}
+func (*MsgRotateAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f386273baafdae3d, []int{2}
+}
+func (m *MsgRotateAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAuthenticator.Merge(m, src)
+}
+func (m *MsgRotateAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateAuthenticator proto.InternalMessageInfo
+
+func (m *MsgRotateAuthenticator) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRotateAuthenticator) GetAuthenticator() *types.Any {
+	if m != nil {
+		return m.Authenticator
+	}
+	return nil
+}
+
+// MsgRotateAuthenticatorResponse defines the Msg/RotateAuthenticator response
+// type.
+type MsgRotateAuthenticatorResponse struct {
+}
+
+func (m *MsgRotateAuthenticatorResponse) Reset()         { *m = MsgRotateAuthenticatorResponse{} }
+func (m *MsgRotateAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgRotateAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f386273baafdae3d, []int{3}
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateAuthenticatorResponse.Merge(m, src)
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateAuthenticatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateAuthenticatorResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAuthenticator)(nil), "cosmos.smartaccount.v1.MsgRegisterAuthenticator")
+	proto.RegisterType((*MsgRegisterAuthenticatorResponse)(nil), "cosmos.smartaccount.v1.MsgRegisterAuthenticatorResponse")
+	proto.RegisterType((*MsgRotateAuthenticator)(nil), "cosmos.smartaccount.v1.MsgRotateAuthenticator")
+	proto.RegisterType((*MsgRotateAuthenticatorResponse)(nil), "cosmos.smartaccount.v1.MsgRotateAuthenticatorResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/smartaccount/v1/tx.proto", fileDescriptor_f386273baafdae3d) }
+
+var fileDescriptor_f386273baafdae3d = []byte{
+	// 373 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xef, 0x55, 0x28, 0x78, 0xc5, 0x25, 0xd6, 0x9a, 0x66, 0x88, 0x21, 0x38, 0x14, 0xa1, 0x77,
+	0xb6, 0x42, 0x11, 0xb7, 0x76, 0x2f, 0x48, 0xdc, 0x5c, 0x24, 0x4d, 0xce, 0x6b, 0xd0, 0xe4, 0x4a,
+	0xde, 0xa5, 0xb4, 0x83, 0x8b, 0x6e, 0x4e, 0x7e, 0x14, 0x87, 0xae, 0xe2, 0x2a, 0x4e, 0xc5, 0xc9,
+	0x51, 0xda, 0xc1, 0xaf, 0x21, 0x6d, 0x12, 0x68, 0xb1, 0x11, 0x3a, 0x3a, 0x85, 0x70, 0xbf, 0xbf,
+	0xef, 0xf1, 0xf0, 0x81, 0x23, 0xc0, 0x17, 0x40, 0xc1, 0xb7, 0x43, 0x69, 0x3b, 0x8e, 0x88, 0x02,
+	0x49, 0x07, 0x75, 0x2a, 0x87, 0xa4, 0x1f, 0x0a, 0x29, 0x94, 0x72, 0x0c, 0x20, 0xcb, 0x00, 0x32,
+	0xa8, 0x6b, 0x15, 0x2e, 0x04, 0xbf, 0x65, 0x74, 0x81, 0xea, 0x46, 0xd7, 0xd4, 0x0e, 0x46, 0x31,
+	0x45, 0xab, 0xc4, 0x94, 0xab, 0xc5, 0x1f, 0x4d, 0xf8, 0xf1, 0xd3, 0x7e, 0x62, 0xe7, 0x03, 0x9f,
+	0xbb, 0xf8, 0xc0, 0xe3, 0x07, 0xf3, 0x15, 0x61, 0xb5, 0x03, 0xdc, 0x62, 0xdc, 0x03, 0xc9, 0xc2,
+	0x56, 0x24, 0x7b, 0x2c, 0x90, 0x9e, 0x63, 0x4b, 0x11, 0x2a, 0xc7, 0xb8, 0x00, 0x2c, 0x70, 0x59,
+	0xa8, 0x22, 0x03, 0x55, 0xb7, 0xdb, 0xea, 0xc7, 0xb8, 0x56, 0x4a, 0x74, 0x5b, 0xae, 0x1b, 0x32,
+	0x80, 0x0b, 0x19, 0x7a, 0x01, 0xb7, 0x12, 0x9c, 0xe2, 0xe2, 0x1d, 0x7b, 0x59, 0x42, 0xcd, 0x1b,
+	0xa8, 0x5a, 0x6c, 0x94, 0x48, 0x9c, 0x9a, 0xa4, 0xa9, 0x49, 0x2b, 0x18, 0xb5, 0xab, 0xef, 0xe3,
+	0xda, 0xe1, 0xfa, 0x9a, 0x64, 0x25, 0x88, 0xb5, 0x2a, 0x7a, 0x56, 0xbc, 0xff, 0x7e, 0x3e, 0x4a,
+	0x2c, 0x4d, 0x13, 0x1b, 0x59, 0x05, 0x2c, 0x06, 0x7d, 0x11, 0x00, 0x33, 0x5f, 0x10, 0x2e, 0xcf,
+	0x41, 0x42, 0xda, 0x92, 0xfd, 0xc3, 0x8e, 0x06, 0xd6, 0xd7, 0xc7, 0x4f, 0x1b, 0x36, 0x1e, 0xf3,
+	0x78, 0xab, 0x03, 0x5c, 0x79, 0x40, 0x78, 0x2f, 0x63, 0x99, 0x24, 0x23, 0x46, 0xd6, 0xf4, 0xb4,
+	0xd3, 0x4d, 0x19, 0x69, 0x1a, 0xe5, 0x0e, 0xef, 0xae, 0x9b, 0x35, 0xf9, 0x4b, 0xf0, 0x37, 0x5e,
+	0x6b, 0x6e, 0x86, 0x4f, 0xed, 0xdb, 0xe7, 0x6f, 0x53, 0x1d, 0x4d, 0xa6, 0x3a, 0xfa, 0x9a, 0xea,
+	0xe8, 0x69, 0xa6, 0xe7, 0x26, 0x33, 0x3d, 0xf7, 0x39, 0xd3, 0x73, 0x97, 0x4d, 0xee, 0xc9, 0x5e,
+	0xd4, 0x25, 0x8e, 0xf0, 0x93, 0x03, 0x49, 0x3e, 0x35, 0x70, 0x6f, 0xe8, 0x90, 0xce, 0x77, 0xb0,
+	0x7a, 0x93, 0x72, 0xd4, 0x67, 0xd0, 0x2d, 0x2c, 0x96, 0x7a, 0xf2, 0x33, 0x00, 0x7d, 0x40, 0x76,
+	0x1e, 0xb7, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAuthenticator converts the base account of the sender into a smart
+	// account authenticated by the given authenticator.
+	RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of the smart account of the
+	// sender.
+	RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterAuthenticator(ctx context.Context, in *MsgRegisterAuthenticator, opts ...grpc.CallOption) (*MsgRegisterAuthenticatorResponse, error) {
+	out := new(MsgRegisterAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.smartaccount.v1.Msg/RegisterAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateAuthenticator(ctx context.Context, in *MsgRotateAuthenticator, opts ...grpc.CallOption) (*MsgRotateAuthenticatorResponse, error) {
+	out := new(MsgRotateAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.smartaccount.v1.Msg/RotateAuthenticator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAuthenticator converts the base account of the sender into a smart
+	// account authenticated by the given authenticator.
+	RegisterAuthenticator(context.Context, *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error)
+	// RotateAuthenticator replaces the authenticator of the smart account of the
+	// sender.
+	RotateAuthenticator(context.Context, *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAuthenticator(ctx context.Context, req *MsgRegisterAuthenticator) (*MsgRegisterAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuthenticator not implemented")
+}
+func (*UnimplementedMsgServer) RotateAuthenticator(ctx context.Context, req *MsgRotateAuthenticator) (*MsgRotateAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAuthenticator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.smartaccount.v1.Msg/RegisterAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAuthenticator(ctx, req.(*MsgRegisterAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.smartaccount.v1.Msg/RotateAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateAuthenticator(ctx, req.(*MsgRotateAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.smartaccount.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAuthenticator",
+			Handler:    _Msg_RegisterAuthenticator_Handler,
+		},
+		{
+			MethodName: "RotateAuthenticator",
+			Handler:    _Msg_RotateAuthenticator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/smartaccount/v1/tx.proto",
+}
+
+func (m *MsgRegisterAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authenticator != nil {
+		{
+			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Authenticator != nil {
+		{
+			size, err := m.Authenticator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Authenticator != nil {
+		l = m.Authenticator.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Authenticator != nil {
+		l = m.Authenticator.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticator == nil {
+				m.Authenticator = &types.Any{}
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateAuthenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateAuthenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authenticator == nil {
+				m.Authenticator = &types.Any{}
+			}
+			if err := m.Authenticator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateAuthenticatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateAuthenticatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
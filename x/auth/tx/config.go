@@ -3,6 +3,8 @@ package tx
 import (
 	"fmt"
 
+	"cosmossdk.io/tx/textual/valuerenderer"
+
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,9 +26,16 @@ type config struct {
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
-// is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
+// is not supported by default (eg: SignMode_SIGN_MODE_TEXTUAL, see NewTxConfigWithTextual).
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, nil))
+}
+
+// NewTxConfigWithTextual is like NewTxConfig, with a Textual used by the
+// SIGN_MODE_TEXTUAL sign mode handler, which is supported if enabled.
+// See NewTextual to create a Textual resolving the SDK messages.
+func NewTxConfigWithTextual(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, textual *valuerenderer.Textual) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, textual))
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
@@ -0,0 +1,46 @@
+package tx
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// eip191MessagePrefix is the prefix of the messages signed with EIP-191
+// personal_sign, followed by the length of the message.
+const eip191MessagePrefix = "\x19Ethereum Signed Message:\n"
+
+var _ signing.SignModeHandler = signModeEIP191Handler{}
+
+// signModeEIP191Handler defines the SIGN_MODE_EIP_191 SignModeHandler. Its
+// sign bytes are the SIGN_MODE_LEGACY_AMINO_JSON sign bytes, prefixed as done
+// by the personal_sign method of Ethereum wallets, so that the signatures of
+// such wallets over the amino JSON of a tx can be verified with the
+// eth_secp256k1 keys.
+type signModeEIP191Handler struct {
+	signModeLegacyAminoJSONHandler
+}
+
+func (s signModeEIP191Handler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_EIP_191
+}
+
+func (s signModeEIP191Handler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}
+}
+
+func (s signModeEIP191Handler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP_191 {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_191, mode)
+	}
+
+	aminoJSONBz, err := s.signModeLegacyAminoJSONHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(eip191MessagePrefix+strconv.Itoa(len(aminoJSONBz))), aminoJSONBz...), nil
+}
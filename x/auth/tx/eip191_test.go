@@ -0,0 +1,105 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestEIP191Handler_GetSignBytes(t *testing.T) {
+	var (
+		chainID        = "test-chain"
+		accNum  uint64 = 7
+		seqNum  uint64 = 7
+	)
+
+	bldr := newBuilder(nil)
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{
+		Address:       addr1.String(),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seqNum,
+		PubKey:        pubkey1,
+	}
+
+	handler := signModeEIP191Handler{}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.NoError(t, err)
+
+	aminoJSONBz := legacytx.StdSignBytes(chainID, accNum, seqNum, timeout, legacytx.StdFee{Amount: coins, Gas: gas}, []sdk.Msg{msg}, memo, nil)
+	expectedSignBz := []byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(aminoJSONBz), aminoJSONBz))
+	require.Equal(t, expectedSignBz, signBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with the errors of the legacy amino JSON handler
+	signingData.Address = ""
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signingData, tx)
+	require.Error(t, err)
+}
+
+func TestEIP191Handler_Modes(t *testing.T) {
+	handler := signModeEIP191Handler{}
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_EIP_191, handler.DefaultMode())
+	require.Equal(t, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_191}, handler.Modes())
+}
+
+func TestEIP191Handler_VerifySignature(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), DefaultSignModes)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(coins)
+	txBuilder.SetGasLimit(gas)
+
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_191},
+		Sequence: 1,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      1,
+		PubKey:        pubKey,
+	}
+	modeHandler := txConfig.SignModeHandler()
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_191, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the signature of personal_sign, with V = 27 + recovery id
+	signature, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	signature[len(signature)-1] += 27
+	sig.Data = &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_191, Signature: signature}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignature(context.Background(), pubKey, signerData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	signerData.Sequence++
+	require.Error(t, signing.VerifySignature(context.Background(), pubKey, signerData, sig.Data, modeHandler, txBuilder.GetTx()))
+}
@@ -3,6 +3,8 @@ package tx
 import (
 	"fmt"
 
+	"cosmossdk.io/tx/textual/valuerenderer"
+
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -12,11 +14,13 @@ var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_EIP_191,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON,
+// SIGN_MODE_EIP_191 and SIGN_MODE_TEXTUAL. The latter requires a non-nil textual.
+func makeSignModeHandler(modes []signingtypes.SignMode, textual *valuerenderer.Textual) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -31,6 +35,13 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeLegacyAminoJSONHandler{}
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
 			handlers[i] = signModeDirectAuxHandler{}
+		case signingtypes.SignMode_SIGN_MODE_EIP_191:
+			handlers[i] = signModeEIP191Handler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			if textual == nil {
+				panic(fmt.Errorf("%s requires a Textual, use NewTxConfigWithTextual", mode))
+			}
+			handlers[i] = signModeTextualHandler{t: textual}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
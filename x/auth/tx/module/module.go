@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -33,6 +34,8 @@ type txInputs struct {
 	AccountKeeper  ante.AccountKeeper    `optional:"true"`
 	BankKeeper     authtypes.BankKeeper  `optional:"true"`
 	FeeGrantKeeper feegrantkeeper.Keeper `optional:"true"`
+	MsgFeeKeeper   ante.MsgFeeKeeper     `optional:"true"`
+	EpochingKeeper ante.EpochingKeeper   `optional:"true"`
 }
 
 type txOutputs struct {
@@ -45,6 +48,15 @@ type txOutputs struct {
 func provideModule(in txInputs) txOutputs {
 	txConfig := tx.NewTxConfig(in.ProtoCodecMarshaler, tx.DefaultSignModes)
 
+	// SIGN_MODE_TEXTUAL is enabled when the bank keeper can query the coin
+	// metadata rendered in its sign bytes.
+	if bk, ok := in.BankKeeper.(tx.BankKeeper); ok {
+		signModes := append([]signingtypes.SignMode{}, tx.DefaultSignModes...)
+		signModes = append(signModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+		textual := tx.NewTextual(tx.NewBankKeeperCoinMetadataQueryFn(bk))
+		txConfig = tx.NewTxConfigWithTextual(in.ProtoCodecMarshaler, signModes, textual)
+	}
+
 	baseAppOption := func(app *baseapp.BaseApp) {
 		// AnteHandlers
 		if !in.Config.SkipAnteHandler {
@@ -91,13 +103,19 @@ func newAnteHandler(txConfig client.TxConfig, in txInputs) (sdk.AnteHandler, err
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// unordered txs are enabled when the account keeper can record their hashes
+	unorderedTxKeeper, _ := in.AccountKeeper.(ante.UnorderedTxKeeper)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: unorderedTxKeeper,
+			MsgFeeKeeper:      in.MsgFeeKeeper,
+			EpochingKeeper:    in.EpochingKeeper,
 		},
 	)
 	if err != nil {
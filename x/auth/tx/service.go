@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"regexp"
@@ -12,6 +13,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -19,24 +23,37 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateWithStateDiffFn is the signature of the
+// Baseapp#SimulateWithStateDiff function.
+type baseAppSimulateWithStateDiffFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, []baseapp.StateChange, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx             client.Context
+	simulate              baseAppSimulateFn
+	simulateWithStateDiff baseAppSimulateWithStateDiffFn
+	interfaceRegistry     codectypes.InterfaceRegistry
 }
 
-// NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
+// NewTxServer creates a new Tx service server. simulateWithStateDiff may be
+// nil, in which case the simulations with state diff are not supported.
+func NewTxServer(
+	clientCtx client.Context,
+	simulate baseAppSimulateFn,
+	simulateWithStateDiff baseAppSimulateWithStateDiffFn,
+	interfaceRegistry codectypes.InterfaceRegistry,
+) txtypes.ServiceServer {
 	return txServer{
-		clientCtx:         clientCtx,
-		simulate:          simulate,
-		interfaceRegistry: interfaceRegistry,
+		clientCtx:             clientCtx,
+		simulate:              simulate,
+		simulateWithStateDiff: simulateWithStateDiff,
+		interfaceRegistry:     interfaceRegistry,
 	}
 }
 
@@ -127,6 +144,10 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
+	if req.StateDiff {
+		return s.simulateStateDiff(txBytes)
+	}
+
 	gasInfo, result, err := s.simulate(txBytes)
 	if err != nil {
 		return nil, err
@@ -138,6 +159,111 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// simulateStateDiff simulates a tx, and returns its state changes along with
+// the balance changes decoded from them.
+func (s txServer) simulateStateDiff(txBytes []byte) (*txtypes.SimulateResponse, error) {
+	if s.simulateWithStateDiff == nil {
+		return nil, status.Error(codes.Unimplemented, "simulations with state diff are not supported")
+	}
+
+	gasInfo, result, changes, err := s.simulateWithStateDiff(txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	stateDiff := make([]*txtypes.StateChange, len(changes))
+	for i, change := range changes {
+		stateDiff[i] = &txtypes.StateChange{
+			StoreKey: change.StoreKey,
+			Delete:   change.Delete,
+			Key:      change.Key,
+			Value:    change.Value,
+			OldValue: change.OldValue,
+		}
+	}
+
+	balanceChanges, err := DecodeBalanceChanges(changes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to decode balance changes: %v", err)
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo:        &gasInfo,
+		Result:         result,
+		StateDiff:      stateDiff,
+		BalanceChanges: balanceChanges,
+	}, nil
+}
+
+// DecodeBalanceChanges decodes the changes of the x/bank balances from the
+// state changes of a tx. The balance changes are returned in the order of the
+// first change of each address.
+func DecodeBalanceChanges(changes []baseapp.StateChange) ([]txtypes.BalanceChange, error) {
+	var balanceChanges []txtypes.BalanceChange
+	indexes := make(map[string]int)
+
+	for _, change := range changes {
+		if change.StoreKey != banktypes.StoreKey || !bytes.HasPrefix(change.Key, banktypes.BalancesPrefix) {
+			continue
+		}
+
+		addr, denom, err := banktypes.AddressAndDenomFromBalancesStore(change.Key[len(banktypes.BalancesPrefix):])
+		if err != nil {
+			return nil, err
+		}
+
+		oldAmount, err := unmarshalBalance(change.OldValue)
+		if err != nil {
+			return nil, err
+		}
+		newAmount := sdk.ZeroInt()
+		if !change.Delete {
+			if newAmount, err = unmarshalBalance(change.Value); err != nil {
+				return nil, err
+			}
+		}
+
+		delta := newAmount.Sub(oldAmount)
+		if delta.IsZero() {
+			continue
+		}
+
+		i, ok := indexes[addr.String()]
+		if !ok {
+			i = len(balanceChanges)
+			indexes[addr.String()] = i
+			balanceChanges = append(balanceChanges, txtypes.BalanceChange{
+				Address:  addr.String(),
+				Sent:     sdk.NewCoins(),
+				Received: sdk.NewCoins(),
+			})
+		}
+
+		if delta.IsPositive() {
+			balanceChanges[i].Received = balanceChanges[i].Received.Add(sdk.NewCoin(denom, delta))
+		} else {
+			balanceChanges[i].Sent = balanceChanges[i].Sent.Add(sdk.NewCoin(denom, delta.Neg()))
+		}
+	}
+
+	return balanceChanges, nil
+}
+
+// unmarshalBalance unmarshals an amount of the balances store, an empty value
+// being a zero amount.
+func unmarshalBalance(bz []byte) (math.Int, error) {
+	if len(bz) == 0 {
+		return sdk.ZeroInt(), nil
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return math.Int{}, err
+	}
+
+	return amount, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -257,11 +383,12 @@ func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
+	simulateWithStateDiffFn baseAppSimulateWithStateDiffFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, simulateWithStateDiffFn, interfaceRegistry),
 	)
 }
 
@@ -0,0 +1,87 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func balanceChange(t *testing.T, addr sdk.AccAddress, denom string, oldAmount, newAmount int64) baseapp.StateChange {
+	marshal := func(amount int64) []byte {
+		if amount == 0 {
+			return nil
+		}
+		bz, err := sdk.NewInt(amount).Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	return baseapp.StateChange{
+		StoreKVPair: storetypes.StoreKVPair{
+			StoreKey: banktypes.StoreKey,
+			Delete:   newAmount == 0,
+			Key:      banktypes.CreatePrefixedAccountStoreKey(addr, []byte(denom)),
+			Value:    marshal(newAmount),
+		},
+		OldValue: marshal(oldAmount),
+	}
+}
+
+func TestDecodeBalanceChanges(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	changes := []baseapp.StateChange{
+		balanceChange(t, addr1, "atom", 100, 40),
+		// the denom to address index is not a balance
+		{
+			StoreKVPair: storetypes.StoreKVPair{
+				StoreKey: banktypes.StoreKey,
+				Key:      banktypes.CreateDenomAddressPrefix("atom"),
+				Value:    []byte{0},
+			},
+		},
+		balanceChange(t, addr2, "atom", 0, 60),
+		balanceChange(t, addr1, "stake", 7, 0),
+		balanceChange(t, addr2, "stake", 5, 5),
+		// the writes of other stores are ignored
+		{
+			StoreKVPair: storetypes.StoreKVPair{
+				StoreKey: "acc",
+				Key:      append(banktypes.BalancesPrefix, 'x'),
+				Value:    []byte("y"),
+			},
+		},
+	}
+
+	balanceChanges, err := authtx.DecodeBalanceChanges(changes)
+	require.NoError(t, err)
+	require.Equal(t, []txtypes.BalanceChange{
+		{
+			Address:  addr1.String(),
+			Sent:     sdk.NewCoins(sdk.NewInt64Coin("atom", 60), sdk.NewInt64Coin("stake", 7)),
+			Received: sdk.NewCoins(),
+		},
+		{
+			Address:  addr2.String(),
+			Sent:     sdk.NewCoins(),
+			Received: sdk.NewCoins(sdk.NewInt64Coin("atom", 60)),
+		},
+	}, balanceChanges)
+
+	_, err = authtx.DecodeBalanceChanges([]baseapp.StateChange{{
+		StoreKVPair: storetypes.StoreKVPair{
+			StoreKey: banktypes.StoreKey,
+			Key:      banktypes.CreatePrefixedAccountStoreKey(addr1, []byte("atom")),
+			Value:    []byte("invalid"),
+		},
+	}})
+	require.Error(t, err)
+}
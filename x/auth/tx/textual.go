@@ -0,0 +1,281 @@
+package tx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/tx/textual/valuerenderer"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler
+type signModeTextualHandler struct {
+	t *valuerenderer.Textual
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes. The coin metadata is
+// queried with a background context, GetSignBytesWithContext should be used
+// instead when the sign bytes are computed on chain.
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	return h.GetSignBytesWithContext(context.Background(), mode, data, tx)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	signerData := valuerenderer.SignerData{
+		Address:       data.Address,
+		ChainID:       data.ChainID,
+		AccountNumber: data.AccountNumber,
+		Sequence:      data.Sequence,
+	}
+	if data.PubKey != nil {
+		pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		signerData.PubKey = &anypb.Any{TypeUrl: pkAny.TypeUrl, Value: pkAny.Value}
+	}
+
+	return h.t.GetSignBytes(ctx, protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), signerData)
+}
+
+// NewTextual returns a new Textual rendering coins with the metadata returned
+// by the given query function. Contrary to valuerenderer.NewTextual, the Anys
+// of the txs are also resolved against the types registered with gogoproto,
+// such as the SDK messages.
+func NewTextual(q valuerenderer.CoinMetadataQueryFn) *valuerenderer.Textual {
+	t := valuerenderer.NewTextual(q)
+	t.SetTypeResolver(newGogoTypeResolver())
+
+	return t
+}
+
+// BankKeeper defines the bank keeper methods used to query the coin metadata
+// rendered in SIGN_MODE_TEXTUAL.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a coin metadata query function
+// reading the metadata from state with the given bank keeper. It must be
+// called with a context wrapping an sdk.Context, as done by the signature
+// verification AnteHandler.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) valuerenderer.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		sdkCtx, ok := ctx.(sdk.Context)
+		if !ok {
+			return nil, fmt.Errorf("querying the coin metadata from state requires an sdk.Context, got %T", ctx)
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdkCtx, denom)
+		if !found {
+			return nil, nil
+		}
+
+		return toAPIMetadata(&metadata)
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a coin metadata query function querying
+// the metadata with the bank gRPC query service, for use in clients.
+func NewGRPCCoinMetadataQueryFn(grpcConn gogogrpc.ClientConn) valuerenderer.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := banktypes.NewQueryClient(grpcConn).DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return toAPIMetadata(&res.Metadata)
+	}
+}
+
+// toAPIMetadata converts the gogoproto bank metadata to its API counterpart.
+func toAPIMetadata(metadata *banktypes.Metadata) (*bankv1beta1.Metadata, error) {
+	bz, err := metadata.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &bankv1beta1.Metadata{}
+	if err := proto.Unmarshal(bz, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// gogoTypeResolver is a protoregistry.MessageTypeResolver resolving the types
+// of the global protobuf registry, and falling back to the types registered
+// with gogoproto. The latter are resolved as dynamic messages, built from the
+// file descriptors registered with gogoproto.
+type gogoTypeResolver struct {
+	mu    sync.Mutex
+	files *protoregistry.Files
+}
+
+var _ protoregistry.MessageTypeResolver = (*gogoTypeResolver)(nil)
+
+func newGogoTypeResolver() *gogoTypeResolver {
+	return &gogoTypeResolver{files: new(protoregistry.Files)}
+}
+
+// FindMessageByName implements protoregistry.MessageTypeResolver.
+func (r *gogoTypeResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(name); err == nil {
+		return mt, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	d, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		typ := gogoproto.MessageType(string(name))
+		if typ == nil {
+			return nil, protoregistry.NotFound
+		}
+
+		msg, ok := reflect.New(typ.Elem()).Interface().(interface{ Descriptor() ([]byte, []int) })
+		if !ok {
+			return nil, fmt.Errorf("gogoproto message %s has no file descriptor", name)
+		}
+
+		gzippedFd, _ := msg.Descriptor()
+		if err := r.registerFile(gzippedFd); err != nil {
+			return nil, err
+		}
+
+		d, err = r.files.FindDescriptorByName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return dynamicpb.NewMessageType(md), nil
+}
+
+// FindMessageByURL implements protoregistry.MessageTypeResolver.
+func (r *gogoTypeResolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+// registerFile registers the given gzipped file descriptor, along with its
+// dependencies which are not registered yet, neither globally nor in r.files.
+// Dependencies which are unknown to gogoproto are left unresolved, as they
+// only define options, such as the gogoproto ones.
+func (r *gogoTypeResolver) registerFile(gzippedFd []byte) error {
+	zr, err := gzip.NewReader(bytes.NewReader(gzippedFd))
+	if err != nil {
+		return err
+	}
+	bz, err := io.ReadAll(zr)
+	if err != nil {
+		return err
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fdp); err != nil {
+		return err
+	}
+
+	if _, err := r.FindFileByPath(fdp.GetName()); err == nil {
+		return nil
+	}
+
+	for _, dep := range fdp.Dependency {
+		if _, err := r.FindFileByPath(dep); err == nil {
+			continue
+		}
+
+		if gzippedDep := gogoproto.FileDescriptor(dep); gzippedDep != nil {
+			if err := r.registerFile(gzippedDep); err != nil {
+				return err
+			}
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, r)
+	if err != nil {
+		return err
+	}
+
+	return r.files.RegisterFile(fd)
+}
+
+// FindFileByPath implements protodesc.Resolver, looking up the global
+// registry first.
+func (r *gogoTypeResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := protoregistry.GlobalFiles.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+
+	return r.files.FindFileByPath(path)
+}
+
+// FindDescriptorByName implements protodesc.Resolver, looking up the global
+// registry first.
+func (r *gogoTypeResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+
+	return r.files.FindDescriptorByName(name)
+}
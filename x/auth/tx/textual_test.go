@@ -0,0 +1,125 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockBankKeeper map[string]banktypes.Metadata
+
+func (bk mockBankKeeper) GetDenomMetaData(_ sdk.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := bk[denom]
+	return metadata, found
+}
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	bk := mockBankKeeper{"uatom": banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		Base:       "uatom",
+		Display:    "atom",
+	}}
+	textual := NewTextual(NewBankKeeperCoinMetadataQueryFn(bk))
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, textual)
+	txBuilder := txConfig.NewTxBuilder()
+
+	accSeq := uint64(2)
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	txBuilder.SetGasLimit(20000)
+
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubkey,
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		Sequence: accSeq,
+	}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        pubkey,
+	}
+	ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+
+	signBytes, err := signing.GetSignBytesWithContext(ctx, modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the sign bytes render the gogoproto messages and the coin metadata
+	for _, text := range []string{"Chain id: test-chain", addr.String(), "sometestmemo", "/testdata.TestMsg", "Fees: 1.5 atom"} {
+		require.True(t, bytes.Contains(signBytes, []byte(text)), "sign bytes do not contain %q", text)
+	}
+
+	// the coin metadata cannot be queried from state without an sdk.Context
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify the signature")
+	signature, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	sig.Data = &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL, Signature: signature}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	require.NoError(t, signing.VerifySignature(ctx, pubkey, signerData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	signerData.Sequence++
+	require.Error(t, signing.VerifySignature(ctx, pubkey, signerData, sig.Data, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify that setting signature doesn't change sign bytes")
+	signerData.Sequence--
+	newSignBytes, err := signing.GetSignBytesWithContext(ctx, modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, newSignBytes)
+
+	t.Log("verify GetSignBytes with an unsupported mode")
+	_, err = signModeTextualHandler{t: textual}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualRequiresTextual(t *testing.T) {
+	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.Panics(t, func() {
+		NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	})
+}
+
+func TestGogoTypeResolver(t *testing.T) {
+	r := newGogoTypeResolver()
+
+	// types of the global registry are resolved as is
+	mt, err := r.FindMessageByURL("/cosmos.bank.v1beta1.Metadata")
+	require.NoError(t, err)
+	require.IsType(t, &bankv1beta1.Metadata{}, mt.New().Interface())
+
+	// gogoproto types are resolved as dynamic messages
+	mt, err = r.FindMessageByURL("/testdata.TestMsg")
+	require.NoError(t, err)
+	require.Equal(t, "testdata.TestMsg", string(mt.Descriptor().FullName()))
+	require.NotNil(t, mt.Descriptor().Fields().ByName("signers"))
+
+	_, err = r.FindMessageByURL("/testdata.Unknown")
+	require.Error(t, err)
+}
@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,6 +117,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// pub_key_rotation_gas_cost is the gas consumed by each rotation of the
+	// public key of an account.
+	PubKeyRotationGasCost uint64 `protobuf:"varint,6,opt,name=pub_key_rotation_gas_cost,json=pubKeyRotationGasCost,proto3" json:"pub_key_rotation_gas_cost,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,55 +189,153 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationGasCost() uint64 {
+	if m != nil {
+		return m.PubKeyRotationGasCost
+	}
+	return 0
+}
+
+// PubKeyRotation records the rotation of the public key of an account.
+type PubKeyRotation struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// old_pub_key is the public key replaced by the rotation.
+	OldPubKey *types.Any `protobuf:"bytes,2,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	// new_pub_key is the public key set by the rotation.
+	NewPubKey *types.Any `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotation) GetOldPubKey() *types.Any {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetNewPubKey() *types.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 609 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0x8e, 0xdb, 0xfc, 0xfa, 0xe7, 0xd2, 0x56, 0xea, 0x35, 0xbf, 0xe2, 0x66, 0xb0, 0xad, 0x4a,
-	0x48, 0x41, 0x22, 0x0e, 0x09, 0x2a, 0x12, 0xdd, 0xea, 0x82, 0x50, 0x05, 0x85, 0xca, 0x11, 0x0c,
-	0x2c, 0xd6, 0xd9, 0x79, 0xeb, 0x9e, 0x9a, 0xf3, 0x19, 0xdf, 0xb9, 0x8a, 0xfb, 0x09, 0x18, 0x19,
-	0x19, 0xfb, 0x01, 0x18, 0x3b, 0x33, 0xa3, 0x4e, 0x15, 0x13, 0x53, 0x84, 0xd2, 0x01, 0xc4, 0xa7,
-	0x40, 0xb9, 0x73, 0xaa, 0x16, 0x75, 0xf2, 0xbd, 0xcf, 0xf3, 0xdc, 0xf3, 0xfe, 0xf3, 0x21, 0x2b,
-	0xe2, 0x82, 0x71, 0xd1, 0x26, 0xb9, 0x3c, 0x6a, 0x9f, 0x74, 0x42, 0x90, 0xa4, 0xa3, 0x02, 0x37,
-	0xcd, 0xb8, 0xe4, 0x78, 0x4d, 0xf3, 0xae, 0x82, 0x4a, 0xbe, 0xb1, 0xa1, 0xc1, 0x40, 0x49, 0xda,
-	0xa5, 0x42, 0x05, 0x8d, 0x7a, 0xcc, 0x63, 0xae, 0xf1, 0xc9, 0xa9, 0x44, 0x37, 0x62, 0xce, 0xe3,
-	0x01, 0xb4, 0x55, 0x14, 0xe6, 0x87, 0x6d, 0x92, 0x14, 0x9a, 0xda, 0xfc, 0x65, 0xa0, 0x9a, 0x47,
-	0x04, 0xec, 0x44, 0x11, 0xcf, 0x13, 0x89, 0xbb, 0x68, 0x9e, 0xf4, 0xfb, 0x19, 0x08, 0x61, 0x1a,
-	0x8e, 0xd1, 0x5c, 0xf4, 0xcc, 0xef, 0xe7, 0xad, 0x7a, 0x99, 0x63, 0x47, 0x33, 0x3d, 0x99, 0xd1,
-	0x24, 0xf6, 0xa7, 0x42, 0xfc, 0x02, 0xcd, 0xa7, 0x79, 0x18, 0x1c, 0x43, 0x61, 0xce, 0x38, 0x46,
-	0xb3, 0xd6, 0xad, 0xbb, 0x3a, 0xa1, 0x3b, 0x4d, 0xe8, 0xee, 0x24, 0x85, 0x67, 0xfe, 0x19, 0xd9,
-	0xf5, 0x34, 0x0f, 0x07, 0x34, 0x9a, 0x68, 0x1f, 0x72, 0x46, 0x25, 0xb0, 0x54, 0x16, 0xfe, 0x5c,
-	0x9a, 0x87, 0x2f, 0xa1, 0xc0, 0xf7, 0xd1, 0x0a, 0xd1, 0x75, 0x04, 0x49, 0xce, 0x42, 0xc8, 0xcc,
-	0x59, 0xc7, 0x68, 0x56, 0xfd, 0xe5, 0x12, 0x7d, 0xad, 0x40, 0xdc, 0x40, 0x0b, 0x02, 0x3e, 0xe4,
-	0x90, 0x44, 0x60, 0x56, 0x95, 0xe0, 0x3a, 0xde, 0x36, 0x3f, 0x9e, 0xd9, 0x95, 0xcf, 0x67, 0x76,
-	0xe5, 0xf7, 0x99, 0x5d, 0xb9, 0x38, 0x6f, 0x2d, 0x94, 0x8d, 0xed, 0x6d, 0x7e, 0x31, 0xd0, 0xf2,
-	0x3e, 0xef, 0xe7, 0x83, 0xeb, 0x5e, 0xf7, 0xd0, 0x52, 0x48, 0x04, 0x04, 0xa5, 0xbb, 0x6a, 0xb8,
-	0xd6, 0x75, 0xdc, 0x3b, 0x66, 0xee, 0xde, 0x98, 0x91, 0x57, 0xbd, 0x1c, 0xd9, 0x86, 0x5f, 0x0b,
-	0x6f, 0x8c, 0x0d, 0xa3, 0x6a, 0x42, 0x18, 0xa8, 0xfe, 0x17, 0x7d, 0x75, 0xc6, 0x0e, 0xaa, 0xa5,
-	0x90, 0x31, 0x2a, 0x04, 0xe5, 0x89, 0x30, 0x67, 0x9d, 0xd9, 0xe6, 0xa2, 0x7f, 0x13, 0xda, 0x6e,
-	0x4c, 0x8b, 0xbd, 0x38, 0x6f, 0xad, 0xdc, 0xaa, 0x6d, 0x6f, 0xf3, 0xeb, 0x0c, 0x9a, 0x3b, 0x20,
-	0x19, 0x61, 0x02, 0xbb, 0x68, 0x8d, 0x91, 0x61, 0xc0, 0x80, 0xf1, 0x20, 0x3a, 0x22, 0x19, 0x89,
-	0x24, 0x64, 0x7a, 0x3f, 0x55, 0x7f, 0x95, 0x91, 0xe1, 0x3e, 0x30, 0xbe, 0x7b, 0x4d, 0x60, 0x07,
-	0x2d, 0xc9, 0x61, 0x20, 0x68, 0x1c, 0x0c, 0x28, 0xa3, 0x52, 0x15, 0x55, 0xf5, 0x91, 0x1c, 0xf6,
-	0x68, 0xfc, 0x6a, 0x82, 0xe0, 0x47, 0xe8, 0x7f, 0xa5, 0x38, 0x85, 0x20, 0xe2, 0x42, 0x06, 0x29,
-	0x64, 0x41, 0x58, 0x48, 0x28, 0xe7, 0xbd, 0x3a, 0x91, 0x9e, 0xc2, 0x2e, 0x17, 0xf2, 0x00, 0x32,
-	0xaf, 0x90, 0x80, 0xdf, 0xa0, 0x7b, 0x13, 0xc3, 0x13, 0xc8, 0xe8, 0x61, 0xa1, 0x2f, 0x41, 0xbf,
-	0xbb, 0xb5, 0xd5, 0x79, 0xaa, 0x57, 0xe0, 0x99, 0xe3, 0x91, 0x5d, 0xef, 0xd1, 0xf8, 0x9d, 0x52,
-	0x4c, 0xae, 0x3e, 0x7f, 0xa6, 0x78, 0xbf, 0x2e, 0x6e, 0xa1, 0xfa, 0x16, 0x7e, 0x8b, 0x36, 0xfe,
-	0x35, 0x14, 0x10, 0xa5, 0xdd, 0xad, 0x27, 0xc7, 0x1d, 0xf3, 0x3f, 0x65, 0xd9, 0x18, 0x8f, 0xec,
-	0xf5, 0x5b, 0x96, 0xbd, 0xa9, 0xc2, 0x5f, 0x17, 0x77, 0xe2, 0xdb, 0x0b, 0xe5, 0xee, 0x0d, 0x6f,
-	0xf7, 0xdb, 0xd8, 0x32, 0x2e, 0xc7, 0x96, 0xf1, 0x73, 0x6c, 0x19, 0x9f, 0xae, 0xac, 0xca, 0xe5,
-	0x95, 0x55, 0xf9, 0x71, 0x65, 0x55, 0xde, 0x3f, 0x88, 0xa9, 0x3c, 0xca, 0x43, 0x37, 0xe2, 0xac,
-	0x7c, 0x3d, 0xe5, 0xa7, 0x25, 0xfa, 0xc7, 0xed, 0xa1, 0x7e, 0x8c, 0xb2, 0x48, 0x41, 0x84, 0x73,
-	0xea, 0x0f, 0x7e, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x35, 0x25, 0xef, 0x58, 0xa8, 0x03, 0x00,
-	0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x31, 0x4f, 0xdb, 0x4c,
+	0x18, 0x8e, 0x49, 0xbe, 0x90, 0x5c, 0x00, 0x09, 0x13, 0xf8, 0x9c, 0x0c, 0x71, 0x14, 0xa9, 0x52,
+	0x2a, 0x35, 0x4e, 0x93, 0x8a, 0x8a, 0xb2, 0x61, 0x5a, 0x21, 0xd4, 0x42, 0x91, 0xd3, 0x76, 0xe8,
+	0x62, 0x9d, 0x9d, 0xc3, 0xb1, 0xc8, 0xf9, 0x5c, 0xdf, 0x19, 0x62, 0x7e, 0x41, 0x47, 0xc6, 0x8e,
+	0xfc, 0x80, 0x8e, 0xa8, 0xbf, 0x01, 0x31, 0xa1, 0x4e, 0x9d, 0xd2, 0x2a, 0x0c, 0xad, 0xfa, 0x0b,
+	0x3a, 0x56, 0x3e, 0x5f, 0x52, 0x42, 0x19, 0x2a, 0x26, 0xdf, 0xfb, 0x3c, 0xcf, 0x3d, 0xef, 0xfb,
+	0xde, 0x7b, 0x3e, 0x50, 0xb1, 0x09, 0xc5, 0x84, 0x36, 0x61, 0xc8, 0x7a, 0xcd, 0xc3, 0x96, 0x85,
+	0x18, 0x6c, 0xf1, 0x40, 0xf3, 0x03, 0xc2, 0x88, 0xbc, 0x94, 0xf0, 0x1a, 0x87, 0x04, 0x5f, 0x2e,
+	0x25, 0xa0, 0xc9, 0x25, 0x4d, 0xa1, 0xe0, 0x41, 0xb9, 0xe8, 0x10, 0x87, 0x24, 0x78, 0xbc, 0x12,
+	0x68, 0xc9, 0x21, 0xc4, 0xe9, 0xa3, 0x26, 0x8f, 0xac, 0x70, 0xbf, 0x09, 0xbd, 0x48, 0x50, 0xea,
+	0x4d, 0x8a, 0xb9, 0x18, 0x51, 0x06, 0xb1, 0x9f, 0x08, 0x6a, 0xdf, 0x25, 0x50, 0xd0, 0x21, 0x45,
+	0x1b, 0xb6, 0x4d, 0x42, 0x8f, 0xc9, 0x6d, 0x30, 0x0b, 0xbb, 0xdd, 0x00, 0x51, 0xaa, 0x48, 0x55,
+	0xa9, 0x9e, 0xd7, 0x95, 0xcf, 0x67, 0x8d, 0xa2, 0x28, 0x62, 0x23, 0x61, 0x3a, 0x2c, 0x70, 0x3d,
+	0xc7, 0x18, 0x0b, 0xe5, 0x2d, 0x30, 0xeb, 0x87, 0x96, 0x79, 0x80, 0x22, 0x65, 0xa6, 0x2a, 0xd5,
+	0x0b, 0xed, 0xa2, 0x96, 0xa4, 0xd5, 0xc6, 0x69, 0xb5, 0x0d, 0x2f, 0xd2, 0x95, 0x9f, 0x43, 0xb5,
+	0xe8, 0x87, 0x56, 0xdf, 0xb5, 0x63, 0xed, 0x03, 0x82, 0x5d, 0x86, 0xb0, 0xcf, 0x22, 0x23, 0xeb,
+	0x87, 0xd6, 0x73, 0x14, 0xc9, 0xf7, 0xc0, 0x02, 0x4c, 0xea, 0x30, 0xbd, 0x10, 0x5b, 0x28, 0x50,
+	0xd2, 0x55, 0xa9, 0x9e, 0x31, 0xe6, 0x05, 0xba, 0xcb, 0x41, 0xb9, 0x0c, 0x72, 0x14, 0xbd, 0x0b,
+	0x91, 0x67, 0x23, 0x25, 0xc3, 0x05, 0x93, 0x78, 0x5d, 0x79, 0x7f, 0xaa, 0xa6, 0x3e, 0x9c, 0xaa,
+	0xa9, 0x1f, 0xa7, 0x6a, 0xea, 0xe2, 0xac, 0x91, 0x13, 0x8d, 0x6d, 0xd7, 0x3e, 0x4a, 0x60, 0x7e,
+	0x87, 0x74, 0xc3, 0xfe, 0xa4, 0xd7, 0x6d, 0x30, 0x67, 0x41, 0x8a, 0x4c, 0xe1, 0xce, 0x1b, 0x2e,
+	0xb4, 0xab, 0xda, 0x2d, 0x43, 0xd1, 0xae, 0x9d, 0x91, 0x9e, 0xb9, 0x1c, 0xaa, 0x92, 0x51, 0xb0,
+	0xae, 0x1d, 0x9b, 0x0c, 0x32, 0x1e, 0xc4, 0x88, 0xf7, 0x9f, 0x37, 0xf8, 0x5a, 0xae, 0x82, 0x82,
+	0x8f, 0x02, 0xec, 0x52, 0xea, 0x12, 0x8f, 0x2a, 0xe9, 0x6a, 0xba, 0x9e, 0x37, 0xae, 0x43, 0xeb,
+	0xe5, 0x71, 0xb1, 0x17, 0x67, 0x8d, 0x85, 0xa9, 0xda, 0xb6, 0x6b, 0xbf, 0x66, 0x40, 0x76, 0x0f,
+	0x06, 0x10, 0x53, 0x59, 0x03, 0x4b, 0x18, 0x0e, 0x4c, 0x8c, 0x30, 0x31, 0xed, 0x1e, 0x0c, 0xa0,
+	0xcd, 0x50, 0x90, 0xcc, 0x27, 0x63, 0x2c, 0x62, 0x38, 0xd8, 0x41, 0x98, 0x6c, 0x4e, 0x08, 0xb9,
+	0x0a, 0xe6, 0xd8, 0xc0, 0xa4, 0xae, 0x63, 0xf6, 0x5d, 0xec, 0x32, 0x5e, 0x54, 0xc6, 0x00, 0x6c,
+	0xd0, 0x71, 0x9d, 0x17, 0x31, 0x22, 0x3f, 0x04, 0xcb, 0x5c, 0x71, 0x8c, 0x4c, 0x9b, 0x50, 0x66,
+	0xfa, 0x28, 0x30, 0xad, 0x88, 0x21, 0x71, 0xde, 0x8b, 0xb1, 0xf4, 0x18, 0x6d, 0x12, 0xca, 0xf6,
+	0x50, 0xa0, 0x47, 0x0c, 0xc9, 0x2f, 0xc1, 0xff, 0xb1, 0xe1, 0x21, 0x0a, 0xdc, 0xfd, 0x28, 0xd9,
+	0x84, 0xba, 0xed, 0xd5, 0xd5, 0xd6, 0x93, 0x64, 0x04, 0xba, 0x32, 0x1a, 0xaa, 0xc5, 0x8e, 0xeb,
+	0xbc, 0xe1, 0x8a, 0x78, 0xeb, 0xb3, 0xa7, 0x9c, 0x37, 0x8a, 0x74, 0x0a, 0x4d, 0x76, 0xc9, 0xaf,
+	0x41, 0xe9, 0xa6, 0x21, 0x45, 0xb6, 0xdf, 0x5e, 0x7d, 0x7c, 0xd0, 0x52, 0xfe, 0xe3, 0x96, 0xe5,
+	0xd1, 0x50, 0x5d, 0x99, 0xb2, 0xec, 0x8c, 0x15, 0xc6, 0x0a, 0xbd, 0x15, 0x97, 0xd7, 0x40, 0x49,
+	0xdc, 0x45, 0x33, 0x20, 0x0c, 0x32, 0x97, 0x78, 0xa6, 0x03, 0x29, 0x4f, 0xa0, 0x64, 0x79, 0x77,
+	0xcb, 0xc9, 0x6d, 0x33, 0x04, 0xbd, 0x05, 0x69, 0x6c, 0xb1, 0x9e, 0x13, 0xb7, 0x46, 0xaa, 0x7d,
+	0x9a, 0x01, 0x0b, 0x7b, 0x53, 0x9a, 0x3b, 0xfd, 0x16, 0xbb, 0xa0, 0x40, 0xfa, 0x5d, 0xf3, 0x9f,
+	0x7e, 0x8d, 0x8b, 0x3f, 0x6e, 0x76, 0x10, 0xf9, 0x8c, 0x68, 0xa2, 0x80, 0x3c, 0xe9, 0x77, 0x93,
+	0x65, 0xec, 0xe7, 0xa1, 0xa3, 0x89, 0x5f, 0xfa, 0x6e, 0x7e, 0x1e, 0x3a, 0x12, 0x7e, 0x2b, 0x20,
+	0xdb, 0x43, 0xae, 0xd3, 0x63, 0x7c, 0x82, 0x69, 0x43, 0x44, 0xf2, 0x1a, 0xc8, 0xc4, 0xaf, 0x04,
+	0x1f, 0x42, 0xa1, 0x5d, 0xfe, 0x2b, 0xc1, 0xab, 0xf1, 0x13, 0xa2, 0xe7, 0xce, 0x87, 0x6a, 0xea,
+	0xe4, 0xab, 0x2a, 0x19, 0x7c, 0x87, 0xbe, 0x79, 0x3e, 0xaa, 0x48, 0x97, 0xa3, 0x8a, 0xf4, 0x6d,
+	0x54, 0x91, 0x4e, 0xae, 0x2a, 0xa9, 0xcb, 0xab, 0x4a, 0xea, 0xcb, 0x55, 0x25, 0xf5, 0xf6, 0xbe,
+	0xe3, 0xb2, 0x5e, 0x68, 0x69, 0x36, 0xc1, 0xe2, 0x45, 0x13, 0x9f, 0x06, 0xed, 0x1e, 0x34, 0x07,
+	0xc9, 0x03, 0xc9, 0x22, 0x1f, 0x51, 0x2b, 0xcb, 0x13, 0x3d, 0xfa, 0x3d, 0x00, 0xb8, 0x47, 0x73,
+	0x6e, 0x3c, 0x05, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -267,6 +372,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyRotationGasCost != that1.PubKeyRotationGasCost {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -392,6 +500,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PubKeyRotationGasCost != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PubKeyRotationGasCost))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -420,6 +533,73 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldPubKey != nil {
+		{
+			size, err := m.OldPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -498,6 +678,35 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.PubKeyRotationGasCost != 0 {
+		n += 1 + sovAuth(uint64(m.PubKeyRotationGasCost))
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.OldPubKey != nil {
+		l = m.OldPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -937,6 +1146,231 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationGasCost", wireType)
+			}
+			m.PubKeyRotationGasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyRotationGasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldPubKey == nil {
+				m.OldPubKey = &types.Any{}
+			}
+			if err := m.OldPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
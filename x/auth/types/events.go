@@ -0,0 +1,10 @@
+package types
+
+// auth module event types
+const (
+	EventTypeRotatePubKey = "rotate_pub_key"
+
+	AttributeKeyAddress   = "address"
+	AttributeKeyOldPubKey = "old_pub_key"
+	AttributeKeyNewPubKey = "new_pub_key"
+)
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -38,6 +40,11 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, rotation := range g.PubKeyRotations {
+		if err := rotation.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -70,6 +77,38 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	lastRotations := make(map[string]PubKeyRotation)
+	for _, rotation := range data.PubKeyRotations {
+		if err := rotation.Validate(); err != nil {
+			return err
+		}
+		lastRotations[rotation.Address] = rotation
+	}
+
+	// the public key of an account does not match its address once rotated, so
+	// the accounts whose public key is the last one they were rotated to are
+	// validated without it
+	for i, acc := range genAccs {
+		pubKey := acc.GetPubKey()
+		if pubKey == nil || bytes.Equal(pubKey.Address(), acc.GetAddress()) {
+			continue
+		}
+
+		rotation, ok := lastRotations[acc.GetAddress().String()]
+		if !ok || !pubKey.Equals(rotation.NewPubKey.GetCachedValue().(cryptotypes.PubKey)) {
+			continue
+		}
+
+		msg, ok := acc.(proto.Message)
+		if !ok {
+			return fmt.Errorf("cannot proto clone %T", acc)
+		}
+		genAccs[i] = proto.Clone(msg).(GenesisAccount)
+		if err := genAccs[i].SetPubKey(nil); err != nil {
+			return err
+		}
+	}
+
 	return ValidateGenAccounts(genAccs)
 }
 
@@ -30,6 +30,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// pub_key_rotations are the rotations of the public keys of the accounts, in
+	// the order they happened for each account.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,3,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +82,13 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -86,23 +96,26 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x8a, 0x2a, 0x94, 0x22, 0x21, 0x42, 0x87, 0x52, 0x24, 0x53, 0x60, 0x29, 0x03,
+	0x36, 0x2d, 0x13, 0x23, 0x65, 0x60, 0x60, 0x41, 0x45, 0x2c, 0x2c, 0x95, 0x1d, 0x8c, 0x1b, 0x95,
+	0xe4, 0xa2, 0xd8, 0x46, 0xe4, 0x2d, 0x78, 0xac, 0x8e, 0x15, 0x13, 0x13, 0x42, 0xc9, 0x8b, 0xa0,
+	0xda, 0x06, 0x09, 0x29, 0x93, 0x4f, 0xe7, 0xef, 0xee, 0xff, 0x74, 0xe1, 0x51, 0x0c, 0x2a, 0x05,
+	0x45, 0x99, 0xd1, 0x73, 0xfa, 0x3a, 0xe2, 0x42, 0xb3, 0x11, 0x95, 0x22, 0x13, 0x2a, 0x51, 0x24,
+	0x2f, 0x40, 0x43, 0xb4, 0xe7, 0x10, 0xb2, 0x46, 0x88, 0x47, 0xfa, 0xfb, 0x12, 0x40, 0xbe, 0x08,
+	0x6a, 0x11, 0x6e, 0x9e, 0x29, 0xcb, 0x4a, 0xc7, 0xf7, 0xbb, 0x12, 0x24, 0xd8, 0x92, 0xae, 0x2b,
+	0xdf, 0xc5, 0x4d, 0x41, 0x76, 0xa5, 0xfd, 0x3f, 0xfe, 0x40, 0xe1, 0xf6, 0x8d, 0xcb, 0xbd, 0xd7,
+	0x4c, 0x8b, 0xe8, 0x32, 0x6c, 0xe7, 0xac, 0x60, 0xa9, 0xea, 0xa1, 0x01, 0x1a, 0x76, 0xc6, 0x07,
+	0xa4, 0xc1, 0x83, 0xdc, 0x59, 0x64, 0xb2, 0xb9, 0xfc, 0x3a, 0x0c, 0xa6, 0x7e, 0x20, 0x3a, 0x0f,
+	0xb7, 0x58, 0x1c, 0x83, 0xc9, 0xb4, 0xea, 0x6d, 0x0c, 0x5a, 0xc3, 0xce, 0xb8, 0x4b, 0x9c, 0x2f,
+	0xf9, 0xf5, 0x25, 0x57, 0x59, 0x39, 0xfd, 0xa3, 0xa2, 0x87, 0x70, 0x37, 0x37, 0x7c, 0xb6, 0x10,
+	0xe5, 0xac, 0x00, 0xcd, 0x74, 0x02, 0x99, 0xea, 0xb5, 0xec, 0xe8, 0x49, 0x73, 0xae, 0xe1, 0xb7,
+	0xa2, 0x9c, 0x7a, 0xd6, 0xe7, 0xef, 0xe4, 0xff, 0xba, 0x6a, 0x72, 0xbd, 0xac, 0x30, 0x5a, 0x55,
+	0x18, 0x7d, 0x57, 0x18, 0xbd, 0xd7, 0x38, 0x58, 0xd5, 0x38, 0xf8, 0xac, 0x71, 0xf0, 0x78, 0x2a,
+	0x13, 0x3d, 0x37, 0x9c, 0xc4, 0x90, 0x52, 0x7f, 0x19, 0xf7, 0x9c, 0xa9, 0xa7, 0x05, 0x7d, 0x73,
+	0x67, 0xd2, 0x65, 0x2e, 0x14, 0x6f, 0x5b, 0xe7, 0x8b, 0x9f, 0x01, 0x00, 0xd9, 0xf8, 0xf3, 0x42,
+	0xab, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +138,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +204,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +315,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
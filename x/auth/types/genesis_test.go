@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +12,8 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/testutil"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -53,6 +56,41 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisRotatedPubKeys(t *testing.T) {
+	oldPubKey, newPubKey := secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	rotation, err := types.NewPubKeyRotation(addr, oldPubKey, newPubKey, 1, time.Now().UTC())
+	require.NoError(t, err)
+	otherRotation, err := types.NewPubKeyRotation(addr, newPubKey, secp256k1.GenPrivKey().PubKey(), 2, time.Now().UTC())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		pubKey    cryptotypes.PubKey
+		rotations []types.PubKeyRotation
+		expErr    bool
+	}{
+		{"public key of the address", oldPubKey, nil, false},
+		{"rotated public key", newPubKey, []types.PubKeyRotation{rotation}, false},
+		{"public key not rotated", newPubKey, nil, true},
+		{"public key rotated again", newPubKey, []types.PubKeyRotation{rotation, otherRotation}, true},
+		{"invalid rotation", newPubKey, []types.PubKeyRotation{{Address: addr.String()}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.NewGenesisState(types.DefaultParams(), types.GenesisAccounts{types.NewBaseAccount(addr, tc.pubKey, 0, 0)})
+			genState.PubKeyRotations = tc.rotations
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	var cdc codec.Codec
 	depinject.Inject(testutil.AppConfig, &cdc)
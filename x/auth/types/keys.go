@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -22,6 +23,14 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxsKeyPrefix prefix for the hashes of executed unordered txs,
+	// indexed by timeout height
+	UnorderedTxsKeyPrefix = []byte{0x02}
+
+	// PubKeyRotationsKeyPrefix prefix for the rotations of the public keys of
+	// the accounts, indexed by address
+	PubKeyRotationsKeyPrefix = []byte{0x03}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 
@@ -38,3 +47,27 @@ func AddressStoreKey(addr sdk.AccAddress) []byte {
 func AccountNumberStoreKey(accountNumber uint64) []byte {
 	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accountNumber)...)
 }
+
+// UnorderedTxKey returns the key of the executed unordered tx with the given
+// hash and timeout height.
+func UnorderedTxKey(txHash []byte, timeout uint64) []byte {
+	return append(UnorderedTxsByTimeoutKey(timeout), txHash...)
+}
+
+// UnorderedTxsByTimeoutKey returns the prefix of the executed unordered txs
+// with the given timeout height.
+func UnorderedTxsByTimeoutKey(timeout uint64) []byte {
+	return append(append([]byte{}, UnorderedTxsKeyPrefix...), sdk.Uint64ToBigEndian(timeout)...)
+}
+
+// PubKeyRotationsKey returns the prefix of the rotations of the public key of
+// the account with the given address.
+func PubKeyRotationsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, PubKeyRotationsKeyPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// PubKeyRotationKey returns the key of the rotation with the given index of the
+// public key of the account with the given address.
+func PubKeyRotationKey(addr sdk.AccAddress, index uint64) []byte {
+	return append(PubKeyRotationsKey(addr), sdk.Uint64ToBigEndian(index)...)
+}
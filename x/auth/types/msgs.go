@@ -1,11 +1,17 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg                       = &MsgUpdateParams{}
+	_ sdk.Msg                       = &MsgRotatePubKey{}
+	_ types.UnpackInterfacesMessage = &MsgRotatePubKey{}
+)
 
 // GetSignBytes implements the LegacyMsg interface.
 func (msg MsgUpdateParams) GetSignBytes() []byte {
@@ -30,3 +36,66 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgRotatePubKey returns a new MsgRotatePubKey replacing the public key of
+// the account with the given address.
+func NewMsgRotatePubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	pkAny, err := types.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:   addr.String(),
+		NewPubKey: pkAny,
+	}, nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgRotatePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgRotatePubKey message.
+func (msg *MsgRotatePubKey) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgRotatePubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "invalid address")
+	}
+
+	if _, err := msg.GetNewPubKeyI(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetNewPubKeyI returns the unpacked new public key of the account.
+func (msg *MsgRotatePubKey) GetNewPubKeyI() (cryptotypes.PubKey, error) {
+	return unpackPubKey(msg.NewPubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
+
+// unpackPubKey returns the public key packed into the given Any.
+func unpackPubKey(pkAny *types.Any) (cryptotypes.PubKey, error) {
+	if pkAny == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	pubKey, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected %T, got %T", (cryptotypes.PubKey)(nil), pkAny.GetCachedValue())
+	}
+
+	return pubKey, nil
+}
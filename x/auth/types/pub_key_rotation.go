@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.UnpackInterfacesMessage = PubKeyRotation{}
+
+// NewPubKeyRotation returns a new PubKeyRotation of the public key of the
+// account with the given address.
+func NewPubKeyRotation(addr sdk.AccAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, t time.Time) (PubKeyRotation, error) {
+	oldAny, err := types.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+	newAny, err := types.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return PubKeyRotation{}, err
+	}
+
+	return PubKeyRotation{
+		Address:   addr.String(),
+		OldPubKey: oldAny,
+		NewPubKey: newAny,
+		Height:    height,
+		Time:      t,
+	}, nil
+}
+
+// Validate checks that the address and the public keys of the rotation are
+// valid.
+func (r PubKeyRotation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return fmt.Errorf("invalid address of public key rotation: %w", err)
+	}
+	if _, err := unpackPubKey(r.OldPubKey); err != nil {
+		return fmt.Errorf("invalid old public key of public key rotation of %s: %w", r.Address, err)
+	}
+	if _, err := unpackPubKey(r.NewPubKey); err != nil {
+		return fmt.Errorf("invalid new public key of public key rotation of %s: %w", r.Address, err)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &pubKey); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(r.NewPubKey, &pubKey)
+}
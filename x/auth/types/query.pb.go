@@ -755,6 +755,119 @@ func (m *QueryAccountAddressByIDResponse) GetAccountAddress() string {
 	return ""
 }
 
+// QueryPubKeyRotationsRequest is the request type for the Query/PubKeyRotations
+// RPC method.
+type QueryPubKeyRotationsRequest struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsRequest) Reset()         { *m = QueryPubKeyRotationsRequest{} }
+func (m *QueryPubKeyRotationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsRequest) ProtoMessage()    {}
+func (*QueryPubKeyRotationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{16}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsRequest proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPubKeyRotationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPubKeyRotationsResponse is the response type for the
+// Query/PubKeyRotations RPC method.
+type QueryPubKeyRotationsResponse struct {
+	// rotations are the rotations of the public key of the account, from the
+	// oldest to the newest.
+	Rotations []PubKeyRotation `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPubKeyRotationsResponse) Reset()         { *m = QueryPubKeyRotationsResponse{} }
+func (m *QueryPubKeyRotationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPubKeyRotationsResponse) ProtoMessage()    {}
+func (*QueryPubKeyRotationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{17}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPubKeyRotationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.Merge(m, src)
+}
+func (m *QueryPubKeyRotationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPubKeyRotationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPubKeyRotationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPubKeyRotationsResponse proto.InternalMessageInfo
+
+func (m *QueryPubKeyRotationsResponse) GetRotations() []PubKeyRotation {
+	if m != nil {
+		return m.Rotations
+	}
+	return nil
+}
+
+func (m *QueryPubKeyRotationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -772,68 +885,75 @@ func init() {
 	proto.RegisterType((*AddressStringToBytesResponse)(nil), "cosmos.auth.v1beta1.AddressStringToBytesResponse")
 	proto.RegisterType((*QueryAccountAddressByIDRequest)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDRequest")
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
+	proto.RegisterType((*QueryPubKeyRotationsRequest)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsRequest")
+	proto.RegisterType((*QueryPubKeyRotationsResponse)(nil), "cosmos.auth.v1beta1.QueryPubKeyRotationsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4e, 0xeb, 0x46,
-	0x14, 0xc6, 0xe3, 0xdc, 0x16, 0xb8, 0x73, 0x73, 0x53, 0x69, 0xc8, 0x95, 0xa8, 0xc3, 0x75, 0xae,
-	0x4c, 0x81, 0x84, 0x12, 0x1b, 0x12, 0x36, 0xfd, 0xa3, 0x4a, 0x09, 0xb4, 0x15, 0x8b, 0x4a, 0xa9,
-	0x61, 0xd5, 0x45, 0x23, 0x3b, 0x36, 0xc6, 0x2a, 0xf1, 0x84, 0x8c, 0x53, 0x11, 0xa1, 0x48, 0x55,
-	0x57, 0xec, 0x5a, 0xa9, 0x2f, 0x40, 0xdf, 0xa0, 0x95, 0x78, 0x08, 0xc4, 0x0a, 0xb5, 0x9b, 0xae,
-	0xaa, 0x0a, 0xba, 0xe8, 0xb2, 0x8f, 0x70, 0x95, 0x99, 0x63, 0x27, 0x86, 0x49, 0x62, 0x56, 0xb1,
-	0x67, 0xce, 0xf9, 0xce, 0x6f, 0xce, 0x19, 0x7f, 0x41, 0x85, 0x16, 0xa1, 0x6d, 0x42, 0x75, 0xb3,
-	0x17, 0x1c, 0xeb, 0xdf, 0x6f, 0x5b, 0x4e, 0x60, 0x6e, 0xeb, 0xa7, 0x3d, 0xa7, 0xdb, 0xd7, 0x3a,
-	0x5d, 0x12, 0x10, 0xbc, 0xc8, 0x03, 0xb4, 0x61, 0x80, 0x06, 0x01, 0xf2, 0x06, 0x64, 0x59, 0x26,
-	0x75, 0x78, 0x74, 0x94, 0xdb, 0x31, 0x5d, 0xcf, 0x37, 0x03, 0x8f, 0xf8, 0x5c, 0x40, 0xce, 0xb9,
-	0xc4, 0x25, 0xec, 0x51, 0x1f, 0x3e, 0xc1, 0xea, 0xfb, 0x2e, 0x21, 0xee, 0x89, 0xa3, 0xb3, 0x37,
-	0xab, 0x77, 0xa4, 0x9b, 0x3e, 0x54, 0x94, 0x97, 0x61, 0xcb, 0xec, 0x78, 0xba, 0xe9, 0xfb, 0x24,
-	0x60, 0x6a, 0x14, 0x76, 0x15, 0x11, 0x30, 0x83, 0x03, 0x61, 0xbe, 0xdf, 0xe4, 0x15, 0x01, 0x9e,
-	0xbd, 0xa8, 0xdf, 0xa2, 0xdc, 0xd7, 0x43, 0xd6, 0x5a, 0xab, 0x45, 0x7a, 0x7e, 0x40, 0x0d, 0xe7,
-	0xb4, 0xe7, 0xd0, 0x00, 0x7f, 0x81, 0xd0, 0x88, 0x7a, 0x49, 0x7a, 0x23, 0x15, 0x5f, 0x54, 0xd6,
-	0x34, 0x48, 0x1d, 0x1e, 0x51, 0xe3, 0x0d, 0x81, 0x6a, 0x5a, 0xc3, 0x74, 0x1d, 0xc8, 0x35, 0xc6,
-	0x32, 0xd5, 0x4b, 0x09, 0xbd, 0x7a, 0x50, 0x80, 0x76, 0x88, 0x4f, 0x1d, 0xfc, 0x19, 0x5a, 0x30,
-	0x61, 0x6d, 0x49, 0x7a, 0xf3, 0xac, 0xf8, 0xa2, 0x92, 0xd3, 0xf8, 0x29, 0xb5, 0xb0, 0x01, 0x5a,
-	0xcd, 0xef, 0xd7, 0x33, 0x37, 0x57, 0xe5, 0x05, 0xc8, 0xde, 0x37, 0xa2, 0x1c, 0xfc, 0x65, 0x8c,
-	0x30, 0xcd, 0x08, 0xd7, 0x67, 0x12, 0xf2, 0xe2, 0x31, 0xc4, 0x03, 0xb4, 0x38, 0x4e, 0x18, 0x76,
-	0xa0, 0x82, 0xe6, 0x4d, 0xdb, 0xee, 0x3a, 0x94, 0xb2, 0xe3, 0x3f, 0xaf, 0x2f, 0xfd, 0x71, 0x55,
-	0xce, 0x81, 0x7e, 0x8d, 0xef, 0x1c, 0x04, 0x5d, 0xcf, 0x77, 0x8d, 0x30, 0xf0, 0xe3, 0x85, 0x8b,
-	0xcb, 0x42, 0xea, 0xbf, 0xcb, 0x42, 0x4a, 0x5d, 0x46, 0x32, 0x13, 0xfd, 0x8a, 0xd8, 0xbd, 0x13,
-	0xe7, 0x41, 0x77, 0xd5, 0x06, 0x94, 0x6c, 0x98, 0x5d, 0xb3, 0x3d, 0x6a, 0xc9, 0x47, 0x68, 0xae,
-	0xc3, 0x56, 0xa0, 0xe1, 0x79, 0x4d, 0x70, 0xd1, 0x34, 0x9e, 0x54, 0x7f, 0xe7, 0xfa, 0xef, 0x42,
-	0xca, 0x80, 0x04, 0xf5, 0x30, 0x3e, 0xc7, 0x48, 0xf2, 0x53, 0x34, 0x0f, 0x1d, 0x03, 0xcd, 0x24,
-	0x4d, 0x0e, 0x53, 0xd4, 0x1c, 0xc2, 0x31, 0x4e, 0x4e, 0xdf, 0x42, 0x79, 0xe1, 0xd9, 0xa0, 0xe4,
-	0x5e, 0xc2, 0xc1, 0xe2, 0x9b, 0xab, 0x72, 0x36, 0xa6, 0x31, 0x36, 0x5e, 0xf5, 0x15, 0x5a, 0xac,
-	0x3b, 0xad, 0xe3, 0x6a, 0xa5, 0xd1, 0x75, 0x8e, 0xbc, 0xb3, 0xb0, 0xf6, 0x27, 0x28, 0x17, 0x5f,
-	0x86, 0xa2, 0x2b, 0xe8, 0xa5, 0xc5, 0xd6, 0x9b, 0x1d, 0xb6, 0xc1, 0x67, 0x66, 0x64, 0xac, 0xb1,
-	0x60, 0xb5, 0x8e, 0xf2, 0x30, 0xb8, 0x7a, 0x3f, 0x70, 0xe8, 0x21, 0x81, 0xf9, 0xc1, 0xc4, 0x57,
-	0xd0, 0x4b, 0x18, 0x64, 0xd3, 0x1a, 0xee, 0x33, 0x8d, 0x8c, 0x91, 0x31, 0xc7, 0x72, 0xd4, 0xcf,
-	0xd1, 0xb2, 0x58, 0x03, 0x40, 0x56, 0x51, 0x36, 0x14, 0xa1, 0x6c, 0x07, 0x48, 0x42, 0x69, 0x1e,
-	0xae, 0xee, 0x45, 0x28, 0x7c, 0xe1, 0x90, 0x30, 0xb9, 0x10, 0x25, 0xa1, 0xca, 0x6e, 0x04, 0xf3,
-	0x40, 0x65, 0xd4, 0x95, 0xd9, 0x27, 0xda, 0x42, 0xca, 0xf8, 0xd5, 0x89, 0x4e, 0xb7, 0xbf, 0x17,
-	0xd2, 0x64, 0x51, 0xda, 0xb3, 0x59, 0xee, 0x33, 0x23, 0xed, 0xd9, 0xaa, 0x8d, 0x0a, 0x13, 0x33,
-	0xa0, 0x72, 0x0d, 0xbd, 0x07, 0xa3, 0x6c, 0x26, 0xfd, 0x8a, 0xb2, 0x66, 0x4c, 0xae, 0xf2, 0xff,
-	0x73, 0xf4, 0x2e, 0x2b, 0x83, 0x2f, 0x24, 0x14, 0x5e, 0x4e, 0x8a, 0x4b, 0xc2, 0x8f, 0x42, 0x64,
-	0x62, 0xf2, 0x46, 0x92, 0x50, 0x0e, 0xac, 0xae, 0xfe, 0xf8, 0xe7, 0xbf, 0xbf, 0xa4, 0x0b, 0xf8,
-	0xb5, 0x2e, 0x34, 0xd3, 0xb0, 0xfa, 0x4f, 0x12, 0x9a, 0x87, 0x5c, 0x5c, 0x9c, 0x29, 0x1f, 0x82,
-	0x94, 0x12, 0x44, 0x02, 0x87, 0xce, 0x38, 0x4a, 0x78, 0x7d, 0x2a, 0x87, 0x7e, 0x0e, 0x5d, 0x1d,
-	0xe0, 0xdf, 0x24, 0x84, 0x1f, 0x0f, 0x02, 0x57, 0x67, 0x96, 0x7c, 0x3c, 0x68, 0x79, 0xe7, 0x69,
-	0x49, 0xc9, 0x90, 0xa3, 0x0b, 0xd8, 0xf4, 0x6c, 0xfd, 0xdc, 0xb3, 0x07, 0xf8, 0x07, 0x09, 0xcd,
-	0x71, 0x4b, 0xc1, 0xeb, 0x93, 0x2b, 0xc6, 0x4c, 0x47, 0x2e, 0xce, 0x0e, 0x04, 0x9c, 0x15, 0x86,
-	0xf3, 0x1a, 0xe7, 0x85, 0x38, 0xdc, 0x2f, 0xf1, 0xaf, 0x12, 0x8a, 0x7b, 0x0f, 0xc5, 0xfa, 0xe4,
-	0x0a, 0x42, 0x17, 0x97, 0xb7, 0x92, 0x27, 0x00, 0xda, 0x26, 0x43, 0x5b, 0xc3, 0x1f, 0x08, 0xd1,
-	0xda, 0x2c, 0xa9, 0x19, 0xdd, 0xb5, 0x0b, 0x09, 0x65, 0xc6, 0xcd, 0x6e, 0xc2, 0x85, 0x13, 0xd8,
-	0xe4, 0x84, 0x0b, 0x27, 0x72, 0xce, 0x19, 0xed, 0xe2, 0xfe, 0x39, 0xbc, 0x64, 0x39, 0x91, 0xed,
-	0x61, 0x71, 0x0f, 0xa6, 0xb8, 0xac, 0xbc, 0xfd, 0x84, 0x0c, 0x40, 0xac, 0x32, 0xc4, 0x32, 0xfe,
-	0x70, 0x0a, 0x62, 0xf4, 0x45, 0x70, 0xa7, 0x1b, 0xe0, 0xdf, 0x47, 0xc8, 0x31, 0x73, 0x9c, 0x8e,
-	0x2c, 0x72, 0xe3, 0xe9, 0xc8, 0x42, 0xe7, 0x55, 0x77, 0x18, 0xb2, 0x86, 0x37, 0x13, 0x21, 0x73,
-	0x8f, 0x1f, 0xd4, 0x77, 0xaf, 0xef, 0x14, 0xe9, 0xf6, 0x4e, 0x91, 0xfe, 0xb9, 0x53, 0xa4, 0x9f,
-	0xef, 0x95, 0xd4, 0xed, 0xbd, 0x92, 0xfa, 0xeb, 0x5e, 0x49, 0x7d, 0x53, 0x72, 0xbd, 0xe0, 0xb8,
-	0x67, 0x69, 0x2d, 0xd2, 0x0e, 0x15, 0xf9, 0x4f, 0x99, 0xda, 0xdf, 0xe9, 0x67, 0x5c, 0x3e, 0xe8,
-	0x77, 0x1c, 0x6a, 0xcd, 0xb1, 0x7f, 0xd9, 0xea, 0xdb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x78,
-	0xb2, 0xf3, 0xc7, 0x0a, 0x00, 0x00,
+	// 976 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x29, 0x24, 0xe9, 0x8b, 0x9b, 0x4a, 0x13, 0x57, 0x0a, 0x9b, 0xd4, 0xae, 0x36,
+	0xb4, 0x89, 0x4b, 0xbd, 0x5b, 0x3b, 0xbd, 0x94, 0x22, 0x24, 0xbb, 0xa1, 0x55, 0x85, 0x90, 0xcc,
+	0x36, 0x27, 0x0e, 0x58, 0xbb, 0xde, 0xa9, 0xb3, 0x6a, 0xb3, 0xb3, 0xf5, 0xec, 0xa2, 0x5a, 0x51,
+	0x24, 0xc4, 0x29, 0x37, 0x90, 0x38, 0x71, 0x0b, 0x77, 0x0e, 0x45, 0xca, 0x85, 0xff, 0xa0, 0xea,
+	0xa9, 0x82, 0x0b, 0x27, 0x84, 0x12, 0x0e, 0xfc, 0x19, 0xc8, 0x33, 0x6f, 0xd7, 0xde, 0x74, 0x62,
+	0x6f, 0x80, 0x53, 0xbc, 0x33, 0xef, 0xfb, 0x7d, 0x9f, 0x79, 0xf3, 0xe3, 0x05, 0x2a, 0x5d, 0xc6,
+	0x77, 0x19, 0xb7, 0x9c, 0x38, 0xda, 0xb1, 0xbe, 0xaa, 0xbb, 0x34, 0x72, 0xea, 0xd6, 0xf3, 0x98,
+	0xf6, 0x07, 0x66, 0xd8, 0x67, 0x11, 0x23, 0x4b, 0x32, 0xc0, 0x1c, 0x06, 0x98, 0x18, 0xa0, 0xdf,
+	0x44, 0x95, 0xeb, 0x70, 0x2a, 0xa3, 0x53, 0x6d, 0xe8, 0xf4, 0xfc, 0xc0, 0x89, 0x7c, 0x16, 0x48,
+	0x03, 0xbd, 0xd4, 0x63, 0x3d, 0x26, 0x7e, 0x5a, 0xc3, 0x5f, 0x38, 0xfa, 0x5e, 0x8f, 0xb1, 0xde,
+	0x33, 0x6a, 0x89, 0x2f, 0x37, 0x7e, 0x62, 0x39, 0x01, 0x66, 0xd4, 0x57, 0x71, 0xca, 0x09, 0x7d,
+	0xcb, 0x09, 0x02, 0x16, 0x09, 0x37, 0x8e, 0xb3, 0x65, 0x15, 0xb0, 0x80, 0x43, 0x63, 0x39, 0xdf,
+	0x91, 0x19, 0x11, 0x5e, 0x7c, 0x18, 0x5f, 0x42, 0xe9, 0xf3, 0x21, 0x6b, 0xb3, 0xdb, 0x65, 0x71,
+	0x10, 0x71, 0x9b, 0x3e, 0x8f, 0x29, 0x8f, 0xc8, 0x03, 0x80, 0x11, 0xf5, 0xb2, 0x76, 0x4d, 0xdb,
+	0x58, 0x68, 0xdc, 0x30, 0x51, 0x3a, 0x5c, 0xa2, 0x29, 0x0b, 0x82, 0xd9, 0xcc, 0xb6, 0xd3, 0xa3,
+	0xa8, 0xb5, 0xc7, 0x94, 0xc6, 0xa1, 0x06, 0x57, 0x4e, 0x25, 0xe0, 0x21, 0x0b, 0x38, 0x25, 0x1f,
+	0xc3, 0xbc, 0x83, 0x63, 0xcb, 0xda, 0xb5, 0x0b, 0x1b, 0x0b, 0x8d, 0x92, 0x29, 0x57, 0x69, 0x26,
+	0x05, 0x30, 0x9b, 0xc1, 0xa0, 0x55, 0x7c, 0x7d, 0x54, 0x9b, 0x47, 0xf5, 0x23, 0x3b, 0xd5, 0x90,
+	0x87, 0x19, 0xc2, 0x19, 0x41, 0xb8, 0x3e, 0x95, 0x50, 0x26, 0xcf, 0x20, 0x3e, 0x86, 0xa5, 0x71,
+	0xc2, 0xa4, 0x02, 0x0d, 0x98, 0x73, 0x3c, 0xaf, 0x4f, 0x39, 0x17, 0xcb, 0xbf, 0xd8, 0x5a, 0xfe,
+	0xf5, 0xa8, 0x56, 0x42, 0xff, 0xa6, 0x9c, 0x79, 0x1c, 0xf5, 0xfd, 0xa0, 0x67, 0x27, 0x81, 0x1f,
+	0xce, 0x1f, 0x1c, 0x56, 0x0a, 0x7f, 0x1f, 0x56, 0x0a, 0xc6, 0x2a, 0xe8, 0xc2, 0xf4, 0x33, 0xe6,
+	0xc5, 0xcf, 0xe8, 0xa9, 0xea, 0x1a, 0x6d, 0x4c, 0xd9, 0x76, 0xfa, 0xce, 0xee, 0xa8, 0x24, 0x77,
+	0x61, 0x36, 0x14, 0x23, 0x58, 0xf0, 0x15, 0x53, 0x71, 0xd0, 0x4c, 0x29, 0x6a, 0xbd, 0xf3, 0xea,
+	0x8f, 0x4a, 0xc1, 0x46, 0x81, 0xb1, 0x9d, 0xdd, 0xc7, 0xd4, 0xf2, 0x23, 0x98, 0xc3, 0x8a, 0xa1,
+	0x67, 0x9e, 0x22, 0x27, 0x12, 0xa3, 0x04, 0x24, 0xc3, 0x29, 0xe9, 0xbb, 0xb0, 0xa2, 0x5c, 0x1b,
+	0xa6, 0xdc, 0xca, 0xb9, 0xb1, 0xe4, 0xf5, 0x51, 0x6d, 0x31, 0xe3, 0x31, 0xb6, 0xbd, 0xc6, 0x15,
+	0x58, 0x6a, 0xd1, 0xee, 0xce, 0x66, 0xa3, 0xdd, 0xa7, 0x4f, 0xfc, 0x17, 0x49, 0xee, 0x7b, 0x50,
+	0xca, 0x0e, 0x63, 0xd2, 0x35, 0xb8, 0xe4, 0x8a, 0xf1, 0x4e, 0x28, 0x26, 0xe4, 0x9e, 0xd9, 0x45,
+	0x77, 0x2c, 0xd8, 0x68, 0xc1, 0x0a, 0x6e, 0x5c, 0x6b, 0x10, 0x51, 0xbe, 0xcd, 0x70, 0xff, 0x70,
+	0xc7, 0xd7, 0xe0, 0x12, 0x6e, 0x64, 0xc7, 0x1d, 0xce, 0x0b, 0x8f, 0xa2, 0x5d, 0x74, 0xc6, 0x34,
+	0xc6, 0x27, 0xb0, 0xaa, 0xf6, 0x40, 0x90, 0xeb, 0xb0, 0x98, 0x98, 0x70, 0x31, 0x83, 0x24, 0x89,
+	0xb5, 0x0c, 0x37, 0xb6, 0x52, 0x14, 0x39, 0xb0, 0xcd, 0x84, 0x5d, 0x82, 0x92, 0xd3, 0xe5, 0x7e,
+	0x0a, 0x73, 0xca, 0x65, 0x54, 0x95, 0xe9, 0x2b, 0xba, 0x0d, 0xe5, 0xf1, 0xa3, 0x93, 0xae, 0xee,
+	0xd1, 0x56, 0x42, 0xb3, 0x08, 0x33, 0xbe, 0x27, 0xb4, 0x17, 0xec, 0x19, 0xdf, 0x33, 0x3c, 0xa8,
+	0x9c, 0xa9, 0xc0, 0xcc, 0x4d, 0xb8, 0x8c, 0x5b, 0xd9, 0xc9, 0x7b, 0x8b, 0x16, 0x9d, 0x8c, 0x9d,
+	0xf1, 0x83, 0x86, 0xe7, 0xac, 0x1d, 0xbb, 0x9f, 0xd2, 0x81, 0x9d, 0x3c, 0x7a, 0xff, 0xe1, 0x82,
+	0x92, 0x07, 0x8a, 0x47, 0xe3, 0xdf, 0x3c, 0x6b, 0x2f, 0x35, 0x58, 0x55, 0xb3, 0xe1, 0xfa, 0x1f,
+	0xc2, 0xc5, 0x7e, 0x32, 0x88, 0xb7, 0x60, 0x4d, 0x7d, 0x9b, 0x33, 0x06, 0x78, 0xab, 0x47, 0xda,
+	0xff, 0xed, 0x99, 0x6b, 0xfc, 0xb4, 0x00, 0xef, 0x0a, 0x64, 0x72, 0xa0, 0x41, 0x72, 0xd7, 0x39,
+	0xa9, 0x2a, 0xa9, 0x54, 0x3d, 0x41, 0xbf, 0x99, 0x27, 0x54, 0x66, 0x36, 0xae, 0x7f, 0xf3, 0xdb,
+	0x5f, 0xdf, 0xcf, 0x54, 0xc8, 0x55, 0x4b, 0xd9, 0x9b, 0x92, 0xec, 0xdf, 0x6a, 0x30, 0x87, 0x5a,
+	0xb2, 0x31, 0xd5, 0x3e, 0x01, 0xa9, 0xe6, 0x88, 0x44, 0x0e, 0x4b, 0x70, 0x54, 0xc9, 0xfa, 0x44,
+	0x0e, 0x6b, 0x0f, 0x0f, 0xc8, 0x3e, 0x79, 0xa9, 0x01, 0x79, 0xfb, 0x5c, 0x93, 0xcd, 0xa9, 0x29,
+	0xdf, 0xbe, 0x37, 0xfa, 0x9d, 0xf3, 0x89, 0xf2, 0x21, 0xa7, 0xf7, 0xb9, 0xe3, 0x7b, 0xd6, 0x9e,
+	0xef, 0xed, 0x93, 0xaf, 0x35, 0x98, 0x95, 0x2f, 0x34, 0x59, 0x3f, 0x3b, 0x63, 0xe6, 0x0d, 0xd7,
+	0x37, 0xa6, 0x07, 0x22, 0xce, 0x9a, 0xc0, 0xb9, 0x4a, 0x56, 0x94, 0x38, 0xb2, 0xfd, 0x90, 0x1f,
+	0x35, 0xc8, 0x3e, 0xe5, 0x9c, 0x58, 0x67, 0x67, 0x50, 0x36, 0x45, 0xfd, 0x76, 0x7e, 0x01, 0xa2,
+	0xdd, 0x12, 0x68, 0x37, 0xc8, 0xfb, 0x4a, 0xb4, 0x5d, 0x21, 0xea, 0xa4, 0x67, 0xed, 0x40, 0x83,
+	0xe2, 0x78, 0xef, 0x38, 0xe3, 0xc0, 0x29, 0xba, 0x8e, 0x5e, 0xcd, 0x11, 0x99, 0xab, 0x5c, 0xb2,
+	0x1d, 0x0d, 0x0f, 0x59, 0x49, 0xd5, 0x45, 0x88, 0xba, 0x06, 0x13, 0x9a, 0x96, 0x5e, 0x3f, 0x87,
+	0x02, 0x11, 0x37, 0x05, 0x62, 0x8d, 0x7c, 0x30, 0x01, 0xd1, 0xda, 0xcb, 0x34, 0x8e, 0x7d, 0xf2,
+	0xf3, 0x08, 0x39, 0xd3, 0x6b, 0x26, 0x23, 0xab, 0x9a, 0x9b, 0x5e, 0x3f, 0x87, 0x02, 0x91, 0xef,
+	0x08, 0x64, 0x93, 0xdc, 0xca, 0x85, 0x2c, 0x5b, 0xe6, 0x3e, 0xf9, 0x45, 0x83, 0xcb, 0xa7, 0x1e,
+	0x68, 0x32, 0xe1, 0x94, 0xa9, 0xfb, 0x8c, 0x5e, 0x3f, 0x87, 0x02, 0x71, 0x9b, 0x02, 0xf7, 0x1e,
+	0xb9, 0x9b, 0xf3, 0xd5, 0xb1, 0xc2, 0xd8, 0xed, 0x3c, 0xa5, 0x83, 0x4e, 0xfa, 0xee, 0xb7, 0xee,
+	0xbf, 0x3a, 0x2e, 0x6b, 0x6f, 0x8e, 0xcb, 0xda, 0x9f, 0xc7, 0x65, 0xed, 0xbb, 0x93, 0x72, 0xe1,
+	0xcd, 0x49, 0xb9, 0xf0, 0xfb, 0x49, 0xb9, 0xf0, 0x45, 0xb5, 0xe7, 0x47, 0x3b, 0xb1, 0x6b, 0x76,
+	0xd9, 0x6e, 0x62, 0x2f, 0xff, 0xd4, 0xb8, 0xf7, 0xd4, 0x7a, 0x21, 0x73, 0x45, 0x83, 0x90, 0x72,
+	0x77, 0x56, 0xfc, 0xc3, 0xb5, 0xf9, 0xcf, 0x00, 0x16, 0x5e, 0xf5, 0x41, 0xd2, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -874,6 +994,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
+	// PubKeyRotations returns the rotations of the public key of an account.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
 }
 
 type queryClient struct {
@@ -956,6 +1078,15 @@ func (c *queryClient) AddressStringToBytes(ctx context.Context, in *AddressStrin
 	return out, nil
 }
 
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/PubKeyRotations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts
@@ -984,6 +1115,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
+	// PubKeyRotations returns the rotations of the public key of an account.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1014,6 +1147,9 @@ func (*UnimplementedQueryServer) AddressBytesToString(ctx context.Context, req *
 func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressStringToBytes not implemented")
 }
+func (*UnimplementedQueryServer) PubKeyRotations(ctx context.Context, req *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1163,6 +1299,24 @@ func _Query_AddressStringToBytes_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/PubKeyRotations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1199,6 +1353,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddressStringToBytes",
 			Handler:    _Query_AddressStringToBytes_Handler,
 		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -1700,6 +1858,97 @@ func (m *QueryAccountAddressByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPubKeyRotationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPubKeyRotationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPubKeyRotationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rotations) > 0 {
+		for iNdEx := len(m.Rotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1912,6 +2161,42 @@ func (m *QueryAccountAddressByIDResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPubKeyRotationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPubKeyRotationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rotations) > 0 {
+		for _, e := range m.Rotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3174,6 +3459,244 @@ func (m *QueryAccountAddressByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPubKeyRotationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPubKeyRotationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPubKeyRotationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rotations = append(m.Rotations, PubKeyRotation{})
+			if err := m.Rotations[len(m.Rotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,7 +17,11 @@ import (
 
 // Transaction command flags
 const (
-	FlagDelayed = "delayed"
+	FlagDelayed   = "delayed"
+	FlagCliffTime = "cliff-time"
+	FlagLockup    = "lockup"
+	FlagVesting   = "vesting"
+	FlagDest      = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +38,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -49,7 +55,8 @@ func NewMsgCreateVestingAccountCmd() *cobra.Command {
 account can either be a delayed or continuous vesting account, which is determined
 by the '--delayed' flag. All vesting accounts created will have their start time
 set by the committed block's time. The end_time must be provided as a UNIX epoch
-timestamp.`,
+timestamp. A continuous vesting account can have a cliff, given as a UNIX epoch
+timestamp with the '--cliff-time' flag, before which no tokens are vested.`,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -72,14 +79,17 @@ timestamp.`,
 			}
 
 			delayed, _ := cmd.Flags().GetBool(FlagDelayed)
+			cliffTime, _ := cmd.Flags().GetInt64(FlagCliffTime)
 
 			msg := types.NewMsgCreateVestingAccount(clientCtx.GetFromAddress(), toAddr, amount, endTime, delayed)
+			msg.CliffTime = cliffTime
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+	cmd.Flags().Int64(FlagCliffTime, 0, "The UNIX epoch timestamp before which no tokens of a continuous vesting account are vested")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -207,3 +217,146 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [start_time]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: `Create a new clawback vesting account funded by the sender, who becomes its funder and
+is allowed to claw back its unvested tokens. The account has a lockup schedule, defining when its
+tokens become spendable, and a vesting schedule, defining when its tokens are vested. Its tokens
+are only spendable once both unlocked and vested. The start_time of both schedules must be
+provided as a UNIX epoch timestamp.
+
+The schedules are given with the '--lockup' and '--vesting' flags, as JSON files containing
+sequential periods in the format of the create-periodic-vesting-account command:
+
+[
+ {
+  "coins": "10test",
+  "length_seconds": 2592000
+ },
+ {
+  "coins": "10test",
+  "length_seconds": 2592000
+ }
+]
+
+If one of the schedules is omitted, all the tokens of the other one are released at the start time.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			lockupPeriods, err := readPeriodsFile(lockupFile)
+			if err != nil {
+				return err
+			}
+
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			vestingPeriods, err := readPeriodsFile(vestingFile)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the JSON file of the lockup periods")
+	cmd.Flags().String(FlagVesting, "", "Path to the JSON file of the vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readPeriodsFile reads the periods of a JSON file of InputPeriod. An empty
+// path returns no periods.
+func readPeriodsFile(path string) ([]types.Period, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputPeriods []InputPeriod
+	if err := json.Unmarshal(contents, &inputPeriods); err != nil {
+		return nil, err
+	}
+
+	periods := make([]types.Period, 0, len(inputPeriods))
+	for _, p := range inputPeriods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account funded by the sender. The
+tokens are sent to the sender, or to the address given with the '--dest' flag. The unvested tokens
+that are delegated are not clawed back.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr, _ := cmd.Flags().GetString(FlagDest); destStr != "" {
+				dest, err = sdk.AccAddressFromBech32(destStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back tokens, the sender if empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
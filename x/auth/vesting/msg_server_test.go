@@ -0,0 +1,189 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+var (
+	blockTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	funderAddr  = sdk.AccAddress("funder______________")
+	vestingAddr = sdk.AccAddress("vesting_____________")
+	destAddr    = sdk.AccAddress("dest________________")
+)
+
+// mockBankKeeper keeps the balances in memory, and only lets the accounts
+// spend their unlocked coins.
+type mockBankKeeper struct {
+	ak       authkeeper.AccountKeeper
+	balances map[string]sdk.Coins
+}
+
+func (bk *mockBankKeeper) IsSendEnabledCoins(sdk.Context, ...sdk.Coin) error {
+	return nil
+}
+
+func (bk *mockBankKeeper) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return bk.balances[addr.String()]
+}
+
+func (bk *mockBankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance := bk.balances[fromAddr.String()]
+	spendable := balance
+	if acc, ok := bk.ak.GetAccount(ctx, fromAddr).(interface {
+		LockedCoins(time.Time) sdk.Coins
+	}); ok {
+		spendable, _ = balance.SafeSub(acc.LockedCoins(ctx.BlockTime())...)
+	}
+	if !spendable.IsAllGTE(amt) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", spendable, amt)
+	}
+
+	bk.balances[fromAddr.String()] = balance.Sub(amt...)
+	bk.balances[toAddr.String()] = bk.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (bk *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+}
+
+type MsgServerTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    *mockBankKeeper
+	msgServer     types.MsgServer
+}
+
+func (s *MsgServerTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(authtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: blockTime})
+	encCfg := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, vesting.AppModuleBasic{})
+
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		encCfg.Codec, key, authtypes.ProtoBaseAccount, map[string][]string{}, sdk.Bech32MainPrefix, authtypes.NewModuleAddress("gov").String(),
+	)
+	s.bankKeeper = &mockBankKeeper{
+		ak:       s.accountKeeper,
+		balances: map[string]sdk.Coins{funderAddr.String(): sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+	}
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+}
+
+func TestMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgServerTestSuite))
+}
+
+func (s *MsgServerTestSuite) TestCreateVestingAccountWithCliff() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	msg := types.NewMsgCreateVestingAccount(funderAddr, vestingAddr, amount, blockTime.Add(4*time.Hour).Unix(), false)
+	msg.CliffTime = blockTime.Add(time.Hour).Unix()
+
+	_, err := s.msgServer.CreateVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, vestingAddr).(*types.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(msg.CliffTime, acc.CliffTime)
+	s.Require().Nil(acc.GetVestedCoins(blockTime.Add(59 * time.Minute)))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), acc.GetVestedCoins(blockTime.Add(time.Hour)))
+}
+
+func (s *MsgServerTestSuite) createClawbackVestingAccount() {
+	periods := []types.Period{
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))},
+	}
+	msg := types.NewMsgCreateClawbackVestingAccount(funderAddr, vestingAddr, blockTime.Unix(), nil, periods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+}
+
+func (s *MsgServerTestSuite) TestCreateClawbackVestingAccount() {
+	s.createClawbackVestingAccount()
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, vestingAddr).(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().NoError(acc.Validate())
+	s.Require().Equal(funderAddr, acc.GetFunder())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), acc.OriginalVesting)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), acc.LockupPeriods[0].Amount)
+	s.Require().Equal(int64(0), acc.LockupPeriods[0].Length)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), s.bankKeeper.GetAllBalances(s.ctx, vestingAddr))
+
+	// require the account not to be created twice
+	msg := types.NewMsgCreateClawbackVestingAccount(funderAddr, vestingAddr, blockTime.Unix(), acc.LockupPeriods, acc.VestingPeriods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MsgServerTestSuite) TestClawback() {
+	s.createClawbackVestingAccount()
+	ctx := s.ctx.WithBlockTime(blockTime.Add(time.Hour))
+	s.accountKeeper.SetAccount(ctx, s.accountKeeper.NewAccountWithAddress(ctx, destAddr))
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgClawback
+		expErr error
+	}{
+		{
+			name:   "not the funder",
+			msg:    types.NewMsgClawback(destAddr, vestingAddr, nil),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "unknown account",
+			msg:    types.NewMsgClawback(funderAddr, funderAddr, nil),
+			expErr: sdkerrors.ErrUnknownAddress,
+		},
+		{
+			name:   "not a clawback vesting account",
+			msg:    types.NewMsgClawback(funderAddr, destAddr, nil),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "blocked destination",
+			msg:    types.NewMsgClawback(funderAddr, vestingAddr, authtypes.NewModuleAddress(authtypes.FeeCollectorName)),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Clawback(sdk.WrapSDKContext(ctx), tc.msg)
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funderAddr, vestingAddr, destAddr))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), res.Amount)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), s.bankKeeper.GetAllBalances(ctx, destAddr))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), s.bankKeeper.GetAllBalances(ctx, vestingAddr))
+
+	// require the vested coins to be spendable and nothing left to claw back
+	acc := s.accountKeeper.GetAccount(ctx, vestingAddr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), acc.OriginalVesting)
+	s.Require().True(acc.LockedCoins(ctx.BlockTime()).IsZero())
+
+	res, err = s.msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funderAddr, vestingAddr, nil))
+	s.Require().NoError(err)
+	s.Require().True(res.Amount.IsZero())
+}
@@ -5,9 +5,10 @@ import (
 )
 
 // BankKeeper defines the expected interface contract the vesting module requires
-// for creating vesting accounts with funds.
+// for creating vesting accounts with funds and clawing them back.
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -48,6 +48,22 @@ func (p Periods) TotalAmount() sdk.Coins {
 	return total
 }
 
+// subtractFromEnd returns a copy of the periods where the given amount is
+// subtracted from the amounts of the periods, starting from the last one. The
+// lengths of the periods are left unchanged.
+func (p Periods) subtractFromEnd(amount sdk.Coins) Periods {
+	periods := make(Periods, len(p))
+	copy(periods, p)
+
+	for i := len(periods) - 1; i >= 0 && !amount.IsZero(); i-- {
+		subtracted := coinsMin(periods[i].Amount, amount)
+		periods[i].Amount = periods[i].Amount.Sub(subtracted...)
+		amount = amount.Sub(subtracted...)
+	}
+
+	return periods
+}
+
 // String implements the fmt.Stringer interface
 func (p Periods) String() string {
 	periodsListString := make([]string, len(p))
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -164,6 +165,9 @@ type vestingAccountYAML struct {
 
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
+	CliffTime      int64   `json:"cliff_time,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
 }
 
@@ -229,7 +233,7 @@ func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coin
 	// We must handle the case where the start time for a vesting account has
 	// been set into the future or when the start of the chain is not exactly
 	// known.
-	if blockTime.Unix() <= cva.StartTime {
+	if blockTime.Unix() <= cva.StartTime || blockTime.Unix() < cva.CliffTime {
 		return vestedCoins
 	} else if blockTime.Unix() >= cva.EndTime {
 		return cva.OriginalVesting
@@ -278,6 +282,9 @@ func (cva ContinuousVestingAccount) Validate() error {
 	if cva.GetStartTime() >= cva.GetEndTime() {
 		return errors.New("vesting start-time cannot be before end-time")
 	}
+	if cva.CliffTime != 0 && (cva.CliffTime < cva.StartTime || cva.CliffTime > cva.EndTime) {
+		return errors.New("vesting cliff-time must be between start-time and end-time")
+	}
 
 	return cva.BaseVestingAccount.Validate()
 }
@@ -304,6 +311,7 @@ func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
 		DelegatedVesting: cva.DelegatedVesting,
 		EndTime:          cva.EndTime,
 		StartTime:        cva.StartTime,
+		CliffTime:        cva.CliffTime,
 	}
 	return marshalYaml(out)
 }
@@ -592,6 +600,201 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by the
+// given funder. Its original vesting coins are the total amount of the vesting
+// periods, and its end time is the end of the longest of the two schedules.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	endTime := startTime + lockupPeriods.TotalLength()
+	if vestingEnd := startTime + vestingPeriods.TotalLength(); vestingEnd > endTime {
+		endTime = vestingEnd
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: vestingPeriods.TotalAmount(),
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of coins that are both vested and
+// unlocked. If no coins are vested, nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return coinsMin(cva.GetVestedOnly(blockTime), cva.GetUnlockedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of coins that are either unvested or
+// still locked. If no coins are vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule, regardless
+// of the lockup schedule.
+func (cva ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(cva.StartTime, cva.VestingPeriods, blockTime.Unix())
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule, regardless
+// of the vesting schedule.
+func (cva ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(cva.StartTime, cva.LockupPeriods, blockTime.Unix())
+}
+
+// GetUnvestedCoins returns the coins not vested yet by the vesting schedule,
+// which are the coins the funder can claw back.
+func (cva ClawbackVestingAccount) GetUnvestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedOnly(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// Clawback removes the unvested coins that are in the given balance of the
+// account from the latest periods of both schedules and from the original
+// vesting coins, and returns them. The unvested coins that are delegated stay
+// in the account and keep vesting. The delegated vesting and delegated free
+// coins are then recomputed so that the delegated vesting coins do not exceed
+// the coins still vesting.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time, balance sdk.Coins) sdk.Coins {
+	amount := coinsMin(cva.GetUnvestedCoins(blockTime), balance)
+	if amount.IsZero() {
+		return amount
+	}
+
+	cva.VestingPeriods = Periods(cva.VestingPeriods).subtractFromEnd(amount)
+	cva.LockupPeriods = Periods(cva.LockupPeriods).subtractFromEnd(amount)
+	cva.OriginalVesting = cva.OriginalVesting.Sub(amount...)
+
+	delegated := cva.DelegatedVesting.Add(cva.DelegatedFree...)
+	cva.DelegatedVesting = coinsMin(delegated, cva.GetVestingCoins(blockTime))
+	cva.DelegatedFree = delegated.Sub(cva.DelegatedVesting...)
+
+	return amount
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetFunder returns the address of the funder of a clawback vesting account.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(cva.FunderAddress)
+	return funder
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if cva.GetStartTime() >= cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be before end-time")
+	}
+
+	endTime := cva.StartTime + Periods(cva.LockupPeriods).TotalLength()
+	if vestingEnd := cva.StartTime + Periods(cva.VestingPeriods).TotalLength(); vestingEnd > endTime {
+		endTime = vestingEnd
+	}
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of the lockup and vesting periods")
+	}
+	if !Periods(cva.LockupPeriods).TotalAmount().IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if !Periods(cva.VestingPeriods).TotalAmount().IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		FunderAddress:    cva.FunderAddress,
+		LockupPeriods:    cva.LockupPeriods,
+		VestingPeriods:   cva.VestingPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// readSchedule returns the sum of the amounts of the periods, starting at the
+// given start time, that have elapsed at the given time.
+func readSchedule(startTime int64, periods Periods, readTime int64) sdk.Coins {
+	var coins sdk.Coins
+
+	periodEnd := startTime
+	for _, period := range periods {
+		periodEnd += period.Length
+		if readTime < periodEnd {
+			break
+		}
+
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
+// coinsMin returns the minimum of each denom of a and b. The denoms missing in
+// one of the sets are omitted.
+func coinsMin(a, b sdk.Coins) sdk.Coins {
+	var min sdk.Coins
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = append(min, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+
+	return min
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
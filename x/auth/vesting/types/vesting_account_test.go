@@ -699,6 +699,157 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsContVestingAccWithCliff(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewContinuousVestingAccount(bacc, origCoins, now.Unix(), endTime.Unix())
+	cva.CliffTime = now.Add(12 * time.Hour).Unix()
+
+	// require no coins vested before the cliff
+	vestedCoins := cva.GetVestedCoins(now.Add(6 * time.Hour))
+	require.Nil(t, vestedCoins)
+	require.Equal(t, origCoins, cva.LockedCoins(now.Add(6*time.Hour)))
+
+	// require the coins vested since the start time vested at the cliff
+	vestedCoins = cva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, vestedCoins)
+
+	// require coins vested linearly after the cliff
+	vestedCoins = cva.GetVestedCoins(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, vestedCoins)
+
+	// require all coins vested at the end of the vesting schedule
+	vestedCoins = cva.GetVestedCoins(endTime)
+	require.Equal(t, origCoins, vestedCoins)
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, origCoins, cva.OriginalVesting)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.EndTime)
+	require.NoError(t, cva.Validate())
+
+	// require no coins vested at the beginning of the schedules
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+	require.Equal(t, origCoins, cva.LockedCoins(now))
+
+	// require vested coins to stay locked until the end of the lockup
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Nil(t, cva.GetVestedCoins(now.Add(6*time.Hour)))
+	require.Equal(t, origCoins, cva.LockedCoins(now.Add(6*time.Hour)))
+
+	// require unlocked coins to stay locked until vested
+	require.Equal(t, origCoins, cva.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, cva.GetUnvestedCoins(now.Add(12*time.Hour)))
+
+	// require 75% of coins vested after the second vesting period
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, cva.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the schedules
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Nil(t, cva.GetVestingCoins(now.Add(24*time.Hour)))
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+
+	// require the vested but locked coins to be delegated as vesting
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, periods)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+
+	// require the unlocked and vested coins to be delegated as free
+	cva = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), types.Periods{{Length: 0, Amount: origCoins}}, periods)
+	cva.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// require the delegated free coins to be undelegated first
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+}
+
+func TestClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	_, _, funder := testdata.KeyTestPubAddr()
+	periods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	blockTime := now.Add(6 * time.Hour)
+
+	bacc, origCoins := initBaseAccount()
+
+	// require all the unvested coins to be clawed back from the balance
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, periods)
+	clawedBack := cva.Clawback(blockTime, origCoins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, clawedBack)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}, cva.OriginalVesting)
+	require.Equal(t, periods[0].Amount, cva.VestingPeriods[0].Amount)
+	require.True(t, cva.VestingPeriods[1].Amount.IsZero())
+	require.True(t, cva.VestingPeriods[2].Amount.IsZero())
+	require.Equal(t, cva.OriginalVesting, cva.LockupPeriods[0].Amount)
+	require.NoError(t, cva.Validate())
+
+	// require the vested coins to be unchanged and to unlock with the lockup
+	require.Equal(t, cva.OriginalVesting, cva.GetVestedOnly(blockTime))
+	require.Equal(t, cva.OriginalVesting, cva.LockedCoins(blockTime))
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require nothing to be clawed back after the end of the vesting schedule
+	require.True(t, cva.Clawback(now.Add(24*time.Hour), origCoins).IsZero())
+
+	// require the delegated unvested coins to stay in the account, and the
+	// delegated vesting coins not to exceed the coins still vesting
+	cva = types.NewClawbackVestingAccount(bacc, funder, now.Unix(), lockupPeriods, periods)
+	cva.TrackDelegation(blockTime, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+
+	balance := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}
+	clawedBack = cva.Clawback(blockTime, balance)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750)}, clawedBack)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 100)}, cva.OriginalVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+	require.NoError(t, cva.Validate())
+
+	// require the locked coins to stay covered by the remaining balance
+	locked := cva.LockedCoins(blockTime)
+	require.True(t, balance.Sub(clawedBack...).IsAllGTE(locked))
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -735,6 +886,16 @@ func TestGenesisAccountValidate(t *testing.T) {
 			types.NewContinuousVestingAccount(baseAcc, initialVesting, 1654668078, 1554668078),
 			true,
 		},
+		{
+			"valid continuous vesting account with cliff",
+			&types.ContinuousVestingAccount{BaseVestingAccount: baseVestingWithCoins, StartTime: 50, CliffTime: 75},
+			false,
+		},
+		{
+			"invalid cliff time",
+			&types.ContinuousVestingAccount{BaseVestingAccount: baseVestingWithCoins, StartTime: 50, CliffTime: 150},
+			true,
+		},
 		{
 			"valid periodic vesting account",
 			types.NewPeriodicVestingAccount(baseAcc, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
@@ -754,6 +915,42 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback end time",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(150), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
 		{
 			"valid permanent locked vesting account",
 			types.NewPermanentLockedAccount(baseAcc, initialVesting),
@@ -848,6 +1045,25 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, time.Now().Unix(), types.Periods{types.Period{1800, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
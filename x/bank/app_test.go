@@ -17,6 +17,9 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/authz/module"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -333,3 +336,57 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgExecBurnAndSetSendEnabled(t *testing.T) {
+	var (
+		bankKeeper  bankkeeper.Keeper
+		authzKeeper authzkeeper.Keeper
+	)
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = []simtestutil.GenesisAccount{
+		{GenesisAccount: authtypes.NewBaseAccountWithAddress(addr1)},
+		{GenesisAccount: authtypes.NewBaseAccount(addr2, nil, 1, 0)},
+	}
+
+	app, err := simtestutil.SetupWithConfiguration(configurator.NewAppConfig(
+		configurator.ParamsModule(),
+		configurator.AuthModule(),
+		configurator.StakingModule(),
+		configurator.TxModule(),
+		configurator.BankModule(),
+		configurator.AuthzModule()),
+		startupCfg, &bankKeeper, &authzKeeper)
+	require.NoError(t, err)
+
+	baseApp := app.BaseApp
+	ctx := baseApp.NewContext(false, tmproto.Header{})
+	authority, err := sdk.AccAddressFromBech32(bankKeeper.GetAuthority())
+	require.NoError(t, err)
+	expiration := ctx.BlockTime().AddDate(1, 0, 0)
+
+	// addr2 may burn the coins of addr1 and set the send enabled entries on
+	// behalf of the bank authority
+	require.NoError(t, testutil.FundAccount(bankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 42))))
+	burnAuth := authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgBurn{}))
+	require.NoError(t, authzKeeper.SaveGrant(ctx, addr2, addr1, burnAuth, &expiration))
+	setSendEnabledAuth := authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgSetSendEnabled{}))
+	require.NoError(t, authzKeeper.SaveGrant(ctx, addr2, authority, setSendEnabledAuth, &expiration))
+	baseApp.Commit()
+
+	msgs := []sdk.Msg{
+		types.NewMsgBurn(addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))),
+		types.NewMsgSetSendEnabled(authority.String(), []*types.SendEnabled{types.NewSendEnabled("foocoin", false)}, nil),
+	}
+	execMsg := authz.NewMsgExec(addr2, msgs)
+
+	header := tmproto.Header{Height: baseApp.LastBlockHeight() + 1}
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+	_, _, err = simtestutil.SignCheckDeliver(t, txConfig, baseApp, header, []sdk.Msg{&execMsg}, "", []uint64{1}, []uint64{0}, true, true, priv2)
+	require.NoError(t, err)
+
+	ctx = baseApp.NewContext(true, tmproto.Header{})
+	checkBalance(t, baseApp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 32)}, bankKeeper)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 32), bankKeeper.GetSupply(ctx, "foocoin"))
+	require.False(t, bankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -9,10 +11,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-var FlagSplit = "split"
+var (
+	FlagSplit         = "split"
+	FlagUseDefaultFor = "use-default-for"
+)
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -27,6 +33,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewBurnTxCmd(),
+		NewSetSendEnabledTxCmd(),
 	)
 
 	return txCmd
@@ -70,6 +78,94 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 	return cmd
 }
 
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [from_key_or_address] [amount]",
+		Short: "Burn tokens from an account.",
+		Long: `Burn tokens from an account, reducing the total supply.
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetSendEnabledTxCmd returns a CLI command handler for creating a MsgSetSendEnabled transaction.
+func NewSetSendEnabledTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-enabled [authority_key_or_address] [denom=true|false,...]",
+		Short: "Set or delete the send enabled entries of denoms.",
+		Long: `Set the send enabled entries of the given denoms, and delete the entries of the
+denoms given with the '--use-default-for' flag, so that they use the default send
+enabled param. Only the bank authority may execute it: use '--generate-only' to
+submit it in a governance proposal or in an authz exec.
+Note, the '--from' flag is ignored as it is implied from [authority_key_or_address].
+When using '--dry-run' a key name cannot be used, only a bech32 address.
+`,
+		Example: fmt.Sprintf("%s tx bank set-send-enabled cosmos1.. foo=true,bar=false --%s baz", version.AppName, FlagUseDefaultFor),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var sendEnabled []*types.SendEnabled
+			if len(args) > 1 {
+				for _, entry := range strings.Split(args[1], ",") {
+					denom, enabled, found := strings.Cut(entry, "=")
+					if !found {
+						return fmt.Errorf("invalid send enabled entry %q, expected denom=true|false", entry)
+					}
+
+					isEnabled, err := strconv.ParseBool(enabled)
+					if err != nil {
+						return fmt.Errorf("invalid send enabled entry %q: %w", entry, err)
+					}
+
+					sendEnabled = append(sendEnabled, types.NewSendEnabled(denom, isEnabled))
+				}
+			}
+
+			useDefaultFor, err := cmd.Flags().GetStringSlice(FlagUseDefaultFor)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetSendEnabled(clientCtx.GetFromAddress().String(), sendEnabled, useDefaultFor)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagUseDefaultFor, nil, "Denoms whose send enabled entry is deleted, so that they use the default send enabled param")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewMultiSendTxCmd returns a CLI command handler for creating a MsgMultiSend transaction.
 // For a better UX this command is limited to send funds from one account to two or more accounts.
 func NewMultiSendTxCmd() *cobra.Command {
@@ -210,3 +210,70 @@ func (s *CLITestSuite) TestMultiSendTxCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestSetSendEnabledTxCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"valid transaction",
+			[]string{accounts[0].Address.String(), "stake=true,photon=false", fmt.Sprintf("--%s=foo,bar", cli.FlagUseDefaultFor)},
+			false,
+		},
+		{
+			"valid transaction deleting entries only",
+			[]string{accounts[0].Address.String(), fmt.Sprintf("--%s=foo", cli.FlagUseDefaultFor)},
+			false,
+		},
+		{
+			"invalid authority",
+			[]string{"foo", "stake=true"},
+			true,
+		},
+		{
+			"entry without a value",
+			[]string{accounts[0].Address.String(), "stake"},
+			true,
+		},
+		{
+			"entry with an invalid value",
+			[]string{accounts[0].Address.String(), "stake=maybe"},
+			true,
+		},
+		{
+			"denom both set and deleted",
+			[]string{accounts[0].Address.String(), "stake=true", fmt.Sprintf("--%s=stake", cli.FlagUseDefaultFor)},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetSendEnabledTxCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetContext(svrcmd.CreateExecuteContext(context.Background()))
+			cmd.SetArgs(append(tc.args, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
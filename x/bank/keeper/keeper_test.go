@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -541,6 +542,81 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *KeeperTestSuite) TestSendCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	// reject bar coins and redirect the sends to accAddrs[1] to accAddrs[2]
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if !amt.AmountOf(barDenom).IsZero() {
+			return nil, fmt.Errorf("%s cannot be sent", barDenom)
+		}
+		return toAddr, nil
+	})
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	})
+
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(25))), "bar cannot be sent")
+
+	sendAmt := sdk.NewCoins(newFooCoin(50))
+	suite.mockSendCoins(ctx, acc0, accAddrs[2])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal(balances.Sub(sendAmt...), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).Empty())
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+
+	// without restriction, the coins reach their original recipient
+	suite.bankKeeper.ClearSendRestriction()
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(25))))
+	require.Equal(sdk.NewCoins(newBarCoin(25)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(90))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	inputs := []banktypes.Input{
+		{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(60))},
+	}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(30))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(30))},
+	}
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, accAddrs[0], balances))
+
+	var senders []sdk.AccAddress
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		senders = append(senders, fromAddr)
+		if toAddr.Equals(accAddrs[1]) {
+			return accAddrs[2], nil
+		}
+		return toAddr, nil
+	})
+
+	suite.mockInputOutputCoins([]authtypes.AccountI{acc0}, []sdk.AccAddress{accAddrs[2], accAddrs[3]})
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	require.Equal([]sdk.AccAddress{accAddrs[0], accAddrs[0]}, senders)
+	require.True(suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]).Empty())
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(sdk.NewCoins(newFooCoin(30)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+}
+
 func (suite *KeeperTestSuite) TestValidateBalance() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -962,6 +1038,30 @@ func (suite *KeeperTestSuite) TestDelegateCoins() {
 	require.Equal(delCoins, vacc.GetDelegatedVesting())
 }
 
+func (suite *KeeperTestSuite) TestDelegateCoins_WithRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+
+	delCoins := sdk.NewCoins(newFooCoin(50))
+
+	// delegations may be rejected by the send restriction
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, fmt.Errorf("delegations are restricted")
+	})
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.ErrorContains(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), delCoins), "delegations are restricted")
+
+	// but not redirected
+	suite.bankKeeper.ClearSendRestriction()
+	suite.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return accAddrs[2], nil
+	})
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.ErrorIs(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), delCoins), sdkerrors.ErrUnauthorized)
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.ErrorIs(suite.bankKeeper.UndelegateCoins(ctx, holderAcc.GetAddress(), accAddrs[0], delCoins), sdkerrors.ErrUnauthorized)
+}
+
 func (suite *KeeperTestSuite) TestDelegateCoins_Invalid() {
 	ctx := suite.ctx
 	require := suite.Require()
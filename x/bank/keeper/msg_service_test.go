@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -171,3 +172,97 @@ func (suite *KeeperTestSuite) TestMsgMultiSend() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgBurn() {
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("burnableCoin", 100))
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin("burnableCoin", 40))
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, accAddrs[0], origCoins))
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgBurn
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "insufficient funds",
+			input:     banktypes.NewMsgBurn(accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin("burnableCoin", 1000))),
+			expErr:    true,
+			expErrMsg: "insufficient funds",
+		},
+		{
+			name:   "all good",
+			input:  banktypes.NewMsgBurn(accAddrs[0], burnCoins),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.mockSpendableCoins(suite.ctx, acc0)
+			_, err := suite.msgServer.Burn(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	expCoins := origCoins.Sub(burnCoins...)
+	suite.Require().Equal(expCoins, suite.bankKeeper.GetAllBalances(suite.ctx, accAddrs[0]))
+	suite.Require().Equal(expCoins[0], suite.bankKeeper.GetSupply(suite.ctx, "burnableCoin"))
+}
+
+func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
+	suite.bankKeeper.SetSendEnabled(suite.ctx, "deletedCoin", false)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgSetSendEnabled
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     banktypes.NewMsgSetSendEnabled("invalid", nil, nil),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "all good",
+			input: banktypes.NewMsgSetSendEnabled(
+				suite.bankKeeper.GetAuthority(),
+				[]*banktypes.SendEnabled{
+					banktypes.NewSendEnabled("disabledCoin", false),
+					banktypes.NewSendEnabled("enabledCoin", true),
+				},
+				[]string{"deletedCoin"},
+			),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetSendEnabled(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	suite.Require().False(suite.bankKeeper.IsSendEnabledDenom(suite.ctx, "disabledCoin"))
+	suite.Require().True(suite.bankKeeper.IsSendEnabledDenom(suite.ctx, "enabledCoin"))
+	_, found := suite.bankKeeper.GetSendEnabledEntry(suite.ctx, "deletedCoin")
+	suite.Require().False(found)
+}
@@ -285,3 +285,93 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *MsgBurn
+	}{
+		{"", NewMsgBurn(addr1, atom123)},                                // valid burn
+		{": invalid coins", NewMsgBurn(addr1, atom0)},                   // non positive coin
+		{"123atom,0eth: invalid coins", NewMsgBurn(addr1, atom123eth0)}, // non positive coin in multicoins
+		{"invalid from address: empty address string is not allowed: invalid address", NewMsgBurn(addrEmpty, atom123)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgBurnGetSigners(t *testing.T) {
+	from := sdk.AccAddress([]byte("input111111111111111"))
+	msg := NewMsgBurn(from, sdk.NewCoins())
+	res := msg.GetSigners()
+	require.Equal(t, 1, len(res))
+	require.True(t, from.Equals(res[0]))
+}
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	cases := []struct {
+		name        string
+		msg         *MsgSetSendEnabled
+		expectedErr string // empty means no error expected
+	}{
+		{
+			name: "valid",
+			msg: NewMsgSetSendEnabled(authority,
+				[]*SendEnabled{NewSendEnabled("atom", true), NewSendEnabled("eth", false)},
+				[]string{"btc"},
+			),
+		},
+		{
+			name:        "invalid authority",
+			msg:         NewMsgSetSendEnabled("invalid", nil, nil),
+			expectedErr: "invalid authority address: decoding bech32 failed: invalid bech32 string length 7: invalid address",
+		},
+		{
+			name:        "invalid denom",
+			msg:         NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("0atom", true)}, nil),
+			expectedErr: `invalid SendEnabled denom "0atom": invalid denom: 0atom: invalid request`,
+		},
+		{
+			name:        "duplicate send enabled denom",
+			msg:         NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", true), NewSendEnabled("atom", false)}, nil),
+			expectedErr: `duplicate denom entries found for "atom": invalid request`,
+		},
+		{
+			name:        "denom both set and reset to default",
+			msg:         NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("atom", true)}, []string{"atom"}),
+			expectedErr: `duplicate denom entries found for "atom": invalid request`,
+		},
+		{
+			name:        "invalid use default for denom",
+			msg:         NewMsgSetSendEnabled(authority, nil, []string{"0atom"}),
+			expectedErr: `invalid UseDefaultFor denom "0atom": invalid denom: 0atom: invalid request`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
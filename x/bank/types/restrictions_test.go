@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// newRedirectRestriction returns a SendRestrictionFn that records its name in calls
+// and sends to the given address.
+func newRedirectRestriction(name string, calls *[]string, newToAddr sdk.AccAddress) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name)
+		return newToAddr, nil
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	t.Run("nil restrictions", func(t *testing.T) {
+		require.Nil(t, types.ComposeSendRestrictions())
+		require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+	})
+
+	t.Run("single restriction is returned as is", func(t *testing.T) {
+		var calls []string
+		r := types.ComposeSendRestrictions(nil, newRedirectRestriction("r1", &calls, addr1), nil)
+		newToAddr, err := r(sdk.Context{}, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, addr1, newToAddr)
+		require.Equal(t, []string{"r1"}, calls)
+	})
+
+	t.Run("restrictions are run in order", func(t *testing.T) {
+		var calls []string
+		r := newRedirectRestriction("r1", &calls, addr1).Then(newRedirectRestriction("r2", &calls, addr2))
+		newToAddr, err := r(sdk.Context{}, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, addr2, newToAddr)
+		require.Equal(t, []string{"r1", "r2"}, calls)
+	})
+
+	t.Run("an error stops the composition", func(t *testing.T) {
+		var calls []string
+		reject := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, "reject")
+			return nil, errors.New("rejected")
+		}
+		r := types.ComposeSendRestrictions(reject, newRedirectRestriction("r2", &calls, addr2))
+		_, err := r(sdk.Context{}, fromAddr, toAddr, coins)
+		require.EqualError(t, err, "rejected")
+		require.Equal(t, []string{"reject"}, calls)
+	})
+
+	t.Run("no-op restriction", func(t *testing.T) {
+		newToAddr, err := types.NoOpSendRestrictionFn(sdk.Context{}, fromAddr, toAddr, coins)
+		require.NoError(t, err)
+		require.Equal(t, toAddr, newToAddr)
+	})
+}
@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/circuit transaction commands.
+func NewTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewAuthorizeCmd(),
+		NewTripCmd(),
+		NewResetCmd(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewAuthorizeCmd returns a CLI command handler for creating a MsgAuthorizeCircuitBreaker transaction.
+func NewAuthorizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [msg-type-urls...]",
+		Short: "Authorize an account to trip the circuit breaker",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorize an account to trip the circuit breaker, overwriting its existing permissions.
+The level is one of "none" (revoke all permissions), "some-msgs", "all-msgs" or "super-admin".
+The "some-msgs" level must be followed by the list of msg type urls the grantee can trip.
+
+Example:
+$ %s tx circuit authorize cosmos1... some-msgs /cosmos.bank.v1beta1.MsgSend --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthorizeCircuitBreaker(
+				clientCtx.GetFromAddress().String(),
+				grantee.String(),
+				types.NewPermissions(level, args[2:]...),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTripCmd returns a CLI command handler for creating a MsgTripCircuitBreaker transaction.
+func NewTripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-urls...]",
+		Short: "Disable the execution of the given msg types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trip the circuit breaker of the given msg type urls, which stops their execution immediately.
+
+Example:
+$ %s tx circuit trip /cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewResetCmd returns a CLI command handler for creating a MsgResetCircuitBreaker transaction.
+func NewResetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-urls...]",
+		Short: "Enable the execution of the given msg types",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reset the circuit breaker of the given msg type urls, which resumes their execution.
+If no msg type url is given, all the msg types the signer is allowed to reset are enabled.
+
+Example:
+$ %s tx circuit reset /cosmos.bank.v1beta1.MsgSend --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseLevel(s string) (types.Permissions_Level, error) {
+	switch s {
+	case "none":
+		return types.Permissions_LEVEL_NONE_UNSPECIFIED, nil
+	case "some-msgs":
+		return types.Permissions_LEVEL_SOME_MSGS, nil
+	case "all-msgs":
+		return types.Permissions_LEVEL_ALL_MSGS, nil
+	case "super-admin":
+		return types.Permissions_LEVEL_SUPER_ADMIN, nil
+	}
+
+	return types.Permissions_LEVEL_NONE_UNSPECIFIED, fmt.Errorf("unknown permission level %s", s)
+}
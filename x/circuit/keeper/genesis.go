@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis initializes the circuit module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	for _, acc := range data.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(acc.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetPermissions(ctx, addr, acc.Permissions)
+	}
+
+	for _, msgTypeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	accounts := []types.GenesisAccountPermissions{}
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, perms types.Permissions) bool {
+		accounts = append(accounts, types.GenesisAccountPermissions{
+			Address:     addr.String(),
+			Permissions: perms,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(accounts, k.GetDisableList(ctx))
+}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	genesis := types.NewGenesisState(
+		[]types.GenesisAccountPermissions{
+			{Address: s.addrs[0].String(), Permissions: *types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN)},
+			{Address: s.addrs[1].String(), Permissions: *types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, sendMsgTypeURL)},
+		},
+		[]string{delegateMsgTypeURL},
+	)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+
+	s.keeper.InitGenesis(s.ctx, genesis)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, delegateMsgTypeURL))
+	s.Require().Equal(types.Permissions_LEVEL_SUPER_ADMIN, s.keeper.GetPermissions(s.ctx, s.addrs[0]).Level)
+
+	exported := s.keeper.ExportGenesis(s.ctx)
+	s.Require().ElementsMatch(genesis.AccountPermissions, exported.AccountPermissions)
+	s.Require().Equal(genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+}
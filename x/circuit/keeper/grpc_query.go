@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Account returns the circuit breaker permissions of an account.
+func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	perms := k.GetPermissions(ctx, addr)
+
+	return &types.QueryAccountResponse{Permission: &perms}, nil
+}
+
+// Accounts returns all the accounts with circuit breaker permissions.
+func (k Keeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+
+	var accounts []*types.GenesisAccountPermissions
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var perms types.Permissions
+		if err := k.cdc.Unmarshal(value, &perms); err != nil {
+			return err
+		}
+
+		// key is of format: <accAddrLen (1 Byte)><accAddr_Bytes>
+		accounts = append(accounts, &types.GenesisAccountPermissions{
+			Address:     sdk.AccAddress(key[1:]).String(),
+			Permissions: perms,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// DisabledList returns the list of disabled Msg type URLs.
+func (k Keeper) DisabledList(c context.Context, req *types.QueryDisabledListRequest) (*types.QueryDisabledListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDisabledListResponse{DisabledList: k.GetDisableList(ctx)}, nil
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	perms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, sendMsgTypeURL)
+	s.keeper.SetPermissions(s.ctx, s.addrs[0], *perms)
+	s.keeper.SetPermissions(s.ctx, s.addrs[1], *types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS))
+	s.keeper.DisableMsg(s.ctx, delegateMsgTypeURL)
+
+	account, err := s.queryClient.Account(gocontext.Background(), &types.QueryAccountRequest{Address: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(perms, account.Permission)
+
+	_, err = s.queryClient.Account(gocontext.Background(), &types.QueryAccountRequest{Address: "invalid"})
+	s.Require().Error(err)
+
+	accounts, err := s.queryClient.Accounts(gocontext.Background(), &types.QueryAccountsRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(accounts.Accounts, 1)
+	s.Require().Equal(uint64(2), accounts.Pagination.Total)
+
+	disabled, err := s.queryClient.DisabledList(gocontext.Background(), &types.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{delegateMsgTypeURL}, disabled.DisabledList)
+}
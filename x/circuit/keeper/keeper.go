@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of executing all the circuit breaker messages, as a
+	// super-admin. Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper constructs a new circuit Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/circuit module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetPermissions returns the circuit breaker permissions of an account. The
+// module authority always has super-admin permissions.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) types.Permissions {
+	if addr.String() == k.authority {
+		return types.Permissions{Level: types.Permissions_LEVEL_SUPER_ADMIN}
+	}
+
+	var perms types.Permissions
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountPermissionKey(addr))
+	if bz == nil {
+		return perms
+	}
+
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms
+}
+
+// SetPermissions sets the circuit breaker permissions of an account,
+// overwriting any existing ones. Setting LEVEL_NONE_UNSPECIFIED permissions
+// revokes all the permissions of the account.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == types.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(types.AccountPermissionKey(addr))
+		return
+	}
+
+	store.Set(types.AccountPermissionKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IteratePermissions iterates over the accounts with circuit breaker
+// permissions, and calls the provided callback for each of them. Iteration
+// stops when the callback returns true.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms types.Permissions) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AccountPermissionPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format: 0x01<accAddrLen (1 Byte)><accAddr_Bytes>
+		addr := sdk.AccAddress(iterator.Key()[len(types.AccountPermissionPrefix)+1:])
+
+		var perms types.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+		if cb(addr, perms) {
+			break
+		}
+	}
+}
+
+// IsAllowed returns true if the Msg type URL is not disabled. It implements
+// the baseapp.CircuitBreaker interface.
+func (k Keeper) IsAllowed(ctx sdk.Context, msgTypeURL string) bool {
+	return !ctx.KVStore(k.storeKey).Has(types.DisableListKey(msgTypeURL))
+}
+
+// DisableMsg trips the circuit breaker of a Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.DisableListKey(msgTypeURL), []byte{})
+}
+
+// EnableMsg resets the circuit breaker of a Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisableListKey(msgTypeURL))
+}
+
+// IterateDisableList iterates over the disabled Msg type URLs, and calls the
+// provided callback for each of them. Iteration stops when the callback
+// returns true.
+func (k Keeper) IterateDisableList(ctx sdk.Context, cb func(msgTypeURL string) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DisableListPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key()[len(types.DisableListPrefix):])) {
+			break
+		}
+	}
+}
+
+// GetDisableList returns all the disabled Msg type URLs.
+func (k Keeper) GetDisableList(ctx sdk.Context) []string {
+	msgTypeURLs := []string{}
+	k.IterateDisableList(ctx, func(msgTypeURL string) bool {
+		msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		return false
+	})
+
+	return msgTypeURLs
+}
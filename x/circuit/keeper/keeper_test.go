@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	sendMsgTypeURL     = "/cosmos.bank.v1beta1.MsgSend"
+	delegateMsgTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   sdk.AccAddress
+	addrs       []sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1})
+	encCfg := moduletestutil.MakeTestEncodingConfig(circuit.AppModuleBasic{})
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, s.authority.String())
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+
+	s.addrs = simtestutil.CreateIncrementalAccounts(3)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestPermissions() {
+	// the authority is always a super-admin
+	s.Require().Equal(types.Permissions_LEVEL_SUPER_ADMIN, s.keeper.GetPermissions(s.ctx, s.authority).Level)
+	s.Require().Equal(types.Permissions_LEVEL_NONE_UNSPECIFIED, s.keeper.GetPermissions(s.ctx, s.addrs[0]).Level)
+
+	perms := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, sendMsgTypeURL)
+	s.keeper.SetPermissions(s.ctx, s.addrs[0], *perms)
+	s.Require().Equal(*perms, s.keeper.GetPermissions(s.ctx, s.addrs[0]))
+
+	// setting no permissions removes the account
+	s.keeper.SetPermissions(s.ctx, s.addrs[0], types.Permissions{})
+	s.Require().Equal(types.Permissions_LEVEL_NONE_UNSPECIFIED, s.keeper.GetPermissions(s.ctx, s.addrs[0]).Level)
+
+	count := 0
+	s.keeper.IteratePermissions(s.ctx, func(sdk.AccAddress, types.Permissions) bool {
+		count++
+		return false
+	})
+	s.Require().Zero(count)
+}
+
+func (s *KeeperTestSuite) TestDisableList() {
+	s.Require().True(s.keeper.IsAllowed(s.ctx, sendMsgTypeURL))
+
+	s.keeper.DisableMsg(s.ctx, sendMsgTypeURL)
+	s.keeper.DisableMsg(s.ctx, delegateMsgTypeURL)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, sendMsgTypeURL))
+	s.Require().Equal([]string{sendMsgTypeURL, delegateMsgTypeURL}, s.keeper.GetDisableList(s.ctx))
+
+	s.keeper.EnableMsg(s.ctx, sendMsgTypeURL)
+	s.Require().True(s.keeper.IsAllowed(s.ctx, sendMsgTypeURL))
+	s.Require().Equal([]string{delegateMsgTypeURL}, s.keeper.GetDisableList(s.ctx))
+}
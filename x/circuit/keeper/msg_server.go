@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/circuit MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// AuthorizeCircuitBreaker implements the Msg/AuthorizeCircuitBreaker method.
+func (ms msgServer) AuthorizeCircuitBreaker(goCtx context.Context, req *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if ms.GetPermissions(ctx, granter).Level != types.Permissions_LEVEL_SUPER_ADMIN {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s must be a super-admin to grant permissions", req.Granter)
+	}
+
+	if req.Permissions == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPermissions, "permissions cannot be nil")
+	}
+
+	if err := req.Permissions.Validate(); err != nil {
+		return nil, err
+	}
+
+	ms.SetPermissions(ctx, grantee, *req.Permissions)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, req.Granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, req.Grantee),
+			sdk.NewAttribute(types.AttributeKeyLevel, req.Permissions.Level.String()),
+		),
+	)
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements the Msg/TripCircuitBreaker method.
+func (ms msgServer) TripCircuitBreaker(goCtx context.Context, req *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := ms.GetPermissions(ctx, authority)
+	for _, msgTypeURL := range req.MsgTypeUrls {
+		if types.IsCircuitMsgTypeURL(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMsgTypeURL, "%s cannot be disabled", msgTypeURL)
+		}
+
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to trip the circuit breaker of %s", req.Authority, msgTypeURL)
+		}
+
+		if !ms.IsAllowed(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMsgTypeURL, "%s is already disabled", msgTypeURL)
+		}
+
+		ms.DisableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements the Msg/ResetCircuitBreaker method.
+func (ms msgServer) ResetCircuitBreaker(goCtx context.Context, req *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(req.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := ms.GetPermissions(ctx, authority)
+
+	msgTypeURLs := req.MsgTypeUrls
+	if len(msgTypeURLs) == 0 {
+		// reset all the msg type urls the authority is allowed to
+		ms.IterateDisableList(ctx, func(msgTypeURL string) bool {
+			if perms.CanTrip(msgTypeURL) {
+				msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+			}
+
+			return false
+		})
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if !perms.CanTrip(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to reset the circuit breaker of %s", req.Authority, msgTypeURL)
+		}
+
+		if ms.IsAllowed(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMsgTypeURL, "%s is not disabled", msgTypeURL)
+		}
+
+		ms.EnableMsg(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
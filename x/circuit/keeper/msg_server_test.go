@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func (s *KeeperTestSuite) TestAuthorizeCircuitBreaker() {
+	admin, grantee := s.addrs[0].String(), s.addrs[1].String()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAuthorizeCircuitBreaker
+		expErr error
+	}{
+		{
+			name:   "granter is not a super-admin",
+			msg:    types.NewMsgAuthorizeCircuitBreaker(grantee, admin, types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS)),
+			expErr: types.ErrUnauthorized,
+		},
+		{
+			name:   "invalid permissions",
+			msg:    types.NewMsgAuthorizeCircuitBreaker(s.authority.String(), grantee, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS)),
+			expErr: types.ErrInvalidPermissions,
+		},
+		{
+			name: "authority grants super-admin",
+			msg:  types.NewMsgAuthorizeCircuitBreaker(s.authority.String(), admin, types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN)),
+		},
+		{
+			name: "super-admin grants some msgs",
+			msg:  types.NewMsgAuthorizeCircuitBreaker(admin, grantee, types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, sendMsgTypeURL)),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AuthorizeCircuitBreaker(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			grantee, err := sdk.AccAddressFromBech32(tc.msg.Grantee)
+			s.Require().NoError(err)
+			s.Require().Equal(*tc.msg.Permissions, s.keeper.GetPermissions(s.ctx, grantee))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTripAndResetCircuitBreaker() {
+	some, all := s.addrs[0], s.addrs[1]
+	s.keeper.SetPermissions(s.ctx, some, *types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, sendMsgTypeURL))
+	s.keeper.SetPermissions(s.ctx, all, *types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS))
+
+	// an account without permissions can't trip anything
+	_, err := s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(s.addrs[2].String(), []string{sendMsgTypeURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// an account limited to some msgs can't trip others
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(some.String(), []string{delegateMsgTypeURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// circuit msgs can never be disabled
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(s.authority.String(), []string{"/cosmos.circuit.v1.MsgResetCircuitBreaker"}))
+	s.Require().ErrorIs(err, types.ErrInvalidMsgTypeURL)
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(some.String(), []string{sendMsgTypeURL}))
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, sendMsgTypeURL))
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(all.String(), []string{sendMsgTypeURL}))
+	s.Require().ErrorIs(err, types.ErrInvalidMsgTypeURL, "already disabled")
+
+	_, err = s.msgServer.TripCircuitBreaker(s.ctx, types.NewMsgTripCircuitBreaker(all.String(), []string{delegateMsgTypeURL}))
+	s.Require().NoError(err)
+	s.Require().False(s.keeper.IsAllowed(s.ctx, delegateMsgTypeURL))
+
+	// resetting with an empty list only re-enables what the caller may trip
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, types.NewMsgResetCircuitBreaker(some.String(), nil))
+	s.Require().NoError(err)
+	s.Require().True(s.keeper.IsAllowed(s.ctx, sendMsgTypeURL))
+	s.Require().False(s.keeper.IsAllowed(s.ctx, delegateMsgTypeURL))
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, types.NewMsgResetCircuitBreaker(some.String(), []string{delegateMsgTypeURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, types.NewMsgResetCircuitBreaker(all.String(), []string{sendMsgTypeURL}))
+	s.Require().ErrorIs(err, types.ErrInvalidMsgTypeURL, "not disabled")
+
+	_, err = s.msgServer.ResetCircuitBreaker(s.ctx, types.NewMsgResetCircuitBreaker(all.String(), []string{delegateMsgTypeURL}))
+	s.Require().NoError(err)
+	s.Require().Empty(s.keeper.GetDisableList(s.ctx))
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 2, "invalid circuit breaker permissions")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 3, "account is not authorized")
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 4, "invalid msg type url")
+)
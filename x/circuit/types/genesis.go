@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(accountPermissions []GenesisAccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns a default circuit module genesis state, with no
+// authorized account nor disabled Msg type URL.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]GenesisAccountPermissions{}, []string{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.AccountPermissions))
+	for _, acc := range data.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(acc.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", acc.Address, err)
+		}
+
+		if seen[acc.Address] {
+			return fmt.Errorf("duplicate permissions for account %s", acc.Address)
+		}
+		seen[acc.Address] = true
+
+		if err := acc.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions for account %s: %w", acc.Address, err)
+		}
+	}
+
+	return ValidateMsgTypeURLs(data.DisabledTypeUrls)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the circuit module
+	ModuleName = "circuit"
+
+	// StoreKey is the default store key for circuit
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for circuit
+	RouterKey = ModuleName
+)
+
+// Keys for circuit store
+// Items are stored with the following key: values
+//
+// - 0x01<accAddrLen (1 Byte)><accAddr_Bytes>: Permissions
+//
+// - 0x02<msgTypeURL_Bytes>: []byte{}
+var (
+	AccountPermissionPrefix = []byte{0x01}
+	DisableListPrefix       = []byte{0x02}
+)
+
+// AccountPermissionKey returns the store key to retrieve the circuit breaker
+// permissions of an account.
+func AccountPermissionKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// DisableListKey returns the store key marking a Msg type URL as disabled.
+func DisableListKey(msgTypeURL string) []byte {
+	return append(DisableListPrefix, []byte(msgTypeURL)...)
+}
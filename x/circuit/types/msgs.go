@@ -0,0 +1,128 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance.
+func NewMsgAuthorizeCircuitBreaker(granter, grantee string, permissions *Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter,
+		Grantee:     grantee,
+		Permissions: permissions,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgAuthorizeCircuitBreaker message.
+func (m *MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Granter)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return sdkerrors.Wrap(err, "invalid granter address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.Wrap(err, "invalid grantee address")
+	}
+
+	if m.Permissions == nil {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "permissions cannot be nil")
+	}
+
+	return m.Permissions.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+func NewMsgTripCircuitBreaker(authority string, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTripCircuitBreaker message.
+func (m *MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.MsgTypeUrls) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "msg type urls cannot be empty")
+	}
+
+	for _, url := range m.MsgTypeUrls {
+		if IsCircuitMsgTypeURL(url) {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%s cannot be disabled", url)
+		}
+	}
+
+	return ValidateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// IsCircuitMsgTypeURL returns true if the Msg type URL is one of the circuit
+// module Msg's, which can't be disabled not to lock the circuit breaker.
+func IsCircuitMsgTypeURL(msgTypeURL string) bool {
+	switch msgTypeURL {
+	case sdk.MsgTypeURL(&MsgAuthorizeCircuitBreaker{}),
+		sdk.MsgTypeURL(&MsgTripCircuitBreaker{}),
+		sdk.MsgTypeURL(&MsgResetCircuitBreaker{}):
+		return true
+	}
+
+	return false
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+func NewMsgResetCircuitBreaker(authority string, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgResetCircuitBreaker message.
+func (m *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateMsgTypeURLs(m.MsgTypeUrls)
+}
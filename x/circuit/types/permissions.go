@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPermissions creates a new Permissions instance.
+func NewPermissions(level Permissions_Level, limitTypeURLs ...string) *Permissions {
+	return &Permissions{
+		Level:         level,
+		LimitTypeUrls: limitTypeURLs,
+	}
+}
+
+// Validate performs a basic validation of the permissions.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown level %d", p.Level)
+	}
+
+	switch p.Level {
+	case Permissions_LEVEL_SOME_MSGS:
+		if len(p.LimitTypeUrls) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "%s requires at least one msg type url", p.Level)
+		}
+	default:
+		if len(p.LimitTypeUrls) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "msg type urls can only be limited with %s", Permissions_LEVEL_SOME_MSGS)
+		}
+	}
+
+	return ValidateMsgTypeURLs(p.LimitTypeUrls)
+}
+
+// CanTrip returns true if the permissions allow to trip or reset the circuit
+// breaker of the given Msg type URL.
+func (p Permissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_SUPER_ADMIN, Permissions_LEVEL_ALL_MSGS:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, url := range p.LimitTypeUrls {
+			if url == msgTypeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateMsgTypeURLs checks that the given Msg type URLs are well formed and
+// unique.
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, url := range msgTypeURLs {
+		if !strings.HasPrefix(url, "/") || len(url) == 1 {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%q must start with a /", url)
+		}
+
+		if seen[url] {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "duplicate msg type url %s", url)
+		}
+		seen[url] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestPermissionsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		perms  *types.Permissions
+		expErr bool
+	}{
+		{"none", types.NewPermissions(types.Permissions_LEVEL_NONE_UNSPECIFIED), false},
+		{"super admin", types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN), false},
+		{"all msgs", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS), false},
+		{"some msgs", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, "/cosmos.bank.v1beta1.MsgSend"), false},
+		{"some msgs without urls", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS), true},
+		{"all msgs with urls", types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS, "/cosmos.bank.v1beta1.MsgSend"), true},
+		{"malformed url", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, "cosmos.bank.v1beta1.MsgSend"), true},
+		{"duplicate url", types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, "/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"), true},
+		{"unknown level", types.NewPermissions(types.Permissions_Level(42)), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.perms.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPermissionsCanTrip(t *testing.T) {
+	send, delegate := "/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"
+
+	require.False(t, types.NewPermissions(types.Permissions_LEVEL_NONE_UNSPECIFIED).CanTrip(send))
+	require.True(t, types.NewPermissions(types.Permissions_LEVEL_ALL_MSGS).CanTrip(send))
+	require.True(t, types.NewPermissions(types.Permissions_LEVEL_SUPER_ADMIN).CanTrip(send))
+
+	some := types.NewPermissions(types.Permissions_LEVEL_SOME_MSGS, send)
+	require.True(t, some.CanTrip(send))
+	require.False(t, some.CanTrip(delegate))
+}
@@ -0,0 +1,25 @@
+package epoching
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the messages buffered during the current epoch when the
+// current block is the last one of the epoch.
+//
+// NOTE: it must run before the staking EndBlocker so that the validator set
+// updates caused by the buffered messages are applied at the epoch boundary.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochBoundary(ctx) {
+		return
+	}
+
+	k.ExecuteEpochMsgs(ctx)
+}
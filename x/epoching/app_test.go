@@ -0,0 +1,76 @@
+package epoching_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestUnwrappedStakingMsgs(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	genCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(42, sdk.DefaultPowerReduction))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+
+	startupCfg := sims.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = []sims.GenesisAccount{
+		{GenesisAccount: &authtypes.BaseAccount{Address: addr.String()}, Coins: sdk.Coins{genCoin}},
+	}
+
+	var (
+		stakingKeeper  *stakingkeeper.Keeper
+		epochingKeeper keeper.Keeper
+	)
+	app, err := sims.SetupWithConfiguration(testutil.AppConfig, startupCfg, &stakingKeeper, &epochingKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	valAddr := stakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	delegateMsg := stakingtypes.NewMsgDelegate(addr, valAddr, bondCoin)
+	wrappedMsg := types.NewMsgWrappedDelegate(delegateMsg)
+	txConfig := moduletestutil.MakeTestEncodingConfig().TxConfig
+
+	// staking msgs are executed immediately while epoching is disabled
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = sims.SignCheckDeliver(t, txConfig, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{0}, []uint64{0}, true, true, priv)
+	require.NoError(t, err)
+
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = sims.SignCheckDeliver(t, txConfig, app.BaseApp, header, []sdk.Msg{wrappedMsg}, "", []uint64{0}, []uint64{1}, false, false, priv)
+	require.ErrorIs(t, err, types.ErrEpochingDisabled)
+
+	// enable epoching
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx = app.BaseApp.NewContext(false, header)
+	require.NoError(t, epochingKeeper.SetParams(ctx, types.NewParams(types.DefaultEpochLength, true)))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	// unwrapped staking msgs are rejected by the AnteHandler
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = sims.SignCheckDeliver(t, txConfig, app.BaseApp, header, []sdk.Msg{delegateMsg}, "", []uint64{0}, []uint64{2}, false, false, priv)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// wrapped staking msgs are buffered
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = sims.SignCheckDeliver(t, txConfig, app.BaseApp, header, []sdk.Msg{wrappedMsg}, "", []uint64{0}, []uint64{2}, true, true, priv)
+	require.NoError(t, err)
+
+	ctx = app.BaseApp.NewContext(true, tmproto.Header{})
+	require.Len(t, epochingKeeper.GetQueuedMessages(ctx), 1)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// BufferMsg escrows the tokens bonded by the given staking message in the
+// EpochDelegationPool and queues the message for execution at the end of the
+// current epoch. It returns the action ID of the buffered message.
+//
+// Messages can only be buffered when epoching is enabled, otherwise the
+// staking messages must be sent unwrapped.
+func (k Keeper) BufferMsg(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+	if !k.GetParams(ctx).Enabled {
+		return 0, sdkerrors.Wrapf(types.ErrEpochingDisabled, "%s must be sent unwrapped", sdk.MsgTypeURL(msg))
+	}
+
+	if err := types.ValidateQueuedMsg(msg); err != nil {
+		return 0, err
+	}
+
+	if delAddr, coins := types.EscrowedCoins(msg); !coins.Empty() {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		for _, coin := range coins {
+			if coin.Denom != bondDenom {
+				return 0, sdkerrors.Wrapf(types.ErrBadDenom, "got %s, expected %s", coin.Denom, bondDenom)
+			}
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+	}
+
+	epochNumber := k.GetEpochNumber(ctx)
+	actionID := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueMsg,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", actionID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		),
+	)
+
+	return actionID, nil
+}
+
+// ExecuteEpochMsgs executes the messages buffered for the current epoch in the
+// order they were queued, then moves on to the next epoch.
+//
+// Tokens escrowed for a message are released back to the delegator right
+// before the message is executed. Each message is executed in its own cached
+// context: if it fails, none of its state mutations are written, which leaves
+// the released tokens with the delegator.
+func (k Keeper) ExecuteEpochMsgs(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	epochNumber := k.GetEpochNumber(ctx)
+
+	// collect the epoch's messages first as executing them mutates the queue
+	type queuedMsg struct {
+		actionID uint64
+		msg      sdk.Msg
+	}
+
+	var queue []queuedMsg
+	k.IterateEpochMsgs(ctx, epochNumber, func(actionID uint64, msg sdk.Msg) bool {
+		queue = append(queue, queuedMsg{actionID: actionID, msg: msg})
+		return false
+	})
+
+	for _, qm := range queue {
+		k.DeleteEpochMsg(ctx, epochNumber, qm.actionID)
+
+		// release escrowed tokens, a failure here means the pool is corrupted
+		if delAddr, coins := types.EscrowedCoins(qm.msg); !coins.Empty() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, coins); err != nil {
+				panic(fmt.Errorf("failed to release escrowed tokens of queued message %d: %w", qm.actionID, err))
+			}
+		}
+
+		msgTypeURL := sdk.MsgTypeURL(qm.msg)
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+			sdk.NewAttribute(types.AttributeKeyActionID, fmt.Sprintf("%d", qm.actionID)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+		}
+
+		if err := k.executeMsg(ctx, qm.msg); err != nil {
+			logger.Info(
+				"queued message failed on execution",
+				"epoch", epochNumber,
+				"action", qm.actionID,
+				"msg", msgTypeURL,
+				"err", err,
+			)
+
+			attrs = append(attrs,
+				sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueFailure),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			)
+		} else {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyResult, types.AttributeValueSuccess))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteMsg, attrs...))
+	}
+
+	k.IncreaseEpochNumber(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEndEpoch,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epochNumber)),
+		),
+	)
+}
+
+// executeMsg routes a buffered message to its handler. Messages may mutate
+// state thus we use a cached context; state is only written if the handler
+// succeeds.
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) error {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	// the handler result holds the events emitted by the message, re-emit them
+	// to merge them into the original Context's EventManager.
+	events := make([]sdk.Event, 0, len(res.Events))
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+
+	ctx.EventManager().EmitEvents(events)
+	writeCache()
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	k.SetEpochNumber(ctx, data.EpochNumber)
+
+	nextActionID := types.DefaultEpochActionID
+	for _, qm := range data.QueuedMessages {
+		if err := k.SetQueuedMessage(ctx, qm); err != nil {
+			panic(err)
+		}
+
+		if qm.ActionId >= nextActionID {
+			nextActionID = qm.ActionId + 1
+		}
+	}
+	k.setNextActionID(ctx, nextActionID)
+
+	// ensure the EpochDelegationPool module account is set
+	k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), k.GetQueuedMessages(ctx))
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	require := s.Require()
+	msg := stakingtypes.NewMsgUndelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(10)))
+
+	qm1, err := types.NewQueuedMessage(3, 7, msg)
+	require.NoError(err)
+	qm2, err := types.NewQueuedMessage(4, 2, msg)
+	require.NoError(err)
+
+	genState := types.NewGenesisState(types.NewParams(5, true), 3, []types.QueuedMessage{qm1, qm2})
+	require.NoError(types.ValidateGenesis(*genState))
+
+	s.epochingKeeper.InitGenesis(s.ctx, genState)
+	exported := s.epochingKeeper.ExportGenesis(s.ctx)
+
+	require.Equal(genState.Params, exported.Params)
+	require.Equal(genState.EpochNumber, exported.EpochNumber)
+	require.Len(exported.QueuedMessages, 2)
+	for i, qm := range exported.QueuedMessages {
+		require.Equal(genState.QueuedMessages[i].EpochNumber, qm.EpochNumber)
+		require.Equal(genState.QueuedMessages[i].ActionId, qm.ActionId)
+
+		sdkMsg, err := qm.GetSdkMsg()
+		require.NoError(err)
+		require.Equal(msg, sdkMsg)
+	}
+
+	// new action IDs must not collide with the imported ones
+	require.Equal(uint64(8), s.epochingKeeper.GetNewActionID(s.ctx))
+}
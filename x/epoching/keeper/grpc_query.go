@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the current epoch number and the height at which it ends.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		CurrentEpoch:  k.GetEpochNumber(ctx),
+		EpochBoundary: k.GetEpochBoundary(ctx),
+	}, nil
+}
+
+// EpochMsgs returns the messages buffered for the given epoch.
+func (k Keeper) EpochMsgs(c context.Context, req *types.QueryEpochMsgsRequest) (*types.QueryEpochMsgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionsPrefix(req.EpochNumber))
+
+	var msgs []types.QueuedMessage
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		qm, err := types.NewQueuedMessage(req.EpochNumber, sdk.BigEndianToUint64(key), k.mustUnmarshalMsg(value))
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, qm)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochMsgsResponse{Msgs: msgs, Pagination: pageRes}, nil
+}
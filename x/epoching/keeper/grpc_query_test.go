@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCParams() {
+	params := types.NewParams(42, true)
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, params))
+
+	res, err := s.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestGRPCCurrentEpoch() {
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, types.NewParams(10, true)))
+	s.epochingKeeper.SetEpochNumber(s.ctx, 3)
+
+	res, err := s.queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), res.CurrentEpoch)
+	s.Require().Equal(int64(10), res.EpochBoundary)
+}
+
+func (s *KeeperTestSuite) TestGRPCEpochMsgs() {
+	msg := stakingtypes.NewMsgUndelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(10)))
+	for i := 0; i < 3; i++ {
+		s.epochingKeeper.QueueMsgForEpoch(s.ctx, 1, msg)
+	}
+	s.epochingKeeper.QueueMsgForEpoch(s.ctx, 2, msg)
+
+	res, err := s.queryClient.EpochMsgs(gocontext.Background(), &types.QueryEpochMsgsRequest{
+		EpochNumber: 1,
+		Pagination:  &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 2)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().Equal(uint64(1), res.Msgs[0].ActionId)
+	s.Require().Equal(uint64(2), res.Msgs[1].ActionId)
+
+	sdkMsg, err := res.Msgs[0].GetSdkMsg()
+	s.Require().NoError(err)
+	s.Require().Equal(msg, sdkMsg)
+
+	res, err = s.queryClient.EpochMsgs(gocontext.Background(), &types.QueryEpochMsgsRequest{EpochNumber: 2})
+	s.Require().NoError(err)
+	s.Require().Len(res.Msgs, 1)
+	s.Require().Equal(uint64(2), res.Msgs[0].EpochNumber)
+	s.Require().Equal(uint64(4), res.Msgs[0].ActionId)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// RegisterInvariants registers the epoching module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "epoch-delegation-pool", EpochDelegationPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "queued-msgs", QueuedMsgsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/epoching module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := EpochDelegationPoolInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return QueuedMsgsInvariant(k)(ctx)
+	}
+}
+
+// EpochDelegationPoolInvariant checks that the EpochDelegationPool holds
+// exactly the sum of the tokens escrowed by the buffered messages. In
+// particular, the pool must be empty when no message is buffered.
+func EpochDelegationPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		k.IterateQueuedMessages(ctx, func(_, _ uint64, msg sdk.Msg) bool {
+			if _, coins := types.EscrowedCoins(msg); !coins.Empty() {
+				expected = expected.Add(coins...)
+			}
+
+			return false
+		})
+
+		poolBalance := k.bankKeeper.GetAllBalances(ctx, k.authKeeper.GetModuleAddress(types.ModuleName))
+		broken := !poolBalance.IsAllGTE(expected) || !expected.IsAllGTE(poolBalance)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "epoch-delegation-pool",
+			fmt.Sprintf("\tpool balance: %s\n\tsum of escrowed tokens: %s\n", poolBalance, expected),
+		), broken
+	}
+}
+
+// QueuedMsgsInvariant checks that no buffered message belongs to an epoch that
+// has already ended.
+func QueuedMsgsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		epochNumber := k.GetEpochNumber(ctx)
+		k.IterateQueuedMessages(ctx, func(msgEpoch, actionID uint64, queued sdk.Msg) bool {
+			if msgEpoch < epochNumber {
+				count++
+				msg += fmt.Sprintf("\tmessage %d (%s) belongs to past epoch %d\n", actionID, sdk.MsgTypeURL(queued), msgEpoch)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "queued-msgs",
+			fmt.Sprintf("amount of stale queued messages found %d\n%s", count, msg),
+		), broken
+	}
+}
@@ -1,174 +1,244 @@
 package keeper
 
 import (
-	"time"
+	"fmt"
 
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
-	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
 )
 
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
-)
-
-// Keeper of the store
+// Keeper of the epoching store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
-	// Used to calculate the estimated next epoch time.
-	// This is local to every node
-	// TODO: remove in favor of consensus param when its added
-	commitTimeout time.Duration
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
+
+	// Msg server router used to execute buffered messages
+	router *baseapp.MsgServiceRouter
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	router *baseapp.MsgServiceRouter,
+	authority string,
+) Keeper {
+	// ensure the EpochDelegationPool module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
-		commitTimeout: commitTimeout,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
+		router:        router,
+		authority:     authority,
 	}
 }
 
-// GetNewActionID returns ID to be used for next epoch
-func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+// GetAuthority returns the x/epoching module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsEpochedMsg returns true if epoching is enabled and the given message must
+// be buffered until the end of the epoch, i.e. it is only accepted wrapped in
+// an x/epoching message.
+func (k Keeper) IsEpochedMsg(ctx sdk.Context, msg sdk.Msg) bool {
+	return k.GetParams(ctx).Enabled && types.IsEpochedMsg(msg)
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/epoching module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
 
-	bz := store.Get(NextEpochActionID)
+// GetParams returns the current x/epoching module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
 	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetNewActionID returns ID to be used for the next buffered message
+func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	id := types.DefaultEpochActionID
+	if bz := store.Get(types.NextEpochActionIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-	id := sdk.BigEndianToUint64(bz)
 
 	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	store.Set(types.NextEpochActionIDKey, sdk.Uint64ToBigEndian(id+1))
 
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+// setNextActionID sets the ID to be used for the next buffered message
+func (k Keeper) setNextActionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextEpochActionIDKey, sdk.Uint64ToBigEndian(id))
 }
 
-// QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
-	store := ctx.KVStore(k.storeKey)
+// QueueMsgForEpoch saves a message that needs to be executed at the end of the
+// given epoch and returns its action ID.
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber uint64, msg sdk.Msg) uint64 {
+	actionID := k.GetNewActionID(ctx)
+	k.setEpochMsg(ctx, epochNumber, actionID, msg)
 
-	bz, err := k.cdc.MarshalInterface(msg)
+	return actionID
+}
+
+// SetQueuedMessage restores a message previously buffered with
+// QueueMsgForEpoch, e.g. from genesis.
+func (k Keeper) SetQueuedMessage(ctx sdk.Context, qm types.QueuedMessage) error {
+	msg, err := qm.GetSdkMsg()
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	k.setEpochMsg(ctx, qm.EpochNumber, qm.ActionId, msg)
+
+	return nil
 }
 
-// RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
+func (k Keeper) setEpochMsg(ctx sdk.Context, epochNumber, actionID uint64, msg sdk.Msg) {
 	store := ctx.KVStore(k.storeKey)
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
+	bz, err := k.cdc.MarshalInterface(msg)
 	if err != nil {
 		panic(err)
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	store.Set(types.ActionStoreKey(epochNumber, actionID), bz)
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// GetEpochMsg gets a buffered msg by epoch number and action ID
+func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber, actionID uint64) sdk.Msg {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
 		return nil
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	return k.mustUnmarshalMsg(bz)
+}
 
-	return action
+// DeleteEpochMsg deletes a buffered msg by epoch number and action ID
+func (k Keeper) DeleteEpochMsg(ctx sdk.Context, epochNumber, actionID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ActionStoreKey(epochNumber, actionID))
 }
 
-// GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
-	iterator := k.GetEpochActionsIterator(ctx)
+// IterateEpochMsgs iterates over the messages buffered for the given epoch in
+// the order they were queued, and calls the provided callback for each of them.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateEpochMsgs(ctx sdk.Context, epochNumber uint64, cb func(actionID uint64, msg sdk.Msg) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionsPrefix(epochNumber))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		_, actionID := types.SplitActionStoreKey(iterator.Key())
+		if cb(actionID, k.mustUnmarshalMsg(iterator.Value())) {
+			break
+		}
 	}
-
-	return actions
 }
 
-// GetEpochActionsIterator returns iterator for EpochActions
-func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
-}
-
-// DequeueEpochActions dequeue all the actions store on epoch
-func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+// IterateQueuedMessages iterates over all the buffered messages ordered by
+// epoch number and action ID, and calls the provided callback for each of
+// them. Iteration stops when the callback returns true.
+func (k Keeper) IterateQueuedMessages(ctx sdk.Context, cb func(epochNumber, actionID uint64, msg sdk.Msg) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		store.Delete(key)
+		epochNumber, actionID := types.SplitActionStoreKey(iterator.Key())
+		if cb(epochNumber, actionID, k.mustUnmarshalMsg(iterator.Value())) {
+			break
+		}
 	}
 }
 
-// DeleteByKey delete item by key
-func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(key)
-}
+// GetQueuedMessages returns all the buffered messages.
+func (k Keeper) GetQueuedMessages(ctx sdk.Context) []types.QueuedMessage {
+	queuedMsgs := []types.QueuedMessage{}
+	k.IterateQueuedMessages(ctx, func(epochNumber, actionID uint64, msg sdk.Msg) bool {
+		qm, err := types.NewQueuedMessage(epochNumber, actionID, msg)
+		if err != nil {
+			panic(err)
+		}
 
-// GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
+		queuedMsgs = append(queuedMsgs, qm)
+		return false
+	})
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	return queuedMsgs
+}
 
-	return action
+func (k Keeper) mustUnmarshalMsg(bz []byte) sdk.Msg {
+	var msg sdk.Msg
+	if err := k.cdc.UnmarshalInterface(bz, &msg); err != nil {
+		panic(err)
+	}
+
+	return msg
 }
 
 // SetEpochNumber set epoch number
-func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
+func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberKey, sdk.Uint64ToBigEndian(epochNumber))
 }
 
 // GetEpochNumber fetches epoch number
-func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
+func (k Keeper) GetEpochNumber(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberKey)
 	if bz == nil {
-		return DefaultEpochNumber
+		return types.DefaultEpochNumber
 	}
 
-	return int64(sdk.BigEndianToUint64(bz))
+	return sdk.BigEndianToUint64(bz)
 }
 
 // IncreaseEpochNumber increases epoch number
@@ -177,16 +247,21 @@ func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
 	k.SetEpochNumber(ctx, epochNumber+1)
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
+// GetEpochBoundary returns the height of the block at the end of which the
+// current epoch ends.
+func (k Keeper) GetEpochBoundary(ctx sdk.Context) int64 {
+	epochLength := int64(k.GetParams(ctx).EpochLength)
 	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
-}
 
-// GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
-	currentTime := ctx.BlockTime()
-	currentHeight := ctx.BlockHeight()
+	if epochLength == 0 || currentHeight%epochLength == 0 {
+		return currentHeight
+	}
+
+	return currentHeight + (epochLength - currentHeight%epochLength)
+}
 
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+// IsEpochBoundary returns true if the current block is the last one of the
+// current epoch.
+func (k Keeper) IsEpochBoundary(ctx sdk.Context) bool {
+	return k.GetEpochBoundary(ctx) == ctx.BlockHeight()
 }
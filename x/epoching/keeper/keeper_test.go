@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+	addrs       []sdk.AccAddress
+	valAddrs    []sdk.ValAddress
+	bondDenom   string
+
+	interfaceRegistry codectypes.InterfaceRegistry
+	accountKeeper     authkeeper.AccountKeeper
+	bankKeeper        bankkeeper.Keeper
+	stakingKeeper     *stakingkeeper.Keeper
+	epochingKeeper    keeper.Keeper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&s.interfaceRegistry,
+		&s.accountKeeper,
+		&s.bankKeeper,
+		&s.stakingKeeper,
+		&s.epochingKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, types.NewParams(types.DefaultEpochLength, true)))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.interfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.epochingKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.msgServer = keeper.NewMsgServerImpl(s.epochingKeeper)
+
+	s.addrs = simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, s.ctx, 3, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	s.valAddrs = simtestutil.ConvertAddrsToValAddrs(s.addrs)
+	s.bondDenom = s.stakingKeeper.BondDenom(s.ctx)
+
+	// the first address is a validator created outside of the epoching flow
+	tstaking := teststaking.NewHelper(s.T(), s.ctx, s.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(s.valAddrs[0], simtestutil.CreateTestPubKeys(1)[0], sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction), true)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) poolBalance() sdk.Coins {
+	return s.bankKeeper.GetAllBalances(s.ctx, s.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+func (s *KeeperTestSuite) endEpoch() {
+	s.ctx = s.ctx.WithBlockHeight(s.epochingKeeper.GetEpochBoundary(s.ctx))
+	epoching.EndBlocker(s.ctx, s.epochingKeeper)
+}
+
+func (s *KeeperTestSuite) TestActionIDs() {
+	msg := stakingtypes.NewMsgUndelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(1)))
+
+	s.Require().Equal(uint64(1), s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg))
+	s.Require().Equal(uint64(2), s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg))
+	s.Require().Equal(uint64(3), s.epochingKeeper.QueueMsgForEpoch(s.ctx, 300, msg))
+
+	// epoch numbers and action IDs above 255 must not collide
+	s.Require().NotNil(s.epochingKeeper.GetEpochMsg(s.ctx, 300, 3))
+	s.Require().Nil(s.epochingKeeper.GetEpochMsg(s.ctx, 44, 3))
+	s.Require().Len(s.epochingKeeper.GetQueuedMessages(s.ctx), 3)
+
+	s.epochingKeeper.DeleteEpochMsg(s.ctx, 300, 3)
+	s.Require().Nil(s.epochingKeeper.GetEpochMsg(s.ctx, 300, 3))
+}
+
+func (s *KeeperTestSuite) TestEpochBoundary() {
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, types.NewParams(10, true)))
+
+	testCases := []struct {
+		height   int64
+		boundary int64
+	}{
+		{1, 10},
+		{9, 10},
+		{10, 10},
+		{11, 20},
+	}
+
+	for _, tc := range testCases {
+		ctx := s.ctx.WithBlockHeight(tc.height)
+		s.Require().Equal(tc.boundary, s.epochingKeeper.GetEpochBoundary(ctx))
+		s.Require().Equal(tc.height == tc.boundary, s.epochingKeeper.IsEpochBoundary(ctx))
+	}
+}
+
+func (s *KeeperTestSuite) TestDelegateAtEpochEnd() {
+	require := s.Require()
+	amount := sdk.NewCoin(s.bondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction))
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom)
+
+	res, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], amount),
+	))
+	require.NoError(err)
+	require.Equal(uint64(1), res.ActionId)
+
+	// tokens are escrowed but not delegated yet
+	require.Equal(sdk.NewCoins(amount), s.poolBalance())
+	require.Equal(balanceBefore.Sub(amount), s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom))
+	_, found := s.stakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddrs[0])
+	require.False(found)
+
+	_, broken := keeper.AllInvariants(s.epochingKeeper)(s.ctx)
+	require.False(broken)
+
+	// nothing happens before the epoch boundary
+	epoching.EndBlocker(s.ctx, s.epochingKeeper)
+	require.Equal(uint64(0), s.epochingKeeper.GetEpochNumber(s.ctx))
+
+	s.endEpoch()
+	require.Equal(uint64(1), s.epochingKeeper.GetEpochNumber(s.ctx))
+	require.True(s.poolBalance().IsZero())
+	require.Empty(s.epochingKeeper.GetQueuedMessages(s.ctx))
+	require.Equal(balanceBefore.Sub(amount), s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom))
+	_, found = s.stakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddrs[0])
+	require.True(found)
+
+	_, broken = keeper.AllInvariants(s.epochingKeeper)(s.ctx)
+	require.False(broken)
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorAndDelegateSameEpoch() {
+	require := s.Require()
+	tstaking := teststaking.NewHelper(s.T(), s.ctx, s.stakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+
+	createMsg := tstaking.CreateValidatorMsg(s.valAddrs[2], simtestutil.CreateTestPubKeys(2)[1], sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+	createMsg.Description = stakingtypes.NewDescription("moniker", "", "", "", "")
+	_, err := s.msgServer.WrappedCreateValidator(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedCreateValidator(createMsg))
+	require.NoError(err)
+
+	// delegating to a validator that only exists once the epoch ends is allowed
+	amount := sdk.NewCoin(s.bondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction))
+	_, err = s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[2], amount),
+	))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(createMsg.Value.Add(amount)), s.poolBalance())
+
+	s.endEpoch()
+	_, found := s.stakingKeeper.GetValidator(s.ctx, s.valAddrs[2])
+	require.True(found)
+	_, found = s.stakingKeeper.GetDelegation(s.ctx, s.addrs[1], s.valAddrs[2])
+	require.True(found)
+	require.True(s.poolBalance().IsZero())
+}
+
+func (s *KeeperTestSuite) TestFailedMsgRefundsEscrow() {
+	require := s.Require()
+	amount := sdk.NewCoin(s.bondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction))
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom)
+
+	// the validator never gets created, so the delegation fails on execution
+	_, err := s.msgServer.WrappedDelegate(sdk.WrapSDKContext(s.ctx), types.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[2], amount),
+	))
+	require.NoError(err)
+	require.Equal(balanceBefore.Sub(amount), s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom))
+
+	s.endEpoch()
+	require.Equal(balanceBefore, s.bankKeeper.GetBalance(s.ctx, s.addrs[1], s.bondDenom))
+	require.True(s.poolBalance().IsZero())
+	require.Empty(s.epochingKeeper.GetQueuedMessages(s.ctx))
+
+	var found bool
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeExecuteMsg {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyResult {
+				require.Equal(types.AttributeValueFailure, string(attr.Value))
+				found = true
+			}
+		}
+	}
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestBufferMsgInvalid() {
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			"bad denom",
+			stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin("foo", math.NewInt(10))),
+			types.ErrBadDenom,
+		},
+		{
+			"not a staking msg",
+			types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(10)))),
+			types.ErrInvalidQueuedMsg,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.epochingKeeper.BufferMsg(s.ctx, tc.msg)
+			s.Require().ErrorIs(err, tc.err)
+			s.Require().Empty(s.epochingKeeper.GetQueuedMessages(s.ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBufferMsgDisabled() {
+	msg := stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(10)))
+	s.Require().True(s.epochingKeeper.IsEpochedMsg(s.ctx, msg))
+
+	s.Require().NoError(s.epochingKeeper.SetParams(s.ctx, types.NewParams(types.DefaultEpochLength, false)))
+	s.Require().False(s.epochingKeeper.IsEpochedMsg(s.ctx, msg))
+
+	_, err := s.epochingKeeper.BufferMsg(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrEpochingDisabled)
+	s.Require().Empty(s.epochingKeeper.GetQueuedMessages(s.ctx))
+	s.Require().True(s.poolBalance().Empty())
+}
+
+func (s *KeeperTestSuite) TestInvariants() {
+	require := s.Require()
+
+	// a message buffered without escrowing its tokens breaks the pool invariant
+	msg := stakingtypes.NewMsgDelegate(s.addrs[1], s.valAddrs[0], sdk.NewCoin(s.bondDenom, math.NewInt(10)))
+	s.epochingKeeper.QueueMsgForEpoch(s.ctx, 0, msg)
+	_, broken := keeper.EpochDelegationPoolInvariant(s.epochingKeeper)(s.ctx)
+	require.True(broken)
+	_, broken = keeper.QueuedMsgsInvariant(s.epochingKeeper)(s.ctx)
+	require.False(broken)
+
+	// a message left behind in an ended epoch breaks the queue invariant
+	s.epochingKeeper.SetEpochNumber(s.ctx, 1)
+	_, broken = keeper.QueuedMsgsInvariant(s.epochingKeeper)(s.ctx)
+	require.True(broken)
+}
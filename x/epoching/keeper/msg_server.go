@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epoching MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// WrappedCreateValidator buffers a MsgCreateValidator and escrows its self-delegation.
+func (ms msgServer) WrappedCreateValidator(goCtx context.Context, req *types.MsgWrappedCreateValidator) (*types.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actionID, err := ms.BufferMsg(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedCreateValidatorResponse{ActionId: actionID}, nil
+}
+
+// WrappedEditValidator buffers a MsgEditValidator.
+func (ms msgServer) WrappedEditValidator(goCtx context.Context, req *types.MsgWrappedEditValidator) (*types.MsgWrappedEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actionID, err := ms.BufferMsg(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedEditValidatorResponse{ActionId: actionID}, nil
+}
+
+// WrappedDelegate buffers a MsgDelegate and escrows the delegated amount.
+func (ms msgServer) WrappedDelegate(goCtx context.Context, req *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actionID, err := ms.BufferMsg(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedDelegateResponse{ActionId: actionID}, nil
+}
+
+// WrappedBeginRedelegate buffers a MsgBeginRedelegate.
+func (ms msgServer) WrappedBeginRedelegate(goCtx context.Context, req *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actionID, err := ms.BufferMsg(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedBeginRedelegateResponse{ActionId: actionID}, nil
+}
+
+// WrappedUndelegate buffers a MsgUndelegate.
+func (ms msgServer) WrappedUndelegate(goCtx context.Context, req *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	actionID, err := ms.BufferMsg(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrappedUndelegateResponse{ActionId: actionID}, nil
+}
+
+// UpdateParams updates the x/epoching module parameters.
+func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidEpochLength = sdkerrors.Register(ModuleName, 2, "invalid epoch length")
+	ErrInvalidQueuedMsg   = sdkerrors.Register(ModuleName, 3, "invalid queued message")
+	ErrBadDenom           = sdkerrors.Register(ModuleName, 4, "invalid coin denomination")
+	ErrEpochingDisabled   = sdkerrors.Register(ModuleName, 5, "epoching is disabled")
+)
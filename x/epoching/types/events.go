@@ -0,0 +1,18 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeQueueMsg   = "queue_msg"
+	EventTypeExecuteMsg = "execute_queued_msg"
+	EventTypeEndEpoch   = "end_epoch"
+
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeyResult      = "result"
+	AttributeKeyError       = "error"
+
+	AttributeValueCategory = ModuleName
+	AttributeValueSuccess  = "success"
+	AttributeValueFailure  = "failure"
+)
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber uint64, queuedMsgs []QueuedMessage) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		EpochNumber:    epochNumber,
+		QueuedMessages: queuedMsgs,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, []QueuedMessage{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool, len(data.QueuedMessages))
+	for _, qm := range data.QueuedMessages {
+		if qm.EpochNumber < data.EpochNumber {
+			return fmt.Errorf("queued message %d belongs to past epoch %d", qm.ActionId, qm.EpochNumber)
+		}
+
+		if qm.ActionId < DefaultEpochActionID {
+			return fmt.Errorf("invalid queued message action ID %d", qm.ActionId)
+		}
+
+		if seen[qm.ActionId] {
+			return fmt.Errorf("duplicate queued message action ID %d", qm.ActionId)
+		}
+		seen[qm.ActionId] = true
+
+		msg, err := qm.GetSdkMsg()
+		if err != nil {
+			return err
+		}
+
+		if err := ValidateQueuedMsg(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, qm := range data.QueuedMessages {
+		if err := qm.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
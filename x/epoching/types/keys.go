@@ -0,0 +1,64 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the epoching module. The module account of
+	// the same name is the EpochDelegationPool holding escrowed delegations.
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for epoching
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for epoching
+	RouterKey = ModuleName
+)
+
+const (
+	// DefaultEpochActionID is the ID assigned to the first buffered message.
+	DefaultEpochActionID uint64 = 1
+
+	// DefaultEpochNumber is the number of the first epoch.
+	DefaultEpochNumber uint64 = 0
+)
+
+// Keys for epoching store
+// Items are stored with the following key: values
+//
+// - 0x01: Params
+//
+// - 0x11: nextActionID
+//
+// - 0x12: epochNumber
+//
+// - 0x13<epochNumber_Bytes><actionID_Bytes>: Any(sdk.Msg)
+var (
+	ParamsKey              = []byte{0x01}
+	NextEpochActionIDKey   = []byte{0x11}
+	EpochNumberKey         = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13}
+)
+
+// EpochActionsPrefix returns the store prefix of all the messages buffered for
+// the given epoch.
+func EpochActionsPrefix(epochNumber uint64) []byte {
+	return append(append([]byte{}, EpochActionQueuePrefix...), sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// ActionStoreKey returns the store key of a buffered message.
+func ActionStoreKey(epochNumber, actionID uint64) []byte {
+	return append(EpochActionsPrefix(epochNumber), sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// SplitActionStoreKey returns the epoch number and action ID encoded in the
+// given action store key.
+func SplitActionStoreKey(key []byte) (epochNumber, actionID uint64) {
+	key = key[len(EpochActionQueuePrefix):]
+	if len(key) != 16 {
+		panic("unexpected action store key length")
+	}
+
+	return sdk.BigEndianToUint64(key[:8]), sdk.BigEndianToUint64(key[8:])
+}
@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+func TestActionStoreKey(t *testing.T) {
+	testCases := []struct {
+		epochNumber uint64
+		actionID    uint64
+	}{
+		{0, 1},
+		{256, 1},
+		{1, 256},
+		{^uint64(0), ^uint64(0)},
+	}
+
+	for _, tc := range testCases {
+		key := types.ActionStoreKey(tc.epochNumber, tc.actionID)
+		require.Len(t, key, 17)
+		require.Equal(t, types.EpochActionsPrefix(tc.epochNumber), key[:9])
+
+		epochNumber, actionID := types.SplitActionStoreKey(key)
+		require.Equal(t, tc.epochNumber, epochNumber)
+		require.Equal(t, tc.actionID, actionID)
+	}
+
+	require.Panics(t, func() { types.SplitActionStoreKey(types.EpochActionsPrefix(1)) })
+}
@@ -0,0 +1,243 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epoching message types
+const (
+	TypeMsgWrappedCreateValidator = "wrapped_create_validator"
+	TypeMsgWrappedEditValidator   = "wrapped_edit_validator"
+	TypeMsgWrappedDelegate        = "wrapped_delegate"
+	TypeMsgWrappedBeginRedelegate = "wrapped_begin_redelegate"
+	TypeMsgWrappedUndelegate      = "wrapped_begin_unbonding"
+	TypeMsgUpdateParams           = "update_params"
+)
+
+var (
+	_ sdk.Msg                            = &MsgWrappedCreateValidator{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgWrappedCreateValidator)(nil)
+	_ sdk.Msg                            = &MsgWrappedEditValidator{}
+	_ sdk.Msg                            = &MsgWrappedDelegate{}
+	_ sdk.Msg                            = &MsgWrappedBeginRedelegate{}
+	_ sdk.Msg                            = &MsgWrappedUndelegate{}
+	_ sdk.Msg                            = &MsgUpdateParams{}
+)
+
+// NewMsgWrappedCreateValidator creates a new MsgWrappedCreateValidator instance.
+func NewMsgWrappedCreateValidator(msg *stakingtypes.MsgCreateValidator) *MsgWrappedCreateValidator {
+	return &MsgWrappedCreateValidator{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) Type() string { return TypeMsgWrappedCreateValidator }
+
+// GetSigners implements the sdk.Msg interface. The signers are the ones of the
+// wrapped message.
+func (msg MsgWrappedCreateValidator) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWrappedCreateValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedCreateValidator) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wrapped message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.UnpackInterfaces(unpacker)
+}
+
+// NewMsgWrappedEditValidator creates a new MsgWrappedEditValidator instance.
+func NewMsgWrappedEditValidator(msg *stakingtypes.MsgEditValidator) *MsgWrappedEditValidator {
+	return &MsgWrappedEditValidator{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) Type() string { return TypeMsgWrappedEditValidator }
+
+// GetSigners implements the sdk.Msg interface. The signers are the ones of the
+// wrapped message.
+func (msg MsgWrappedEditValidator) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWrappedEditValidator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wrapped message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Type() string { return TypeMsgWrappedDelegate }
+
+// GetSigners implements the sdk.Msg interface. The signers are the ones of the
+// wrapped message.
+func (msg MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWrappedDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wrapped message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Type() string { return TypeMsgWrappedBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface. The signers are the ones of the
+// wrapped message.
+func (msg MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wrapped message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Type() string { return TypeMsgWrappedUndelegate }
+
+// GetSigners implements the sdk.Msg interface. The signers are the ones of the
+// wrapped message.
+func (msg MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	if msg.Msg == nil {
+		return nil
+	}
+
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgWrappedUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty wrapped message")
+	}
+
+	return msg.Msg.ValidateBasic()
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
@@ -0,0 +1,29 @@
+package types
+
+// Default parameter values
+const (
+	DefaultEpochLength uint64 = 10
+	DefaultEnabled            = false
+)
+
+// NewParams creates a new Params instance.
+func NewParams(epochLength uint64, enabled bool) Params {
+	return Params{
+		EpochLength: epochLength,
+		Enabled:     enabled,
+	}
+}
+
+// DefaultParams returns default x/epoching module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength, DefaultEnabled)
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.EpochLength == 0 {
+		return ErrInvalidEpochLength.Wrap("epoch length must be positive")
+	}
+
+	return nil
+}
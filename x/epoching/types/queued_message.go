@@ -0,0 +1,93 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueuedMessage{}
+	_ codectypes.UnpackInterfacesMessage = QueryEpochMsgsResponse{}
+)
+
+// NewQueuedMessage creates a new QueuedMessage instance.
+func NewQueuedMessage(epochNumber, actionID uint64, msg sdk.Msg) (QueuedMessage, error) {
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		Msg:         any,
+	}, nil
+}
+
+// GetSdkMsg returns the buffered message.
+func (m QueuedMessage) GetSdkMsg() (sdk.Msg, error) {
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, ErrInvalidQueuedMsg.Wrapf("expected %T, got %T", (sdk.Msg)(nil), m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMessage) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryEpochMsgsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Msgs {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsEpochedMsg returns true if the given message is one of the staking
+// messages buffered by the epoching module.
+func IsEpochedMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *stakingtypes.MsgCreateValidator,
+		*stakingtypes.MsgEditValidator,
+		*stakingtypes.MsgDelegate,
+		*stakingtypes.MsgBeginRedelegate,
+		*stakingtypes.MsgUndelegate:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateQueuedMsg checks that the given message is one of the staking
+// messages that may be buffered by the epoching module.
+func ValidateQueuedMsg(msg sdk.Msg) error {
+	if !IsEpochedMsg(msg) {
+		return ErrInvalidQueuedMsg.Wrapf("message %s cannot be buffered", sdk.MsgTypeURL(msg))
+	}
+
+	return msg.ValidateBasic()
+}
+
+// EscrowedCoins returns the coins moved to the EpochDelegationPool when the
+// given message was buffered.
+func EscrowedCoins(msg sdk.Msg) (sdk.AccAddress, sdk.Coins) {
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgCreateValidator:
+		delAddr, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+		return delAddr, sdk.NewCoins(msg.Value)
+	case *stakingtypes.MsgDelegate:
+		delAddr, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+		return delAddr, sdk.NewCoins(msg.Amount)
+	default:
+		return nil, nil
+	}
+}
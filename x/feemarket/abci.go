@@ -0,0 +1,26 @@
+package feemarket
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns or routes the base portion of the fees of the block, and
+// adjusts the base fees of the next block to the gas used by the block.
+//
+// NOTE: it must run in the block the fees are collected, before the
+// distribution BeginBlocker of the next block allocates the fee collector
+// balance to the validators.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.CollectBlockBaseFees(ctx); err != nil {
+		panic(err)
+	}
+
+	k.UpdateBaseFees(ctx, ctx.BlockGasMeter().GasConsumedToLimit())
+}
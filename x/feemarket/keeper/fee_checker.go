@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ ante.TxFeeChecker = Keeper{}.TxFeeChecker
+
+// TxFeeChecker implements the ante.TxFeeChecker of the fee market, to be set
+// in the DeductFeeDecorator. The fee of a tx must be at least its gas limit
+// times the current base fee in one of the denoms of the base fees, it replaces
+// the validator minimum gas prices.
+//
+// The base portion of the fee, in the first denom with enough fee, is recorded
+// to be burned or routed to the base fee recipient in EndBlock, and the
+// remainder is a tip left in the fee collector. The tx priority is the tip per
+// unit of gas.
+func (k Keeper) TxFeeChecker(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	// the gentxs are delivered at height 0, without fees
+	baseFees := k.GetBaseFees(ctx)
+	if baseFees.Empty() || ctx.BlockHeight() == 0 {
+		return fee, 0, nil
+	}
+
+	requiredFees := types.RequiredFees(baseFees, gas)
+	for _, requiredFee := range requiredFees {
+		paid := fee.AmountOf(requiredFee.Denom)
+		if paid.LT(requiredFee.Amount) {
+			continue
+		}
+
+		k.AddBlockBaseFee(ctx, requiredFee)
+		return fee, getTxPriority(paid.Sub(requiredFee.Amount), gas), nil
+	}
+
+	return nil, 0, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required one of: %s", fee, requiredFees)
+}
+
+// getTxPriority returns the tip per unit of gas of a tx.
+func getTxPriority(tip sdk.Int, gas uint64) int64 {
+	if gas > 0 {
+		tip = tip.Quo(sdk.NewIntFromUint64(gas))
+	}
+
+	if !tip.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return tip.Int64()
+}
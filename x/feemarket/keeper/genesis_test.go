@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	require := s.Require()
+
+	params := types.NewParams(500, sdk.NewDecWithPrec(1, 1), sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+	), "")
+	baseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(4)))
+	genState := types.NewGenesisState(params, baseFees)
+	require.NoError(types.ValidateGenesis(*genState))
+
+	s.feeMarketKeeper.InitGenesis(s.ctx, genState)
+	exported := s.feeMarketKeeper.ExportGenesis(s.ctx)
+
+	require.Equal(params, exported.Params)
+	require.Equal(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(4)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+	), exported.BaseFees)
+	require.NoError(types.ValidateGenesis(*exported))
+}
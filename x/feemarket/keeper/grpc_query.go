@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the feemarket module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// BaseFees returns the current base fees per unit of gas.
+func (k Keeper) BaseFees(c context.Context, _ *types.QueryBaseFeesRequest) (*types.QueryBaseFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBaseFeesResponse{BaseFees: k.GetBaseFees(ctx)}, nil
+}
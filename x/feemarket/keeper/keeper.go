@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	tStoreKey  storetypes.StoreKey
+	cdc        codec.BinaryCodec
+	authKeeper types.AccountKeeper
+	bankKeeper types.BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The transient store
+// records the base portion of the fees of the current block.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	tkey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	authority string,
+) Keeper {
+	// ensure the feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		storeKey:   key,
+		tStoreKey:  tkey,
+		cdc:        cdc,
+		authKeeper: ak,
+		bankKeeper: bk,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the x/feemarket module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, p types.Params) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	if p.BaseFeeRecipient != "" && k.authKeeper.GetModuleAddress(p.BaseFeeRecipient) == nil {
+		return types.ErrInvalidParams.Wrapf("base fee recipient %s is not a module account", p.BaseFeeRecipient)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&p)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// GetParams returns the current x/feemarket module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return p
+	}
+
+	k.cdc.MustUnmarshal(bz, &p)
+	return p
+}
+
+// GetBaseFees returns the current base fees per unit of gas, in the denoms of
+// the min base fees. The base fees missing from the store, or lower than the
+// min base fees after a params update, are the min base fees.
+func (k Keeper) GetBaseFees(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	minBaseFees := k.GetParams(ctx).MinBaseFees
+
+	baseFees := make(sdk.DecCoins, 0, len(minBaseFees))
+	for _, minBaseFee := range minBaseFees {
+		baseFee := minBaseFee
+		if bz := store.Get(types.BaseFeeKey(minBaseFee.Denom)); bz != nil {
+			var amount sdk.Dec
+			if err := amount.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+
+			if amount.GT(minBaseFee.Amount) {
+				baseFee.Amount = amount
+			}
+		}
+
+		baseFees = append(baseFees, baseFee)
+	}
+
+	return baseFees
+}
+
+// SetBaseFees replaces the stored base fees with the given ones.
+func (k Keeper) SetBaseFees(ctx sdk.Context, baseFees sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BaseFeePrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, baseFee := range baseFees {
+		bz, err := baseFee.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Set(types.BaseFeeKey(baseFee.Denom), bz)
+	}
+}
+
+// AddBlockBaseFee records the base portion of the fee of a tx of the current
+// block, to be burned or routed to the base fee recipient in EndBlock.
+func (k Keeper) AddBlockBaseFee(ctx sdk.Context, baseFee sdk.Coin) {
+	if baseFee.IsZero() {
+		return
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	key := types.BlockBaseFeeKey(baseFee.Denom)
+
+	amount := baseFee.Amount
+	if bz := store.Get(key); bz != nil {
+		var prev sdk.Int
+		if err := prev.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+
+		amount = amount.Add(prev)
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set(key, bz)
+}
+
+// GetBlockBaseFees returns the base portion of the fees of the current block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) sdk.Coins {
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(k.tStoreKey), types.BlockBaseFeePrefix)
+	defer iterator.Close()
+
+	blockBaseFees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		denom := string(iterator.Key()[len(types.BlockBaseFeePrefix):])
+		blockBaseFees = blockBaseFees.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return blockBaseFees
+}
+
+// UpdateBaseFees sets the base fees of the next block from the gas used by the
+// current one. The stored base fees are cleared if the fee market is disabled.
+func (k Keeper) UpdateBaseFees(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	if !params.Enabled() {
+		k.SetBaseFees(ctx, sdk.DecCoins{})
+		return
+	}
+
+	baseFees := params.NextBaseFees(k.GetBaseFees(ctx), blockGasUsed)
+	k.SetBaseFees(ctx, baseFees)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseFees,
+			sdk.NewAttribute(types.AttributeKeyBaseFees, baseFees.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, strconv.FormatUint(blockGasUsed, 10)),
+		),
+	)
+}
+
+// CollectBlockBaseFees burns the base portion of the fees of the current
+// block, or sends it to the base fee recipient. The base portion is taken from
+// the fee collector before the tips are distributed in the next block.
+//
+// The amount collected is capped by the balance of the fee collector, which
+// may be lower than the base portion of the fees if they were partly refunded
+// by a PostHandler.
+func (k Keeper) CollectBlockBaseFees(ctx sdk.Context) error {
+	feeCollector := k.authKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if feeCollector == nil {
+		return fmt.Errorf("fee collector module account (%s) has not been set", authtypes.FeeCollectorName)
+	}
+
+	baseFees := k.GetBlockBaseFees(ctx).Min(k.bankKeeper.GetAllBalances(ctx, feeCollector))
+	if baseFees.IsZero() {
+		return nil
+	}
+
+	recipient := k.GetParams(ctx).BaseFeeRecipient
+	if recipient != "" {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, recipient, baseFees); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRouteBaseFees,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, baseFees.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			),
+		)
+
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, baseFees); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, baseFees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnBaseFees,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, baseFees.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/testutil"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	queryClient types.QueryClient
+	msgServer   types.MsgServer
+	addrs       []sdk.AccAddress
+
+	interfaceRegistry codectypes.InterfaceRegistry
+	txConfig          client.TxConfig
+	accountKeeper     authkeeper.AccountKeeper
+	bankKeeper        bankkeeper.Keeper
+	feeMarketKeeper   keeper.Keeper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&s.interfaceRegistry,
+		&s.txConfig,
+		&s.accountKeeper,
+		&s.bankKeeper,
+		&s.feeMarketKeeper,
+	)
+	s.Require().NoError(err)
+
+	s.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, s.interfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.feeMarketKeeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+	s.msgServer = keeper.NewMsgServerImpl(s.feeMarketKeeper)
+
+	s.addrs = simtestutil.CreateIncrementalAccounts(2)
+
+	params := types.NewParams(1000, types.DefaultMaxChangeRate, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(5, 1)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+	), "")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) feeCollectorBalance() sdk.Coins {
+	return s.bankKeeper.GetAllBalances(s.ctx, s.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+}
+
+func (s *KeeperTestSuite) newTx(fee sdk.Coins, gas uint64) sdk.Tx {
+	txBuilder := s.txConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(s.addrs[0])))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gas)
+
+	return txBuilder.GetTx()
+}
+
+func (s *KeeperTestSuite) TestParams() {
+	require := s.Require()
+
+	params := s.feeMarketKeeper.GetParams(s.ctx)
+	params.BaseFeeRecipient = minttypes.ModuleName
+	require.NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	require.Equal(params, s.feeMarketKeeper.GetParams(s.ctx))
+
+	// the base fee recipient must be a module account
+	params.BaseFeeRecipient = "unknown"
+	require.ErrorIs(s.feeMarketKeeper.SetParams(s.ctx, params), types.ErrInvalidParams)
+
+	params.BaseFeeRecipient = ""
+	params.TargetBlockGas = 0
+	require.ErrorIs(s.feeMarketKeeper.SetParams(s.ctx, params), types.ErrInvalidParams)
+}
+
+func (s *KeeperTestSuite) TestBaseFees() {
+	require := s.Require()
+
+	// the base fees start at the min base fees
+	minBaseFees := s.feeMarketKeeper.GetParams(s.ctx).MinBaseFees
+	require.Equal(minBaseFees, s.feeMarketKeeper.GetBaseFees(s.ctx))
+
+	baseFees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(3)),
+	)
+	s.feeMarketKeeper.SetBaseFees(s.ctx, baseFees)
+	require.Equal(baseFees, s.feeMarketKeeper.GetBaseFees(s.ctx))
+
+	// the base fees are raised to the new min base fees, and the base fees of the
+	// removed denoms are ignored
+	params := s.feeMarketKeeper.GetParams(s.ctx)
+	params.MinBaseFees = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(2)),
+		sdk.NewDecCoinFromDec("photon", sdk.NewDec(1)),
+	)
+	require.NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	require.Equal(params.MinBaseFees, s.feeMarketKeeper.GetBaseFees(s.ctx))
+
+	res, err := s.queryClient.BaseFees(s.ctx, &types.QueryBaseFeesRequest{})
+	require.NoError(err)
+	require.Equal(params.MinBaseFees, res.BaseFees)
+}
+
+func (s *KeeperTestSuite) TestTxFeeChecker() {
+	testCases := []struct {
+		name             string
+		fee              sdk.Coins
+		gas              uint64
+		height           int64
+		expectedBaseFee  sdk.Coins
+		expectedPriority int64
+		expectErr        bool
+	}{
+		{
+			name:            "fee equal to the base fee",
+			fee:             sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+			gas:             100,
+			expectedBaseFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		},
+		{
+			name:             "tip per unit of gas as priority",
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("atom", 350)),
+			gas:              100,
+			expectedBaseFee:  sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+			expectedPriority: 3,
+		},
+		{
+			name:            "base fee rounded up",
+			fee:             sdk.NewCoins(sdk.NewInt64Coin("atom", 51)),
+			gas:             101,
+			expectedBaseFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 51)),
+		},
+		{
+			name:      "base fee rounded up not paid",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+			gas:       101,
+			expectErr: true,
+		},
+		{
+			name:             "base fee paid in the second denom",
+			fee:              sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 400)),
+			gas:              100,
+			expectedBaseFee:  sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+			expectedPriority: 2,
+		},
+		{
+			name:            "base fee paid in the first denom",
+			fee:             sdk.NewCoins(sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("stake", 400)),
+			gas:             100,
+			expectedBaseFee: sdk.NewCoins(sdk.NewInt64Coin("atom", 50)),
+		},
+		{
+			name:      "fee in another denom",
+			fee:       sdk.NewCoins(sdk.NewInt64Coin("photon", 1000)),
+			gas:       100,
+			expectErr: true,
+		},
+		{
+			name:      "no fee",
+			fee:       sdk.NewCoins(),
+			gas:       100,
+			expectErr: true,
+		},
+		{
+			name:            "gentx without fee",
+			fee:             sdk.NewCoins(),
+			gas:             100,
+			height:          -1,
+			expectedBaseFee: sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			ctx := s.ctx
+			if tc.height < 0 {
+				ctx = ctx.WithBlockHeight(0)
+			}
+
+			fee, priority, err := s.feeMarketKeeper.TxFeeChecker(ctx, s.newTx(tc.fee, tc.gas))
+			if tc.expectErr {
+				s.Require().ErrorContains(err, "insufficient fees")
+				s.Require().True(s.feeMarketKeeper.GetBlockBaseFees(ctx).IsZero())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.fee, fee)
+			s.Require().Equal(tc.expectedPriority, priority)
+			s.Require().Equal(tc.expectedBaseFee, s.feeMarketKeeper.GetBlockBaseFees(ctx))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTxFeeCheckerDisabled() {
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	checkedFee, priority, err := s.feeMarketKeeper.TxFeeChecker(s.ctx, s.newTx(fee, 100))
+	s.Require().NoError(err)
+	s.Require().Equal(fee, checkedFee)
+	s.Require().Zero(priority)
+	s.Require().True(s.feeMarketKeeper.GetBlockBaseFees(s.ctx).IsZero())
+}
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	require := s.Require()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 300), sdk.NewInt64Coin("stake", 600))
+	require.NoError(banktestutil.FundModuleAccount(s.bankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	balance := s.feeCollectorBalance()
+	supply := s.bankKeeper.GetSupply(s.ctx, "atom")
+
+	_, _, err := s.feeMarketKeeper.TxFeeChecker(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), 100))
+	require.NoError(err)
+	_, _, err = s.feeMarketKeeper.TxFeeChecker(s.ctx, s.newTx(sdk.NewCoins(sdk.NewInt64Coin("atom", 200)), 200))
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), s.feeMarketKeeper.GetBlockBaseFees(s.ctx))
+
+	// a block using 1.5 times the target gas burns the base fees, and increases
+	// the base fees by half the max change rate
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockGasMeter(sdk.NewGasMeter(10000))
+	s.ctx.BlockGasMeter().ConsumeGas(1500, "test")
+	feemarket.EndBlocker(s.ctx, s.feeMarketKeeper)
+
+	require.Equal(balance.Sub(sdk.NewInt64Coin("atom", 150)), s.feeCollectorBalance())
+	require.Equal(supply.SubAmount(sdk.NewInt(150)), s.bankKeeper.GetSupply(s.ctx, "atom"))
+	require.Equal(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.MustNewDecFromStr("0.53125")),
+		sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("2.125")),
+	), s.feeMarketKeeper.GetBaseFees(s.ctx))
+
+	events := s.ctx.EventManager().Events()
+	require.Contains(events, sdk.NewEvent(types.EventTypeBurnBaseFees, sdk.NewAttribute(sdk.AttributeKeyAmount, "150atom")))
+	require.Contains(events, sdk.NewEvent(
+		types.EventTypeBaseFees,
+		sdk.NewAttribute(types.AttributeKeyBaseFees, "0.531250000000000000atom,2.125000000000000000stake"),
+		sdk.NewAttribute(types.AttributeKeyBlockGasUsed, "1500"),
+	))
+}
+
+func (s *KeeperTestSuite) TestEndBlockerBaseFeeRecipient() {
+	require := s.Require()
+	params := s.feeMarketKeeper.GetParams(s.ctx)
+	params.BaseFeeRecipient = minttypes.ModuleName
+	require.NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	require.NoError(banktestutil.FundModuleAccount(s.bankKeeper, s.ctx, authtypes.FeeCollectorName, fees))
+	balance := s.feeCollectorBalance()
+
+	// the base fees cannot exceed the fee collector balance, e.g. after refunds
+	s.feeMarketKeeper.AddBlockBaseFee(s.ctx, sdk.NewInt64Coin("atom", 200))
+	s.feeMarketKeeper.AddBlockBaseFee(s.ctx, sdk.NewInt64Coin("photon", 200))
+
+	s.ctx = s.ctx.WithBlockGasMeter(sdk.NewGasMeter(10000))
+	feemarket.EndBlocker(s.ctx, s.feeMarketKeeper)
+
+	require.Equal(balance.Sub(fees...), s.feeCollectorBalance())
+	require.Equal(
+		fees,
+		s.bankKeeper.GetAllBalances(s.ctx, s.accountKeeper.GetModuleAddress(minttypes.ModuleName)),
+	)
+
+	// an empty block decreases the base fees down to the min base fees
+	require.Equal(params.MinBaseFees, s.feeMarketKeeper.GetBaseFees(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestEndBlockerDisabled() {
+	require := s.Require()
+	s.feeMarketKeeper.SetBaseFees(s.ctx, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(10))))
+	require.NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager()).WithBlockGasMeter(sdk.NewGasMeter(10000))
+	feemarket.EndBlocker(s.ctx, s.feeMarketKeeper)
+	require.Empty(s.ctx.EventManager().Events())
+
+	// the base fees restart from the min base fees once re-enabled
+	params := s.feeMarketKeeper.GetParams(s.ctx)
+	params.MinBaseFees = sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)))
+	require.NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	require.Equal(params.MinBaseFees, s.feeMarketKeeper.GetBaseFees(s.ctx))
+}
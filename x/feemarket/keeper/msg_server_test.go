@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	params := types.NewParams(2000, sdk.NewDecWithPrec(25, 2), sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.OneDec())), "")
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateParams
+		expectErr error
+	}{
+		{
+			name:      "invalid authority",
+			msg:       types.NewMsgUpdateParams(s.addrs[0].String(), params),
+			expectErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:      "invalid params",
+			msg:       types.NewMsgUpdateParams(s.feeMarketKeeper.GetAuthority(), types.NewParams(0, sdk.OneDec(), nil, "")),
+			expectErr: types.ErrInvalidParams,
+		},
+		{
+			name: "valid params",
+			msg:  types.NewMsgUpdateParams(s.feeMarketKeeper.GetAuthority(), params),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.msg)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			res, err := s.queryClient.Params(s.ctx, &types.QueryParamsRequest{})
+			s.Require().NoError(err)
+			s.Require().Equal(params, res.Params)
+		})
+	}
+}
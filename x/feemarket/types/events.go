@@ -0,0 +1,14 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFees      = "base_fees"
+	EventTypeBurnBaseFees  = "burn_base_fees"
+	EventTypeRouteBaseFees = "route_base_fees"
+
+	AttributeKeyBaseFees     = "base_fees"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+	AttributeKeyRecipient    = "recipient"
+
+	AttributeValueCategory = ModuleName
+)
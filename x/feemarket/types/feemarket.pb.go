@@ -0,0 +1,488 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/feemarket module.
+type Params struct {
+	// target_block_gas is the gas used by a block for which the base fees are
+	// left unchanged. The base fees increase after the blocks using more gas,
+	// and decrease after the blocks using less gas.
+	TargetBlockGas uint64 `protobuf:"varint,1,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// max_change_rate is the maximum relative change of the base fees between
+	// two blocks, reached when a block uses no gas or twice the target gas.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate"`
+	// min_base_fees are the minimum base fees per unit of gas. They define the
+	// denoms the fees can be paid in, a tx paying its fee in none of these
+	// denoms is rejected. The fee market is disabled if empty.
+	MinBaseFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=min_base_fees,json=minBaseFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_base_fees"`
+	// base_fee_recipient is the name of the module account receiving the base
+	// portion of the fees. The base portion is burned if empty.
+	BaseFeeRecipient string `protobuf:"bytes,4,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_481036a621b23787, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetMinBaseFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinBaseFees
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1/feemarket.proto", fileDescriptor_481036a621b23787)
+}
+
+var fileDescriptor_481036a621b23787 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6b, 0xe2, 0x40,
+	0x18, 0xc7, 0x13, 0x15, 0x61, 0x23, 0xee, 0x4a, 0x76, 0x0f, 0x59, 0x59, 0x12, 0xd9, 0x85, 0x25,
+	0xd0, 0x9a, 0x90, 0x7a, 0x2b, 0x3d, 0x45, 0x69, 0x7b, 0x2c, 0x39, 0xf6, 0x32, 0x4c, 0xc6, 0xc7,
+	0x18, 0xec, 0x64, 0x24, 0x33, 0x8a, 0xfd, 0x16, 0x3d, 0xf6, 0xd8, 0x73, 0xcf, 0xfd, 0x06, 0xbd,
+	0x78, 0x94, 0x9e, 0x4a, 0x0f, 0xb6, 0xe8, 0x17, 0x29, 0x93, 0x89, 0xd8, 0x53, 0xe9, 0x29, 0xc9,
+	0xff, 0x25, 0xcf, 0xef, 0x61, 0xc6, 0xf8, 0x47, 0x18, 0xa7, 0x8c, 0xfb, 0x23, 0x00, 0x8a, 0xf3,
+	0x09, 0x08, 0x7f, 0x1e, 0xec, 0x3f, 0xbc, 0x69, 0xce, 0x04, 0x33, 0x7f, 0xaa, 0x90, 0xb7, 0xd7,
+	0xe7, 0x41, 0xfb, 0x57, 0xc2, 0x12, 0x56, 0xf8, 0xbe, 0x7c, 0x53, 0xd1, 0xf6, 0x6f, 0x15, 0x45,
+	0xca, 0x28, 0x7b, 0xca, 0xb2, 0xcb, 0x51, 0x31, 0xe6, 0xe0, 0xcf, 0x83, 0x18, 0x04, 0x0e, 0x7c,
+	0xc2, 0xd2, 0x4c, 0xf9, 0x7f, 0x1f, 0x2b, 0x46, 0xfd, 0x02, 0xe7, 0x98, 0x72, 0xd3, 0x35, 0x5a,
+	0x02, 0xe7, 0x09, 0x08, 0x14, 0x5f, 0x31, 0x32, 0x41, 0x09, 0xe6, 0x96, 0xde, 0xd1, 0xdd, 0x5a,
+	0xf4, 0x5d, 0xe9, 0xa1, 0x94, 0xcf, 0x30, 0x37, 0x87, 0xc6, 0x0f, 0x8a, 0x17, 0x88, 0x8c, 0x71,
+	0x96, 0x00, 0xca, 0xb1, 0x00, 0xab, 0xd2, 0xd1, 0xdd, 0x6f, 0xe1, 0xc9, 0x72, 0xed, 0x68, 0x2f,
+	0x6b, 0xe7, 0x7f, 0x92, 0x8a, 0xf1, 0x2c, 0xf6, 0x08, 0xa3, 0x25, 0x4e, 0xf9, 0xe8, 0xf2, 0xe1,
+	0xc4, 0x17, 0xd7, 0x53, 0xe0, 0xde, 0x00, 0xc8, 0xd3, 0x43, 0xd7, 0x28, 0x69, 0x07, 0x40, 0xa2,
+	0x26, 0xc5, 0x8b, 0x7e, 0xf1, 0xcf, 0x08, 0x0b, 0x30, 0x67, 0x46, 0x93, 0xa6, 0x19, 0x92, 0xe4,
+	0x68, 0x04, 0xc0, 0xad, 0x6a, 0xa7, 0xea, 0x36, 0x8e, 0xfe, 0x78, 0x65, 0x45, 0x1a, 0x5e, 0xb9,
+	0x92, 0xec, 0xf7, 0x59, 0x9a, 0x85, 0x3d, 0x49, 0x70, 0xff, 0xea, 0x1c, 0x7c, 0x8d, 0x40, 0x76,
+	0x78, 0xd4, 0xa0, 0x69, 0x16, 0x62, 0x0e, 0xa7, 0x00, 0xdc, 0x3c, 0x34, 0xcc, 0xdd, 0x48, 0x94,
+	0x03, 0x49, 0xa7, 0x29, 0x64, 0xc2, 0xaa, 0xc9, 0xfd, 0xa2, 0x56, 0xac, 0x52, 0xd1, 0x4e, 0x3f,
+	0xae, 0xdd, 0xde, 0x39, 0x5a, 0x78, 0xbe, 0xdc, 0xd8, 0xfa, 0x6a, 0x63, 0xeb, 0x6f, 0x1b, 0x5b,
+	0xbf, 0xd9, 0xda, 0xda, 0x6a, 0x6b, 0x6b, 0xcf, 0x5b, 0x5b, 0xbb, 0xf4, 0x3e, 0xe5, 0x58, 0x7c,
+	0xb8, 0x02, 0x05, 0x53, 0x5c, 0x2f, 0x8e, 0xa5, 0xf7, 0x3e, 0x00, 0xc4, 0xa5, 0x01, 0x56, 0x23,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MinBaseFees) > 0 {
+		for iNdEx := len(m.MinBaseFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBaseFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovFeemarket(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.MinBaseFees) > 0 {
+		for _, e := range m.MinBaseFees {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBaseFees = append(m.MinBaseFees, types.DecCoin{})
+			if err := m.MinBaseFees[len(m.MinBaseFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
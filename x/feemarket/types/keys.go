@@ -0,0 +1,40 @@
+package types
+
+const (
+	// ModuleName is the name of the feemarket module. The module account of
+	// the same name burns the base portion of the fees.
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for feemarket
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feemarket
+	RouterKey = ModuleName
+)
+
+// Keys for feemarket store
+// Items are stored with the following key: values
+//
+// - 0x01: Params
+//
+// - 0x02<denom_Bytes>: sdk.Dec base fee per unit of gas
+//
+// # Keys for feemarket transient store, reset after each block
+//
+// - 0x03<denom_Bytes>: sdk.Int base portion of the fees of the block
+var (
+	ParamsKey          = []byte{0x01}
+	BaseFeePrefix      = []byte{0x02}
+	BlockBaseFeePrefix = []byte{0x03}
+)
+
+// BaseFeeKey returns the store key of the base fee of the given denom.
+func BaseFeeKey(denom string) []byte {
+	return append(append([]byte{}, BaseFeePrefix...), denom...)
+}
+
+// BlockBaseFeeKey returns the transient store key of the base portion of the
+// fees paid in the given denom in the current block.
+func BlockBaseFeeKey(denom string) []byte {
+	return append(append([]byte{}, BlockBaseFeePrefix...), denom...)
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Default parameter values
+const (
+	// DefaultTargetBlockGas is the default gas used by a block for which the
+	// base fees are left unchanged.
+	DefaultTargetBlockGas uint64 = 15_000_000
+)
+
+// DefaultMaxChangeRate is the default maximum relative change of the base fees
+// between two blocks, as in EIP-1559.
+var DefaultMaxChangeRate = sdk.NewDecWithPrec(125, 3)
+
+// NewParams creates a new Params instance.
+func NewParams(targetBlockGas uint64, maxChangeRate sdk.Dec, minBaseFees sdk.DecCoins, baseFeeRecipient string) Params {
+	return Params{
+		TargetBlockGas:   targetBlockGas,
+		MaxChangeRate:    maxChangeRate,
+		MinBaseFees:      minBaseFees,
+		BaseFeeRecipient: baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters. The fee market
+// is disabled by default, until minimum base fees are set.
+func DefaultParams() Params {
+	return NewParams(DefaultTargetBlockGas, DefaultMaxChangeRate, sdk.DecCoins{}, "")
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Enabled returns true if the fee market is enabled, i.e. if minimum base fees
+// are set.
+func (p Params) Enabled() bool {
+	return !p.MinBaseFees.Empty()
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if p.TargetBlockGas == 0 {
+		return ErrInvalidParams.Wrap("target block gas must be positive")
+	}
+
+	if p.MaxChangeRate.IsNil() || p.MaxChangeRate.IsNegative() || p.MaxChangeRate.GT(sdk.OneDec()) {
+		return ErrInvalidParams.Wrapf("max change rate must be between 0 and 1, got %s", p.MaxChangeRate)
+	}
+
+	if err := p.MinBaseFees.Validate(); err != nil {
+		return ErrInvalidParams.Wrapf("invalid min base fees: %s", err)
+	}
+
+	if p.BaseFeeRecipient != strings.TrimSpace(p.BaseFeeRecipient) {
+		return ErrInvalidParams.Wrapf("invalid base fee recipient %q", p.BaseFeeRecipient)
+	}
+
+	if p.BaseFeeRecipient == authtypes.FeeCollectorName {
+		return ErrInvalidParams.Wrapf("base fee recipient cannot be the %s module account", authtypes.FeeCollectorName)
+	}
+
+	return nil
+}
+
+// NextBaseFees returns the base fees following the given ones after a block
+// using the given gas. The base fees change proportionally to the difference
+// between the gas used and the target block gas, by at most the max change
+// rate, and are never lower than the min base fees. The base fees missing from
+// the given ones are the min base fees.
+func (p Params) NextBaseFees(baseFees sdk.DecCoins, blockGasUsed uint64) sdk.DecCoins {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	delta := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed)).Sub(target).Quo(target)
+	if delta.GT(sdk.OneDec()) {
+		delta = sdk.OneDec()
+	}
+
+	factor := sdk.OneDec().Add(p.MaxChangeRate.Mul(delta))
+
+	nextBaseFees := make(sdk.DecCoins, 0, len(p.MinBaseFees))
+	for _, minBaseFee := range p.MinBaseFees {
+		baseFee := sdk.MaxDec(baseFees.AmountOf(minBaseFee.Denom), minBaseFee.Amount)
+		nextBaseFee := sdk.MaxDec(baseFee.Mul(factor), minBaseFee.Amount)
+
+		nextBaseFees = append(nextBaseFees, sdk.NewDecCoinFromDec(minBaseFee.Denom, nextBaseFee))
+	}
+
+	return nextBaseFees
+}
+
+// RequiredFees returns the fees a tx with the given gas limit must pay in each
+// denom of the given base fees, rounded up.
+func RequiredFees(baseFees sdk.DecCoins, gas uint64) sdk.Coins {
+	glDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+
+	requiredFees := make(sdk.Coins, 0, len(baseFees))
+	for _, baseFee := range baseFees {
+		requiredFees = append(requiredFees, sdk.NewCoin(baseFee.Denom, baseFee.Amount.Mul(glDec).Ceil().RoundInt()))
+	}
+
+	return requiredFees
+}
+
+// ValidateBaseFees checks that the base fees are valid and not lower than
+// the min base fees of the given params.
+func (p Params) ValidateBaseFees(baseFees sdk.DecCoins) error {
+	if err := baseFees.Validate(); err != nil {
+		return err
+	}
+
+	for _, baseFee := range baseFees {
+		minBaseFee := p.MinBaseFees.AmountOf(baseFee.Denom)
+		if minBaseFee.IsZero() {
+			return fmt.Errorf("base fee denom %s is not in the min base fees", baseFee.Denom)
+		}
+
+		if baseFee.Amount.LT(minBaseFee) {
+			return fmt.Errorf("base fee %s is lower than the min base fee %s%s", baseFee, minBaseFee, baseFee.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	minBaseFees := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)))
+
+	testCases := []struct {
+		name      string
+		params    types.Params
+		expectErr bool
+	}{
+		{"default params", types.DefaultParams(), false},
+		{"min base fees", types.NewParams(100, sdk.NewDecWithPrec(1, 1), minBaseFees, ""), false},
+		{"fixed base fees", types.NewParams(100, sdk.ZeroDec(), minBaseFees, ""), false},
+		{"base fee recipient", types.NewParams(100, sdk.OneDec(), minBaseFees, "community"), false},
+		{"zero target block gas", types.NewParams(0, sdk.NewDecWithPrec(1, 1), minBaseFees, ""), true},
+		{"nil max change rate", types.NewParams(100, sdk.Dec{}, minBaseFees, ""), true},
+		{"negative max change rate", types.NewParams(100, sdk.NewDec(-1), minBaseFees, ""), true},
+		{"max change rate above one", types.NewParams(100, sdk.NewDecWithPrec(11, 1), minBaseFees, ""), true},
+		{"invalid min base fees", types.NewParams(100, sdk.OneDec(), sdk.DecCoins{{Denom: "atom", Amount: sdk.ZeroDec()}}, ""), true},
+		{"fee collector recipient", types.NewParams(100, sdk.OneDec(), minBaseFees, authtypes.FeeCollectorName), true},
+		{"blank recipient", types.NewParams(100, sdk.OneDec(), minBaseFees, " "), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidParams)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNextBaseFees(t *testing.T) {
+	minBaseFees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(1)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+	)
+	params := types.NewParams(1000, types.DefaultMaxChangeRate, minBaseFees, "")
+	baseFees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDec(8)),
+		sdk.NewDecCoinFromDec("photon", sdk.NewDec(8)),
+	)
+
+	testCases := []struct {
+		name     string
+		gasUsed  uint64
+		expected sdk.DecCoins
+	}{
+		{"target gas used", 1000, sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("atom", sdk.NewDec(8)),
+			sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+		)},
+		{"twice the target gas used", 2000, sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("atom", sdk.NewDec(9)),
+			sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("2.25")),
+		)},
+		{"change bounded by the max change rate", 10000, sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("atom", sdk.NewDec(9)),
+			sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("2.25")),
+		)},
+		{"half the target gas used", 500, sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("atom", sdk.NewDec(75).QuoInt64(10)),
+			sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+		)},
+		{"no gas used", 0, sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("atom", sdk.NewDec(7)),
+			sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+		)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, params.NextBaseFees(baseFees, tc.gasUsed))
+		})
+	}
+}
+
+func TestRequiredFees(t *testing.T) {
+	baseFees := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(25, 3)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDec(2)),
+	)
+
+	require.Equal(t,
+		sdk.Coins{sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 200)},
+		types.RequiredFees(baseFees, 100),
+	)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	params := types.NewParams(100, sdk.OneDec(), sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(2))), "")
+
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+	require.NoError(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(3))))))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDec(1))))))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(params, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDec(3))))))
+	require.Error(t, types.ValidateGenesis(*types.NewGenesisState(types.Params{}, nil)))
+}
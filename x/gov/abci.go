@@ -50,12 +50,19 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
-		if burnDeposits {
-			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-		} else {
-			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+		// If an expedited proposal fails, it is converted to a regular proposal
+		// and keeps its deposits, which are handled when the regular voting
+		// period ends.
+		if !proposal.Expedited || passes {
+			if burnDeposits {
+				keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+			} else {
+				keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+			}
 		}
 
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
 		if passes {
 			var (
 				idx int
@@ -98,19 +105,34 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
+		} else if proposal.Expedited {
+			// The proposal is converted to a regular one: its voting period is
+			// extended to the regular voting period, counted from its voting
+			// start time, and the votes cast so far, kept by the tally, count
+			// towards its final tally.
+			endTime := proposal.VotingStartTime.Add(*keeper.GetParams(ctx).VotingPeriod)
+			proposal.VotingEndTime = &endTime
+			proposal.Expedited = false
+
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			tagValue = types.AttributeValueExpeditedProposalRejected
+			logMsg = "expedited proposal converted to regular"
 		} else {
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = &tallyResults
+		// the final tally result is only set once the voting period is over
+		if proposal.Status != v1.StatusVotingPeriod {
+			proposal.FinalTallyResult = &tallyResults
 
-		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+			// when proposal become active
+			keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		}
 
-		// when proposal become active
-		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		keeper.SetProposal(ctx, proposal)
 
 		logger.Info(
 			"proposal tallied",
@@ -39,6 +39,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -92,6 +93,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -116,6 +118,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -174,6 +177,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		"",
+		false,
 	)
 	require.NoError(t, err)
 
@@ -226,7 +230,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -294,7 +298,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -344,7 +348,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0], false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -370,6 +374,138 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
+	testcases := map[string]struct {
+		// flag indicating whether the expedited proposal passes.
+		expeditedPasses bool
+		// flag indicating whether the converted regular proposal is expected to eventually pass
+		regularEventuallyPassing bool
+	}{
+		"expedited passes and not converted to regular": {
+			expeditedPasses: true,
+		},
+		"expedited fails, converted to regular - regular eventually passes": {
+			expeditedPasses:          false,
+			regularEventuallyPassing: true,
+		},
+		"expedited fails, converted to regular - regular eventually fails": {
+			expeditedPasses:          false,
+			regularEventuallyPassing: false,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens.MulRaw(2))
+			params := app.GovKeeper.GetParams(ctx)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			// 60% of the votes are enough for a regular proposal to pass, but not
+			// for an expedited one
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{6, 4})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			macc := app.GovKeeper.GetGovernanceAccount(ctx)
+			require.NotNil(t, macc)
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			// the expedited min deposit activates the voting period right away
+			newProposalMsg, err := v1.NewMsgSubmitProposal(
+				[]sdk.Msg{mkTestLegacyContent(t)},
+				params.ExpeditedMinDeposit,
+				addrs[0].String(),
+				"",
+				true,
+			)
+			require.NoError(t, err)
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.True(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+			require.NoError(t, err)
+			secondOption := v1.OptionNo
+			if tc.expeditedPasses {
+				secondOption = v1.OptionYes
+			}
+			err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(secondOption), "")
+			require.NoError(t, err)
+
+			// the expedited voting period is over
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.ExpeditedVotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the expedited proposal is converted to a regular one, keeping its deposits
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins.Add(params.ExpeditedMinDeposit...)))
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, *proposal.VotingEndTime)
+			require.True(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			// the votes cast during the expedited voting period are kept
+			votes := app.GovKeeper.GetVotes(ctx, proposal.Id)
+			require.Len(t, votes, 2)
+
+			if !tc.regularEventuallyPassing {
+				err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), "")
+				require.NoError(t, err)
+			}
+
+			// the regular voting period is over
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = *proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			require.True(t, ok)
+
+			if tc.regularEventuallyPassing {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+			}
+			// the final tally counts the votes cast before the conversion
+			require.Equal(t, sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction).String(), proposal.FinalTallyResult.YesCount)
+			require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.Id))
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -31,6 +31,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	flagMetadata     = "metadata"
+	FlagExpedited    = "expedited"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -70,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdVote(),
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
+		NewCmdCancelProposal(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -91,6 +93,9 @@ They should be defined in a JSON file.
 Example:
 $ %s tx gov submit-proposal path/to/proposal.json
 
+Add the --expedited flag to submit an expedited proposal, which requires a higher
+deposit and threshold but has a shorter voting period.
+
 Where proposal.json contains:
 
 {
@@ -121,7 +126,12 @@ Where proposal.json contains:
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata)
+			expedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, expedited)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -130,6 +140,50 @@ Where proposal.json contains:
 		},
 	}
 
+	cmd.Flags().Bool(FlagExpedited, false, "Submit the proposal as expedited")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelProposal implements submitting a cancel proposal transaction command.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel governance proposal before the voting period ends. Must be signed by the proposal creator.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a governance proposal before its voting period ends. Only the
+proposer of the proposal can cancel it. A share of the deposits, defined by the
+proposal_cancel_ratio governance parameter, is burned and the rest is refunded.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get proposer address
+			from := clientCtx.GetFromAddress()
+
+			msg := v1.NewMsgCancelProposal(proposalID, from.String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -131,7 +131,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
+	minDeposit := keeper.GetParams(ctx).ProposalMinDeposit(proposal.Expedited)
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(minDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -179,10 +180,54 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeDeposit burns the given ratio of all the deposits on a specific
+// proposal, refunds the remaining amount to the depositors and deletes the
+// deposits.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, proposalCancelRatio string) error {
+	cancellationCharges := sdk.NewCoins()
+	rate := sdk.MustNewDecFromStr(proposalCancelRatio)
+	store := ctx.KVStore(keeper.storeKey)
+
+	var err error
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		var remainingAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
+			// remaining amount = deposits amount - burn amount
+			remainingAmount = remainingAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount)))
+			cancellationCharges = cancellationCharges.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		}
+
+		if !remainingAmount.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, remainingAmount)
+			if err != nil {
+				return true
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if !cancellationCharges.IsZero() {
+		if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+// the deposit parameters, using the expedited minimum deposit for expedited proposals.
+// Returns nil on success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
@@ -191,7 +236,7 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 	if minInitialDepositRatio.IsZero() {
 		return nil
 	}
-	minDepositCoins := params.MinDeposit
+	minDepositCoins := params.ProposalMinDeposit(expedited)
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
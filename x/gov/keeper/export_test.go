@@ -2,6 +2,6 @@ package keeper
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
-	return k.validateInitialDeposit(ctx, initialDeposit)
+func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
+	return k.validateInitialDeposit(ctx, initialDeposit, expedited)
 }
@@ -42,6 +42,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					strings.Repeat("1", 300),
+					false,
 				)
 			},
 			expErr:    true,
@@ -54,6 +55,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -66,6 +68,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -78,6 +81,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -90,6 +94,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -101,6 +106,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -141,6 +147,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -164,6 +171,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -205,6 +213,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -253,6 +262,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -277,6 +287,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -318,6 +329,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -366,6 +378,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -416,6 +429,90 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		coins,
+		proposer.String(),
+		"",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ProposalId)
+	proposalID := res.ProposalId
+
+	cases := map[string]struct {
+		preRun     func() uint64
+		proposalID uint64
+		proposer   string
+		expErr     bool
+		expErrMsg  string
+	}{
+		"wrong proposal id": {
+			preRun: func() uint64 {
+				return 0
+			},
+			proposer:  proposer.String(),
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+		"invalid proposer": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			proposer:  addrs[1].String(),
+			expErr:    true,
+			expErrMsg: "invalid proposer",
+		},
+		"all good": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			proposer: proposer.String(),
+			expErr:   false,
+		},
+		"proposal already canceled": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			proposer:  proposer.String(),
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+	}
+
+	for _, name := range []string{"wrong proposal id", "invalid proposer", "all good", "proposal already canceled"} {
+		tc := cases[name]
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			cancelProposalReq := v1.NewMsgCancelProposal(proposalID, tc.proposer)
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, cancelProposalReq)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(proposalID, res.ProposalId)
+				suite.Require().Equal(uint64(suite.ctx.BlockHeight()), res.CanceledHeight)
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -484,6 +581,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -507,6 +605,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -538,6 +637,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -586,6 +686,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -610,6 +711,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -641,6 +743,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -689,6 +792,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -984,6 +1088,34 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "negative proposal cancel ratio",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "-0.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "proposal cancel ratio must not be negative",
+		},
+		{
+			name: "proposal cancel ratio > 1",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.ProposalCancelRatio = "2"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "proposal cancel ratio too large",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1076,7 +1208,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 			params.MinInitialDepositRatio = tc.minInitialDepositRatio.String()
 			govKeeper.SetParams(ctx, params)
 
-			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test")
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", false)
 			suite.Require().NoError(err)
 
 			// System under test
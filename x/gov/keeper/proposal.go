@@ -11,7 +11,7 @@ import (
 )
 
 // SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -68,7 +68,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -91,6 +91,58 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal will cancel proposal before the voting period ends.
+// A share of the deposits, given by the ProposalCancelRatio param, is burned
+// and the rest is refunded to the depositors.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// Proposals submitted before the proposer field was introduced cannot be canceled.
+	if proposal.Proposer == "" {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "proposal %d doesn't have a proposer, so cannot be canceled", proposalID)
+	}
+
+	// Check creator of the proposal
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "invalid proposer %s", proposer)
+	}
+
+	// Check proposal voting period is ended.
+	if (proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod) ||
+		(proposal.VotingEndTime != nil && !proposal.VotingEndTime.After(ctx.BlockTime())) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "voting period is already ended for this proposal %d", proposalID)
+	}
+
+	// burn the (deposits * proposal_cancel_rate) amount and refund the rest to the depositors.
+	if err := keeper.ChargeDeposit(ctx, proposal.Id, keeper.GetParams(ctx).ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	// delete the votes and the proposal, which also removes it from the
+	// inactive and active proposal queues.
+	keeper.deleteVotes(ctx, proposal.Id)
+	keeper.DeleteProposal(ctx, proposal.Id)
+
+	keeper.Logger(ctx).Info(
+		"proposal is canceled by proposer",
+		"proposal", proposal.Id,
+		"proposer", proposal.Proposer,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,7 +289,11 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetParams(ctx).VotingPeriod
+	params := keeper.GetParams(ctx)
+	votingPeriod := params.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = params.ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
@@ -18,7 +18,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +30,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -49,6 +49,68 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	activeIterator.Close()
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer := suite.addrs[0]
+	depositor := suite.addrs[1]
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", proposer, false)
+	suite.Require().NoError(err)
+	_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposal.Id, proposer, deposit)
+	suite.Require().NoError(err)
+	_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposal.Id, depositor, deposit)
+	suite.Require().NoError(err)
+
+	proposerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proposer, sdk.DefaultBondDenom)
+	depositorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, depositor, sdk.DefaultBondDenom)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, sdk.DefaultBondDenom)
+
+	// only the proposer can cancel the proposal
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposal.Id, depositor.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposal.Id, proposer.String())
+	suite.Require().NoError(err)
+
+	_, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.GovKeeper.GetDeposits(suite.ctx, proposal.Id))
+
+	inactiveIterator := suite.app.GovKeeper.InactiveProposalQueueIterator(suite.ctx, *proposal.DepositEndTime)
+	suite.Require().False(inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	// with the default cancel ratio of 0.5, half of each deposit is refunded
+	// and the other half is burned.
+	refund := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500))
+	suite.Require().Equal(proposerBalance.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, proposer, sdk.DefaultBondDenom))
+	suite.Require().Equal(depositorBalance.Add(refund), suite.app.BankKeeper.GetBalance(suite.ctx, depositor, sdk.DefaultBondDenom))
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(1000)), suite.app.BankKeeper.GetSupply(suite.ctx, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestCancelProposalVotingPeriodEnded() {
+	proposer := suite.addrs[0]
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", proposer, false)
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	// the proposal can still be canceled during its voting period
+	proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx.WithBlockTime(proposal.VotingEndTime.Add(-time.Second)), proposal.Id, proposer.String())
+	suite.Require().NoError(err)
+
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", proposer, false)
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx.WithBlockTime(*proposal.VotingEndTime), proposal.Id, proposer.String())
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+}
+
 type invalidProposalRoute struct{ v1beta1.TextProposal }
 
 func (invalidProposalRoute) ProposalRoute() string { return "nonexistingroute" }
@@ -81,7 +143,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -94,7 +156,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), addr, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
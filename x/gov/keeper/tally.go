@@ -10,8 +10,19 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. The votes are deleted, unless the proposal is an expedited proposal that fails, which is
+// converted to a regular proposal whose votes are tallied again at the end of its voting period.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
+	passes, burnDeposits, tallyResults = keeper.tally(ctx, proposal)
+	if !proposal.Expedited || passes {
+		keeper.deleteVotes(ctx, proposal.Id)
+	}
+
+	return passes, burnDeposits, tallyResults
+}
+
+// tally returns the tally of a proposal without deleting its votes.
+func (keeper Keeper) tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
@@ -68,7 +79,6 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -117,7 +127,7 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	threshold, _ := sdk.NewDecFromStr(tallyParams.ProposalThreshold(proposal.Expedited))
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -18,15 +18,15 @@ func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace
 	legacySubspace.Get(ctx, govv1.ParamStoreKeyVotingParams, &vp)
 	legacySubspace.Get(ctx, govv1.ParamStoreKeyTallyParams, &tp)
 
-	params := govv1.NewParams(
-		dp.MinDeposit,
-		*dp.MaxDepositPeriod,
-		*vp.VotingPeriod,
-		tp.Quorum,
-		tp.Threshold,
-		tp.VetoThreshold,
-		sdk.ZeroDec().String(),
-	)
+	params := govv1.Params{
+		MinDeposit:             dp.MinDeposit,
+		MaxDepositPeriod:       dp.MaxDepositPeriod,
+		VotingPeriod:           vp.VotingPeriod,
+		Quorum:                 tp.Quorum,
+		Threshold:              tp.Threshold,
+		VetoThreshold:          tp.VetoThreshold,
+		MinInitialDepositRatio: sdk.ZeroDec().String(),
+	}
 
 	bz, err := cdc.Marshal(&params)
 	if err != nil {
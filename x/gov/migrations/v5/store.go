@@ -0,0 +1,56 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func migrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params govv1.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	// the expedited params are derived from the regular ones so that they stay
+	// consistent with them whatever the chain's denom and periods.
+	expeditedVotingPeriod := govv1.DefaultExpeditedPeriod
+	if expeditedVotingPeriod >= *params.VotingPeriod {
+		expeditedVotingPeriod = *params.VotingPeriod / 2
+	}
+
+	threshold, err := sdk.NewDecFromStr(params.Threshold)
+	if err != nil {
+		return err
+	}
+	expeditedThreshold := govv1.DefaultExpeditedThreshold
+	if expeditedThreshold.LTE(threshold) {
+		expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+
+	params.ExpeditedMinDeposit = sdk.NewCoins(params.MinDeposit...).MulInt(sdk.NewInt(5))
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	params.ExpeditedThreshold = expeditedThreshold.String()
+	params.ProposalCancelRatio = govv1.DefaultProposalCancelRatio.String()
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration includes:
+//
+// Addition of the new expedited proposal parameters that are derived from the regular ones.
+// Addition of the new proposal cancel ratio parameter that is set to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	return migrateParams(ctx, storeKey, cdc)
+}
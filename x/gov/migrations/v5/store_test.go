@@ -0,0 +1,78 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(upgrade.AppModuleBasic{}, gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// params stored by the v4 migration, without the expedited params
+	defaultParams := v1.DefaultParams()
+	oldParams := v1.Params{
+		MinDeposit:             defaultParams.MinDeposit,
+		MaxDepositPeriod:       defaultParams.MaxDepositPeriod,
+		VotingPeriod:           defaultParams.VotingPeriod,
+		Quorum:                 defaultParams.Quorum,
+		Threshold:              defaultParams.Threshold,
+		VetoThreshold:          defaultParams.VetoThreshold,
+		MinInitialDepositRatio: defaultParams.MinInitialDepositRatio,
+	}
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v5.ParamsKey, bz)
+
+	// Run migrations.
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+	var params v1.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &params))
+	require.Equal(t, oldParams.MinDeposit, params.MinDeposit)
+	require.Equal(t, oldParams.VotingPeriod, params.VotingPeriod)
+	require.Equal(t, oldParams.Threshold, params.Threshold)
+	require.Equal(t, defaultParams.ExpeditedMinDeposit, params.ExpeditedMinDeposit)
+	require.Equal(t, defaultParams.ExpeditedVotingPeriod, params.ExpeditedVotingPeriod)
+	require.Equal(t, defaultParams.ExpeditedThreshold, params.ExpeditedThreshold)
+	require.Equal(t, defaultParams.ProposalCancelRatio, params.ProposalCancelRatio)
+	require.NoError(t, params.ValidateBasic())
+}
+
+func TestMigrateStoreDerivedParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(upgrade.AppModuleBasic{}, gov.AppModuleBasic{}).Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// the expedited params must be stricter than the regular ones
+	oldParams := v1.DefaultParams()
+	votingPeriod := time.Hour
+	oldParams.VotingPeriod = &votingPeriod
+	oldParams.Threshold = sdk.NewDecWithPrec(7, 1).String()
+	oldParams.ExpeditedMinDeposit, oldParams.ExpeditedVotingPeriod, oldParams.ExpeditedThreshold = nil, nil, ""
+	oldParams.ProposalCancelRatio = ""
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v5.ParamsKey, bz)
+
+	require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+	var params v1.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &params))
+	require.Equal(t, votingPeriod/2, *params.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(85, 2).String(), params.ExpeditedThreshold)
+	require.NoError(t, params.ValidateBasic())
+}
@@ -42,18 +42,24 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &govGenesis)
 
 	const (
-		tallyQuorum          = "0.400000000000000000"
-		tallyThreshold       = "0.539000000000000000"
-		tallyVetoThreshold   = "0.314000000000000000"
-		minInitialDepositDec = "0.590000000000000000"
+		tallyQuorum             = "0.400000000000000000"
+		tallyThreshold          = "0.539000000000000000"
+		tallyExpeditedThreshold = "0.645000000000000000"
+		tallyVetoThreshold      = "0.314000000000000000"
+		minInitialDepositDec    = "0.590000000000000000"
+		proposalCancelRate      = "0.370000000000000000"
 	)
 
 	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
 	require.Equal(t, "77h26m10s", govGenesis.Params.MaxDepositPeriod.String())
 	require.Equal(t, float64(275567), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, "5274stake", govGenesis.Params.ExpeditedMinDeposit[0].String())
+	require.Equal(t, "14h32m37.73s", govGenesis.Params.ExpeditedVotingPeriod.String())
 	require.Equal(t, tallyQuorum, govGenesis.Params.Quorum)
 	require.Equal(t, tallyThreshold, govGenesis.Params.Threshold)
+	require.Equal(t, tallyExpeditedThreshold, govGenesis.Params.ExpeditedThreshold)
 	require.Equal(t, tallyVetoThreshold, govGenesis.Params.VetoThreshold)
+	require.Equal(t, proposalCancelRate, govGenesis.Params.ProposalCancelRatio)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
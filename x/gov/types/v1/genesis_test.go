@@ -70,6 +70,36 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "invalid expedited min deposit",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedMinDeposit = params.MinDeposit
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid expedited voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedVotingPeriod = params.VotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid expedited threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedThreshold = params.Threshold
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
 		{
 			name: "invalid threshold",
 			genesisState: func() *v1.GenesisState {
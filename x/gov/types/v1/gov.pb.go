@@ -251,6 +251,14 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited
+	//
+	// Since: cosmos-sdk 0.47
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter
+	//
+	// Since: cosmos-sdk 0.47
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +364,20 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -685,6 +707,16 @@ type Params struct {
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	//  Duration of the voting period of an expedited proposal.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,8,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. Default value: 0.67.
+	ExpeditedThreshold string `protobuf:"bytes,9,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Minimum expedited deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,10,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	//  The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
+	//
+	//  Since: cosmos-sdk 0.47
+	ProposalCancelRatio string `protobuf:"bytes,11,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -769,6 +801,34 @@ func (m *Params) GetMinInitialDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -786,82 +846,88 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x73, 0xd3, 0x46,
-	0x14, 0x8f, 0x6c, 0xc5, 0x49, 0x5e, 0x62, 0xa3, 0x2e, 0x14, 0x94, 0x00, 0x76, 0xf0, 0xb4, 0x9d,
-	0x94, 0x3f, 0x76, 0x03, 0x43, 0x3b, 0x53, 0x2e, 0xb5, 0x63, 0x51, 0xc4, 0xd0, 0xd8, 0x95, 0x84,
-	0x19, 0x7a, 0xd1, 0xc8, 0x91, 0xb0, 0x77, 0x6a, 0x69, 0x5d, 0xed, 0xda, 0xe0, 0x8f, 0xd0, 0x1b,
-	0xc7, 0xce, 0xf4, 0x6b, 0x30, 0xed, 0x57, 0xe0, 0xd4, 0x61, 0x38, 0xf4, 0xcf, 0xc5, 0x6d, 0xe1,
-	0x96, 0x4f, 0xd1, 0xd1, 0x6a, 0x15, 0xdb, 0x8a, 0x99, 0xa4, 0x9c, 0x24, 0xbd, 0xf7, 0xfb, 0xbd,
-	0xf7, 0xf6, 0xbd, 0x9f, 0x76, 0x17, 0x2e, 0x1c, 0x10, 0xea, 0x13, 0x5a, 0xed, 0x92, 0x51, 0x75,
-	0xb4, 0x1b, 0x3d, 0x2a, 0x83, 0x90, 0x30, 0x82, 0xf2, 0xb1, 0xa3, 0x12, 0x59, 0x46, 0xbb, 0x5b,
-	0x45, 0x81, 0xeb, 0x38, 0xd4, 0xab, 0x8e, 0x76, 0x3b, 0x1e, 0x73, 0x76, 0xab, 0x07, 0x04, 0x07,
-	0x31, 0x7c, 0xeb, 0x5c, 0x97, 0x74, 0x09, 0x7f, 0xad, 0x46, 0x6f, 0xc2, 0x5a, 0xea, 0x12, 0xd2,
-	0xed, 0x7b, 0x55, 0xfe, 0xd5, 0x19, 0x3e, 0xa9, 0x32, 0xec, 0x7b, 0x94, 0x39, 0xfe, 0x40, 0x00,
-	0x36, 0xd3, 0x00, 0x27, 0x18, 0x0b, 0x57, 0x31, 0xed, 0x72, 0x87, 0xa1, 0xc3, 0x30, 0x49, 0x32,
-	0x6e, 0xc6, 0x15, 0xd9, 0x71, 0x52, 0x51, 0x2d, 0xff, 0x28, 0x13, 0x40, 0x8f, 0x3c, 0xdc, 0xed,
-	0x31, 0xcf, 0x6d, 0x13, 0xe6, 0x35, 0x07, 0x11, 0x0d, 0xed, 0x42, 0x8e, 0xf0, 0x37, 0x55, 0xda,
-	0x96, 0x76, 0x0a, 0x37, 0x37, 0x2b, 0x73, 0x4b, 0xac, 0x4c, 0xa1, 0x86, 0x00, 0xa2, 0x4f, 0x20,
-	0xf7, 0x94, 0x07, 0x52, 0x33, 0xdb, 0xd2, 0xce, 0x5a, 0xbd, 0xf0, 0xfa, 0xc5, 0x0d, 0x10, 0xac,
-	0x86, 0x77, 0x60, 0x08, 0x6f, 0xf9, 0x67, 0x09, 0x56, 0x1a, 0xde, 0x80, 0x50, 0xcc, 0x50, 0x09,
-	0xd6, 0x07, 0x21, 0x19, 0x10, 0xea, 0xf4, 0x6d, 0xec, 0xf2, 0x5c, 0xb2, 0x01, 0x89, 0x49, 0x77,
-	0xd1, 0xe7, 0xb0, 0xe6, 0xc6, 0x58, 0x12, 0x8a, 0xb8, 0xea, 0xeb, 0x17, 0x37, 0xce, 0x89, 0xb8,
-	0x35, 0xd7, 0x0d, 0x3d, 0x4a, 0x4d, 0x16, 0xe2, 0xa0, 0x6b, 0x4c, 0xa1, 0xe8, 0x0b, 0xc8, 0x39,
-	0x3e, 0x19, 0x06, 0x4c, 0xcd, 0x6e, 0x67, 0x77, 0xd6, 0xa7, 0xf5, 0x47, 0x33, 0xa9, 0x88, 0x99,
-	0x54, 0xf6, 0x08, 0x0e, 0xea, 0xf2, 0xcb, 0x49, 0x69, 0xc9, 0x10, 0xf0, 0xf2, 0xef, 0x32, 0xac,
-	0xb6, 0x44, 0x7e, 0x54, 0x80, 0xcc, 0x51, 0x55, 0x19, 0xec, 0xa2, 0xcf, 0x60, 0xd5, 0xf7, 0x28,
-	0x75, 0xba, 0x1e, 0x55, 0x33, 0x3c, 0xee, 0xb9, 0x4a, 0xdc, 0xf9, 0x4a, 0xd2, 0xf9, 0x4a, 0x2d,
-	0x18, 0x1b, 0x47, 0x28, 0x74, 0x1b, 0x72, 0x94, 0x39, 0x6c, 0x48, 0xd5, 0x2c, 0xef, 0xe3, 0xe5,
-	0x54, 0x1f, 0x93, 0x54, 0x26, 0x07, 0x19, 0x02, 0x8c, 0xee, 0x01, 0x7a, 0x82, 0x03, 0xa7, 0x6f,
-	0x33, 0xa7, 0xdf, 0x1f, 0xdb, 0xa1, 0x47, 0x87, 0x7d, 0xa6, 0xca, 0xdb, 0xd2, 0xce, 0xfa, 0xcd,
-	0xad, 0x54, 0x08, 0x2b, 0x82, 0x18, 0x1c, 0x61, 0x28, 0x9c, 0x35, 0x63, 0x41, 0x35, 0x58, 0xa7,
-	0xc3, 0x8e, 0x8f, 0x99, 0x1d, 0xc9, 0x49, 0x5d, 0x16, 0x21, 0xd2, 0x55, 0x5b, 0x89, 0xd6, 0xea,
-	0xf2, 0xf3, 0xbf, 0x4b, 0x92, 0x01, 0x31, 0x29, 0x32, 0xa3, 0xfb, 0xa0, 0x88, 0xc6, 0xda, 0x5e,
-	0xe0, 0xc6, 0x71, 0x72, 0xa7, 0x8c, 0x53, 0x10, 0x4c, 0x2d, 0x70, 0x79, 0xac, 0x06, 0xe4, 0x19,
-	0x61, 0x4e, 0xdf, 0x16, 0x76, 0x75, 0xe5, 0x74, 0xe3, 0xd9, 0xe0, 0xac, 0x44, 0x36, 0x0f, 0xe0,
-	0x83, 0x11, 0x61, 0x38, 0xe8, 0xda, 0x94, 0x39, 0xa1, 0x58, 0xda, 0xea, 0x29, 0x4b, 0x3a, 0x13,
-	0x53, 0xcd, 0x88, 0xc9, 0x6b, 0xba, 0x07, 0xc2, 0x34, 0x5d, 0xde, 0xda, 0x29, 0x63, 0xe5, 0x63,
-	0x62, 0xb2, 0xba, 0xad, 0x48, 0x1f, 0xcc, 0x71, 0x1d, 0xe6, 0xa8, 0x10, 0x89, 0xd5, 0x38, 0xfa,
-	0x2e, 0xff, 0x21, 0xc1, 0xfa, 0xec, 0x60, 0xae, 0xc1, 0xda, 0xd8, 0xa3, 0xf6, 0x01, 0x17, 0xa9,
-	0x74, 0xec, 0x8f, 0xd1, 0x03, 0x66, 0xac, 0x8e, 0x3d, 0xba, 0x17, 0xf9, 0xd1, 0x2d, 0xc8, 0x3b,
-	0x1d, 0xca, 0x1c, 0x1c, 0x08, 0x42, 0x66, 0x21, 0x61, 0x43, 0x80, 0x62, 0xd2, 0xa7, 0xb0, 0x1a,
-	0x10, 0x81, 0xcf, 0x2e, 0xc4, 0xaf, 0x04, 0x24, 0x86, 0xde, 0x01, 0x14, 0x10, 0xfb, 0x29, 0x66,
-	0x3d, 0x7b, 0xe4, 0xb1, 0x84, 0x24, 0x2f, 0x24, 0x9d, 0x09, 0xc8, 0x23, 0xcc, 0x7a, 0x6d, 0x8f,
-	0xc5, 0xe4, 0xf2, 0x2f, 0x12, 0xc8, 0xd1, 0x7e, 0x70, 0xf2, 0xdf, 0x5c, 0x81, 0xe5, 0x11, 0x61,
-	0xde, 0xc9, 0x7f, 0x72, 0x0c, 0x43, 0x77, 0x60, 0x25, 0xde, 0x5c, 0xa8, 0x2a, 0x73, 0x9d, 0x5c,
-	0x49, 0x69, 0xff, 0xf8, 0xce, 0x65, 0x24, 0x8c, 0xb9, 0x61, 0x2c, 0xcf, 0x0f, 0xe3, 0xbe, 0xbc,
-	0x9a, 0x55, 0xe4, 0xf2, 0x5f, 0x12, 0xe4, 0x85, 0xa4, 0x5a, 0x4e, 0xe8, 0xf8, 0x14, 0x3d, 0x86,
-	0x75, 0x1f, 0x07, 0x47, 0xe2, 0x94, 0x4e, 0x12, 0xe7, 0xe5, 0x48, 0x9c, 0x87, 0x93, 0xd2, 0x87,
-	0x33, 0xac, 0xeb, 0xc4, 0xc7, 0xcc, 0xf3, 0x07, 0x6c, 0x6c, 0x80, 0x8f, 0x83, 0x44, 0xb3, 0x3e,
-	0x20, 0xdf, 0x79, 0x96, 0x80, 0xec, 0x81, 0x17, 0x62, 0xe2, 0xf2, 0x46, 0x44, 0x19, 0xd2, 0x42,
-	0x6b, 0x88, 0xfd, 0xbb, 0xfe, 0xd1, 0xe1, 0xa4, 0x74, 0xe9, 0x38, 0x71, 0x9a, 0xe4, 0xa7, 0x48,
-	0x87, 0x8a, 0xef, 0x3c, 0x4b, 0x56, 0xc2, 0xfd, 0x65, 0x0b, 0x36, 0xda, 0x5c, 0x9b, 0x62, 0x65,
-	0x0d, 0x10, 0x5a, 0x4d, 0x32, 0x4b, 0x27, 0x65, 0x96, 0x79, 0xe4, 0x8d, 0x98, 0x25, 0xa2, 0xfe,
-	0x9b, 0x88, 0x58, 0x44, 0xfd, 0x12, 0x72, 0x3f, 0x0c, 0x49, 0x38, 0xf4, 0x85, 0x82, 0xcb, 0x87,
-	0x93, 0x92, 0x12, 0x5b, 0xa6, 0x15, 0xa6, 0xcf, 0x81, 0xd8, 0x8f, 0xf6, 0x60, 0x8d, 0xf5, 0x42,
-	0x8f, 0xf6, 0x48, 0xdf, 0x15, 0x82, 0xf8, 0xf8, 0x70, 0x52, 0x3a, 0x7b, 0x64, 0x7c, 0x67, 0x84,
-	0x29, 0x0f, 0x7d, 0x0b, 0x05, 0x2e, 0xd8, 0x69, 0xa4, 0x58, 0xe9, 0x57, 0x0f, 0x27, 0x25, 0x75,
-	0xde, 0xf3, 0xce, 0x70, 0xf9, 0x08, 0x67, 0x25, 0xb0, 0xf2, 0xaf, 0x59, 0xc8, 0x89, 0xe5, 0x7d,
-	0xf5, 0x3f, 0xe5, 0x10, 0xef, 0x55, 0xb3, 0x53, 0xff, 0xe6, 0xfd, 0xa6, 0x2e, 0x2f, 0x9e, 0xea,
-	0xf1, 0x29, 0x66, 0xdf, 0x63, 0x8a, 0xd1, 0x49, 0x2d, 0xa6, 0x26, 0x2f, 0x3e, 0xa9, 0xc5, 0x84,
-	0xae, 0xcf, 0x4e, 0x68, 0x79, 0x21, 0x74, 0x66, 0x14, 0xb7, 0x8f, 0x8d, 0x22, 0xb7, 0x90, 0x32,
-	0xdf, 0x6e, 0xa4, 0xc3, 0x66, 0xd4, 0x63, 0x1c, 0x60, 0x86, 0xa7, 0xe7, 0x82, 0xcd, 0xcb, 0x57,
-	0x57, 0x16, 0x46, 0x38, 0xef, 0xe3, 0x40, 0x8f, 0xf1, 0xa2, 0x3d, 0x46, 0x84, 0xbe, 0xfa, 0xa3,
-	0x04, 0x30, 0x73, 0x87, 0xb9, 0x08, 0x17, 0xda, 0x4d, 0x4b, 0xb3, 0x9b, 0x2d, 0x4b, 0x6f, 0xee,
-	0xdb, 0x0f, 0xf7, 0xcd, 0x96, 0xb6, 0xa7, 0xdf, 0xd5, 0xb5, 0x86, 0xb2, 0x84, 0xce, 0xc2, 0x99,
-	0x59, 0xe7, 0x63, 0xcd, 0x54, 0x24, 0x74, 0x01, 0xce, 0xce, 0x1a, 0x6b, 0x75, 0xd3, 0xaa, 0xe9,
-	0xfb, 0x4a, 0x06, 0x21, 0x28, 0xcc, 0x3a, 0xf6, 0x9b, 0x4a, 0x16, 0x5d, 0x02, 0x75, 0xde, 0x66,
-	0x3f, 0xd2, 0xad, 0x7b, 0x76, 0x5b, 0xb3, 0x9a, 0x8a, 0x7c, 0xf5, 0x37, 0x09, 0x0a, 0xf3, 0x87,
-	0x3b, 0x2a, 0xc1, 0xc5, 0x96, 0xd1, 0x6c, 0x35, 0xcd, 0xda, 0x03, 0xdb, 0xb4, 0x6a, 0xd6, 0x43,
-	0x33, 0x55, 0x53, 0x19, 0x8a, 0x69, 0x40, 0x43, 0x6b, 0x35, 0x4d, 0xdd, 0xb2, 0x5b, 0x9a, 0xa1,
-	0x37, 0x1b, 0x8a, 0x84, 0xae, 0xc0, 0xe5, 0x34, 0xa6, 0xdd, 0xb4, 0xf4, 0xfd, 0xaf, 0x13, 0x48,
-	0x06, 0x6d, 0xc1, 0xf9, 0x34, 0xa4, 0x55, 0x33, 0x4d, 0xad, 0x11, 0x17, 0x9d, 0xf6, 0x19, 0xda,
-	0x7d, 0x6d, 0xcf, 0xd2, 0x1a, 0x8a, 0xbc, 0x88, 0x79, 0xb7, 0xa6, 0x3f, 0xd0, 0x1a, 0xca, 0x72,
-	0x5d, 0x7b, 0xf9, 0xa6, 0x28, 0xbd, 0x7a, 0x53, 0x94, 0xfe, 0x79, 0x53, 0x94, 0x9e, 0xbf, 0x2d,
-	0x2e, 0xbd, 0x7a, 0x5b, 0x5c, 0xfa, 0xf3, 0x6d, 0x71, 0xe9, 0xbb, 0x6b, 0x5d, 0xcc, 0x7a, 0xc3,
-	0x4e, 0xe5, 0x80, 0xf8, 0xe2, 0x6a, 0x29, 0x1e, 0x37, 0xa8, 0xfb, 0x7d, 0xf5, 0x19, 0xbf, 0x2e,
-	0xb3, 0xf1, 0xc0, 0xa3, 0xd1, 0x5d, 0x38, 0xc7, 0x25, 0x7a, 0xeb, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xde, 0xdf, 0xa6, 0x05, 0x4c, 0x0b, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x73, 0xda, 0xd6,
+	0x16, 0xb7, 0x40, 0x60, 0x38, 0xd8, 0x84, 0x77, 0x9d, 0xc4, 0xb2, 0x93, 0x80, 0xc3, 0xbc, 0xf7,
+	0xc6, 0x2f, 0x7f, 0xe0, 0x39, 0x69, 0xda, 0x99, 0x66, 0xd1, 0x82, 0x51, 0x1a, 0x65, 0x12, 0x43,
+	0x25, 0x05, 0x4f, 0xba, 0xd1, 0xc8, 0xe8, 0x06, 0x6b, 0x8a, 0x74, 0xa9, 0x74, 0x21, 0xe6, 0x23,
+	0x74, 0x97, 0x65, 0x67, 0xfa, 0x0d, 0xba, 0xce, 0xf4, 0x33, 0x64, 0xd5, 0xc9, 0x64, 0xd3, 0x76,
+	0xe3, 0xb6, 0xc9, 0xce, 0x9f, 0xa2, 0xa3, 0xab, 0x2b, 0x04, 0x32, 0x19, 0xbb, 0x59, 0x21, 0x9d,
+	0xf3, 0xfb, 0xfd, 0xee, 0xb9, 0xe7, 0xfc, 0x74, 0x25, 0x60, 0xbd, 0x47, 0x7c, 0x87, 0xf8, 0xf5,
+	0x3e, 0x19, 0xd7, 0xc7, 0x3b, 0xc1, 0x4f, 0x6d, 0xe8, 0x11, 0x4a, 0xd0, 0x6a, 0x98, 0xa8, 0x05,
+	0x91, 0xf1, 0xce, 0x66, 0x99, 0xe3, 0x0e, 0x4c, 0x1f, 0xd7, 0xc7, 0x3b, 0x07, 0x98, 0x9a, 0x3b,
+	0xf5, 0x1e, 0xb1, 0xdd, 0x10, 0xbe, 0x79, 0xb1, 0x4f, 0xfa, 0x84, 0x5d, 0xd6, 0x83, 0x2b, 0x1e,
+	0xad, 0xf4, 0x09, 0xe9, 0x0f, 0x70, 0x9d, 0xdd, 0x1d, 0x8c, 0x9e, 0xd7, 0xa9, 0xed, 0x60, 0x9f,
+	0x9a, 0xce, 0x90, 0x03, 0x36, 0x92, 0x00, 0xd3, 0x9d, 0xf0, 0x54, 0x39, 0x99, 0xb2, 0x46, 0x9e,
+	0x49, 0x6d, 0x12, 0xad, 0xb8, 0x11, 0x56, 0x64, 0x84, 0x8b, 0xf2, 0x6a, 0xd9, 0x4d, 0x95, 0x00,
+	0xda, 0xc7, 0x76, 0xff, 0x90, 0x62, 0xab, 0x4b, 0x28, 0x6e, 0x0f, 0x03, 0x1a, 0xda, 0x81, 0x2c,
+	0x61, 0x57, 0x92, 0xb0, 0x25, 0x6c, 0x17, 0xef, 0x6c, 0xd4, 0xe6, 0xb6, 0x58, 0x8b, 0xa1, 0x2a,
+	0x07, 0xa2, 0xff, 0x42, 0xf6, 0x05, 0x13, 0x92, 0x52, 0x5b, 0xc2, 0x76, 0xbe, 0x59, 0x7c, 0xfb,
+	0xea, 0x36, 0x70, 0x56, 0x0b, 0xf7, 0x54, 0x9e, 0xad, 0xfe, 0x28, 0xc0, 0x72, 0x0b, 0x0f, 0x89,
+	0x6f, 0x53, 0x54, 0x81, 0xc2, 0xd0, 0x23, 0x43, 0xe2, 0x9b, 0x03, 0xc3, 0xb6, 0xd8, 0x5a, 0xa2,
+	0x0a, 0x51, 0x48, 0xb1, 0xd0, 0xa7, 0x90, 0xb7, 0x42, 0x2c, 0xf1, 0xb8, 0xae, 0xf4, 0xf6, 0xd5,
+	0xed, 0x8b, 0x5c, 0xb7, 0x61, 0x59, 0x1e, 0xf6, 0x7d, 0x8d, 0x7a, 0xb6, 0xdb, 0x57, 0x63, 0x28,
+	0xfa, 0x0c, 0xb2, 0xa6, 0x43, 0x46, 0x2e, 0x95, 0xd2, 0x5b, 0xe9, 0xed, 0x42, 0x5c, 0x7f, 0x30,
+	0x93, 0x1a, 0x9f, 0x49, 0x6d, 0x97, 0xd8, 0x6e, 0x53, 0x7c, 0x7d, 0x5c, 0x59, 0x52, 0x39, 0xbc,
+	0xfa, 0x53, 0x06, 0x72, 0x1d, 0xbe, 0x3e, 0x2a, 0x42, 0x6a, 0x5a, 0x55, 0xca, 0xb6, 0xd0, 0xff,
+	0x21, 0xe7, 0x60, 0xdf, 0x37, 0xfb, 0xd8, 0x97, 0x52, 0x4c, 0xf7, 0x62, 0x2d, 0xec, 0x7c, 0x2d,
+	0xea, 0x7c, 0xad, 0xe1, 0x4e, 0xd4, 0x29, 0x0a, 0xdd, 0x83, 0xac, 0x4f, 0x4d, 0x3a, 0xf2, 0xa5,
+	0x34, 0xeb, 0xe3, 0xb5, 0x44, 0x1f, 0xa3, 0xa5, 0x34, 0x06, 0x52, 0x39, 0x18, 0x3d, 0x04, 0xf4,
+	0xdc, 0x76, 0xcd, 0x81, 0x41, 0xcd, 0xc1, 0x60, 0x62, 0x78, 0xd8, 0x1f, 0x0d, 0xa8, 0x24, 0x6e,
+	0x09, 0xdb, 0x85, 0x3b, 0x9b, 0x09, 0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x96, 0x18, 0x6b, 0x26,
+	0x82, 0x1a, 0x50, 0xf0, 0x47, 0x07, 0x8e, 0x4d, 0x8d, 0xc0, 0x4e, 0x52, 0x86, 0x4b, 0x24, 0xab,
+	0xd6, 0x23, 0xaf, 0x35, 0xc5, 0x97, 0x7f, 0x54, 0x04, 0x15, 0x42, 0x52, 0x10, 0x46, 0x8f, 0xa0,
+	0xc4, 0x1b, 0x6b, 0x60, 0xd7, 0x0a, 0x75, 0xb2, 0xe7, 0xd4, 0x29, 0x72, 0xa6, 0xec, 0x5a, 0x4c,
+	0xab, 0x05, 0xab, 0x94, 0x50, 0x73, 0x60, 0xf0, 0xb8, 0xb4, 0x7c, 0xbe, 0xf1, 0xac, 0x30, 0x56,
+	0x64, 0x9b, 0xc7, 0xf0, 0xaf, 0x31, 0xa1, 0xb6, 0xdb, 0x37, 0x7c, 0x6a, 0x7a, 0x7c, 0x6b, 0xb9,
+	0x73, 0x96, 0x74, 0x21, 0xa4, 0x6a, 0x01, 0x93, 0xd5, 0xf4, 0x10, 0x78, 0x28, 0xde, 0x5e, 0xfe,
+	0x9c, 0x5a, 0xab, 0x21, 0x31, 0xda, 0xdd, 0x66, 0xe0, 0x0f, 0x6a, 0x5a, 0x26, 0x35, 0x25, 0x08,
+	0xcc, 0xaa, 0x4e, 0xef, 0xd1, 0x55, 0xc8, 0xe3, 0xa3, 0x21, 0xb6, 0x6c, 0x8a, 0x2d, 0xa9, 0xb0,
+	0x25, 0x6c, 0xe7, 0xd4, 0x38, 0x80, 0x3e, 0x81, 0x5c, 0xe8, 0x7a, 0xec, 0x49, 0x2b, 0x67, 0xd8,
+	0x7c, 0x8a, 0xac, 0xfe, 0x2a, 0x40, 0x61, 0x76, 0xd8, 0x37, 0x21, 0x3f, 0xc1, 0xbe, 0xd1, 0x63,
+	0xc6, 0x17, 0x4e, 0x3d, 0x85, 0x8a, 0x4b, 0xd5, 0xdc, 0x04, 0xfb, 0xbb, 0x41, 0x1e, 0xdd, 0x85,
+	0x55, 0xf3, 0xc0, 0xa7, 0xa6, 0xed, 0x72, 0x42, 0x6a, 0x21, 0x61, 0x85, 0x83, 0x42, 0xd2, 0xff,
+	0x20, 0xe7, 0x12, 0x8e, 0x4f, 0x2f, 0xc4, 0x2f, 0xbb, 0x24, 0x84, 0xde, 0x07, 0xe4, 0x12, 0xe3,
+	0x85, 0x4d, 0x0f, 0x8d, 0x31, 0xa6, 0x11, 0x49, 0x5c, 0x48, 0xba, 0xe0, 0x92, 0x7d, 0x9b, 0x1e,
+	0x76, 0x31, 0x0d, 0xc9, 0xd5, 0x9f, 0x05, 0x10, 0x83, 0x33, 0xe6, 0xec, 0x13, 0xa2, 0x06, 0x99,
+	0x31, 0xa1, 0xf8, 0xec, 0xd3, 0x21, 0x84, 0xa1, 0xfb, 0xb0, 0x1c, 0x1e, 0x58, 0xbe, 0x24, 0x32,
+	0xef, 0x5d, 0x4f, 0x3c, 0x4f, 0xa7, 0x4f, 0x43, 0x35, 0x62, 0xcc, 0x0d, 0x38, 0x33, 0x3f, 0xe0,
+	0x47, 0x62, 0x2e, 0x5d, 0x12, 0xab, 0xbf, 0x0b, 0xb0, 0xca, 0x6d, 0xda, 0x31, 0x3d, 0xd3, 0xf1,
+	0xd1, 0x33, 0x28, 0x38, 0xb6, 0x3b, 0x35, 0xbc, 0x70, 0x96, 0xe1, 0xaf, 0x05, 0x86, 0x3f, 0x39,
+	0xae, 0x5c, 0x9a, 0x61, 0xdd, 0x22, 0x8e, 0x4d, 0xb1, 0x33, 0xa4, 0x13, 0x15, 0x1c, 0xdb, 0x8d,
+	0x9e, 0x03, 0x07, 0x90, 0x63, 0x1e, 0x45, 0x20, 0x63, 0x88, 0x3d, 0x9b, 0x58, 0xac, 0x11, 0xc1,
+	0x0a, 0x49, 0xf3, 0xb6, 0xf8, 0x3b, 0xa1, 0xf9, 0xef, 0x93, 0xe3, 0xca, 0xd5, 0xd3, 0xc4, 0x78,
+	0x91, 0x1f, 0x02, 0x6f, 0x97, 0x1c, 0xf3, 0x28, 0xda, 0x09, 0xcb, 0x57, 0x75, 0x58, 0xe9, 0x32,
+	0xbf, 0xf3, 0x9d, 0xb5, 0x80, 0xfb, 0x3f, 0x5a, 0x59, 0x38, 0x6b, 0x65, 0x91, 0x29, 0xaf, 0x84,
+	0x2c, 0xae, 0xfa, 0x57, 0x64, 0x62, 0xae, 0xfa, 0x39, 0x64, 0xbf, 0x1b, 0x11, 0x6f, 0xe4, 0x70,
+	0x07, 0x57, 0x4f, 0x8e, 0x2b, 0xa5, 0x30, 0x12, 0x57, 0x98, 0x7c, 0xb7, 0x84, 0x79, 0xb4, 0x0b,
+	0x79, 0x7a, 0xe8, 0x61, 0xff, 0x90, 0x0c, 0x2c, 0x6e, 0x88, 0xff, 0x9c, 0x1c, 0x57, 0xd6, 0xa6,
+	0xc1, 0x0f, 0x2a, 0xc4, 0x3c, 0xf4, 0x35, 0x14, 0x99, 0x61, 0x63, 0xa5, 0xd0, 0xe9, 0x37, 0x4e,
+	0x8e, 0x2b, 0xd2, 0x7c, 0xe6, 0x83, 0x72, 0xab, 0x01, 0x4e, 0x8f, 0x60, 0xd5, 0x93, 0x0c, 0x64,
+	0xf9, 0xf6, 0xbe, 0xfc, 0x87, 0x76, 0x08, 0xcf, 0xbf, 0xd9, 0xa9, 0x3f, 0xf9, 0xb8, 0xa9, 0x8b,
+	0x8b, 0xa7, 0x7a, 0x7a, 0x8a, 0xe9, 0x8f, 0x98, 0x62, 0xf0, 0xf6, 0xe7, 0x53, 0x13, 0x17, 0xbf,
+	0xfd, 0xf9, 0x84, 0x6e, 0xcd, 0x4e, 0x28, 0xb3, 0x10, 0x3a, 0x33, 0x8a, 0x7b, 0xa7, 0x46, 0x91,
+	0x5d, 0x48, 0x99, 0x6f, 0x37, 0x52, 0x60, 0x23, 0xe8, 0xb1, 0xed, 0xda, 0xd4, 0x8e, 0xdf, 0x35,
+	0x06, 0x2b, 0x5f, 0x5a, 0x5e, 0xa8, 0x70, 0xd9, 0xb1, 0x5d, 0x25, 0xc4, 0xf3, 0xf6, 0xa8, 0x01,
+	0x1a, 0xed, 0xc3, 0xfa, 0xf4, 0x94, 0x36, 0xe6, 0xfb, 0x94, 0x3b, 0x5f, 0x9f, 0x2e, 0x4d, 0xf9,
+	0xdd, 0xd9, 0x86, 0x7d, 0x01, 0x6b, 0xb1, 0x70, 0xbc, 0xbf, 0xfc, 0xc2, 0xea, 0xd0, 0x14, 0x1a,
+	0x6f, 0x52, 0x83, 0x58, 0xd9, 0x98, 0xb5, 0x14, 0x9c, 0xcf, 0x52, 0xf1, 0xf2, 0x4f, 0x62, 0x6f,
+	0x35, 0xe1, 0xd2, 0xf4, 0xb8, 0xed, 0x99, 0x6e, 0x0f, 0x0f, 0x78, 0xd7, 0x0a, 0x0b, 0xeb, 0x5a,
+	0x8b, 0xc0, 0xbb, 0x0c, 0xcb, 0x5a, 0x76, 0xe3, 0x7b, 0x01, 0x60, 0xe6, 0x53, 0xf2, 0x0a, 0xac,
+	0x77, 0xdb, 0xba, 0x6c, 0xb4, 0x3b, 0xba, 0xd2, 0xde, 0x33, 0x9e, 0xee, 0x69, 0x1d, 0x79, 0x57,
+	0x79, 0xa0, 0xc8, 0xad, 0xd2, 0x12, 0x5a, 0x83, 0x0b, 0xb3, 0xc9, 0x67, 0xb2, 0x56, 0x12, 0xd0,
+	0x3a, 0xac, 0xcd, 0x06, 0x1b, 0x4d, 0x4d, 0x6f, 0x28, 0x7b, 0xa5, 0x14, 0x42, 0x50, 0x9c, 0x4d,
+	0xec, 0xb5, 0x4b, 0x69, 0x74, 0x15, 0xa4, 0xf9, 0x98, 0xb1, 0xaf, 0xe8, 0x0f, 0x8d, 0xae, 0xac,
+	0xb7, 0x4b, 0xe2, 0x8d, 0x5f, 0x04, 0x28, 0xce, 0x7f, 0x63, 0xa1, 0x0a, 0x5c, 0xe9, 0xa8, 0xed,
+	0x4e, 0x5b, 0x6b, 0x3c, 0x36, 0x34, 0xbd, 0xa1, 0x3f, 0xd5, 0x12, 0x35, 0x55, 0xa1, 0x9c, 0x04,
+	0xb4, 0xe4, 0x4e, 0x5b, 0x53, 0x74, 0xa3, 0x23, 0xab, 0x4a, 0xbb, 0x55, 0x12, 0xd0, 0x75, 0xb8,
+	0x96, 0xc4, 0x74, 0xdb, 0xba, 0xb2, 0xf7, 0x55, 0x04, 0x49, 0xa1, 0x4d, 0xb8, 0x9c, 0x84, 0x74,
+	0x1a, 0x9a, 0x26, 0xb7, 0xc2, 0xa2, 0x93, 0x39, 0x55, 0x7e, 0x24, 0xef, 0xea, 0x72, 0xab, 0x24,
+	0x2e, 0x62, 0x3e, 0x68, 0x28, 0x8f, 0xe5, 0x56, 0x29, 0xd3, 0x94, 0x5f, 0xbf, 0x2b, 0x0b, 0x6f,
+	0xde, 0x95, 0x85, 0x3f, 0xdf, 0x95, 0x85, 0x97, 0xef, 0xcb, 0x4b, 0x6f, 0xde, 0x97, 0x97, 0x7e,
+	0x7b, 0x5f, 0x5e, 0xfa, 0xe6, 0x66, 0xdf, 0xa6, 0x87, 0xa3, 0x83, 0x5a, 0x8f, 0x38, 0xfc, 0x0b,
+	0x9f, 0xff, 0xdc, 0xf6, 0xad, 0x6f, 0xeb, 0x47, 0xec, 0x5f, 0x0b, 0x9d, 0x0c, 0xb1, 0x1f, 0xfc,
+	0x25, 0xc9, 0x32, 0xb7, 0xde, 0xfd, 0x7b, 0x00, 0xb3, 0x30, 0x04, 0x84, 0xd3, 0x0c, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -968,6 +1034,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1319,6 +1402,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.MinInitialDepositRatio) > 0 {
 		i -= len(m.MinInitialDepositRatio)
 		copy(dAtA[i:], m.MinInitialDepositRatio)
@@ -1348,22 +1469,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1481,6 +1602,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1624,6 +1752,24 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2216,6 +2362,58 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3197,6 +3395,140 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -10,16 +10,20 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens       = sdk.NewInt(10000000)
-	DefaultQuorum                 = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold              = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold          = sdk.NewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio = sdk.ZeroDec()
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdk.NewInt(5))
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio    = sdk.ZeroDec()
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -47,17 +51,21 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 }
 
 func NewParams(
-	minDeposit sdk.Coins, maxDepositPeriod time.Duration, votingPeriod time.Duration,
-	quorum string, threshold string, vetoThreshold string, minInitialDepositRatio string,
+	minDeposit, expeditedMinDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
+	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio string,
 ) Params {
 	return Params{
 		MinDeposit:             minDeposit,
+		ExpeditedMinDeposit:    expeditedMinDeposit,
 		MaxDepositPeriod:       &maxDepositPeriod,
 		VotingPeriod:           &votingPeriod,
+		ExpeditedVotingPeriod:  &expeditedVotingPeriod,
 		Quorum:                 quorum,
 		Threshold:              threshold,
+		ExpeditedThreshold:     expeditedThreshold,
 		VetoThreshold:          vetoThreshold,
 		MinInitialDepositRatio: minInitialDepositRatio,
+		ProposalCancelRatio:    proposalCancelRatio,
 	}
 }
 
@@ -65,20 +73,50 @@ func NewParams(
 func DefaultParams() Params {
 	return NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
 		DefaultPeriod,
+		DefaultExpeditedPeriod,
 		DefaultQuorum.String(),
 		DefaultThreshold.String(),
+		DefaultExpeditedThreshold.String(),
 		DefaultVetoThreshold.String(),
 		DefaultMinInitialDepositRatio.String(),
+		DefaultProposalCancelRatio.String(),
 	)
 }
 
+// ProposalMinDeposit returns the minimum deposit of a proposal, which depends on
+// whether it is expedited.
+func (p Params) ProposalMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return p.ExpeditedMinDeposit
+	}
+
+	return p.MinDeposit
+}
+
+// ProposalThreshold returns the threshold of Yes votes for a proposal to pass,
+// which depends on whether it is expedited.
+func (p Params) ProposalThreshold(expedited bool) string {
+	if expedited {
+		return p.ExpeditedThreshold
+	}
+
+	return p.Threshold
+}
+
 func (p Params) ValidateBasic() error {
 	if minDeposit := sdk.Coins(p.MinDeposit); minDeposit.Empty() || !minDeposit.IsValid() {
 		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
 	}
 
+	if minExpeditedDeposit := sdk.Coins(p.ExpeditedMinDeposit); minExpeditedDeposit.Empty() || !minExpeditedDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", minExpeditedDeposit)
+	} else if minExpeditedDeposit.IsAllLTE(p.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than minimum deposit: %s", minExpeditedDeposit)
+	}
+
 	if p.MaxDepositPeriod == nil {
 		return fmt.Errorf("maximum deposit period must not be nil: %d", p.MaxDepositPeriod)
 	}
@@ -109,6 +147,20 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if !expeditedThreshold.IsPositive() {
+		return fmt.Errorf("expedited vote threshold must be positive: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than the regular threshold %s", expeditedThreshold, threshold)
+	}
+
 	vetoThreshold, err := sdk.NewDecFromStr(p.VetoThreshold)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
@@ -128,5 +180,28 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
 	}
 
+	if p.ExpeditedVotingPeriod == nil {
+		return fmt.Errorf("expedited voting period must not be nil: %d", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() >= p.VotingPeriod.Seconds() {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the regular voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must not be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
 	return nil
 }
@@ -377,6 +377,33 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+A veto decision policy accepts proposals after min_execution_period unless
+veto_percentage of the group weight voted no with veto, where 0 < veto_percentage <= 1:
+
+{
+    "@type": "/cosmos.group.v1.VetoDecisionPolicy",
+    "veto_percentage": "0.33",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "72h"
+    }
+}
+
+A multi-stage decision policy requires every stage, a subset of the group members,
+to approve proposals:
+
+{
+    "@type": "/cosmos.group.v1.MultiStageDecisionPolicy",
+    "stages": [
+        {"name": "council", "members": ["cosmos1..."], "percentage": "0.5"},
+        {"name": "treasury", "members": ["cosmos1..."], "percentage": "0.66"}
+    ],
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    }
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
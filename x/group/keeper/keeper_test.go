@@ -2923,6 +2923,117 @@ func (s *TestSuite) TestPruneProposals() {
 	s.Require().Contains(err.Error(), "load proposal: not found")
 }
 
+func (s *TestSuite) TestExecVetoPolicyProposal() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "2"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}
+	policy := group.NewVetoDecisionPolicy("0.3", time.Second*10, time.Second*5)
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	submit := func(ctx context.Context) uint64 {
+		res, err := s.groupKeeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addrs[1].String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	// A proposal can not be executed before the min execution period.
+	proposalID := submit(s.ctx)
+	_, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalID, Executor: addrs[1].String()})
+	s.Require().ErrorContains(err, "must wait")
+
+	// It passes after the min execution period if nobody vetoed it.
+	sdkCtx := s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Second * 5))
+	res, err := s.groupKeeper.Exec(sdk.WrapSDKContext(sdkCtx), &group.MsgExec{ProposalId: proposalID, Executor: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+
+	// A vetoed proposal is rejected, even after the min execution period.
+	proposalID = submit(s.ctx)
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      addrs[2].String(),
+		Option:     group.VOTE_OPTION_NO_WITH_VETO,
+	})
+	s.Require().NoError(err)
+	res, err = s.groupKeeper.Exec(sdk.WrapSDKContext(sdkCtx), &group.MsgExec{ProposalId: proposalID, Executor: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, res.Result)
+
+	proposalRes, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposalRes.Proposal.Status)
+}
+
+func (s *TestSuite) TestExecMultiStagePolicyProposal() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "2"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}
+	stages := []group.DecisionPolicyStage{
+		{Name: "council", Members: []string{addrs[1].String(), addrs[2].String()}, Percentage: "0.5"},
+		{Name: "treasury", Members: []string{addrs[3].String()}, Percentage: "1"},
+	}
+	policy := group.NewMultiStageDecisionPolicy(stages, time.Second*10, 0)
+	policyAddr, groupID := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	submit := func() uint64 {
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          []string{addrs[1].String()},
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+	vote := func(proposalID uint64, voter sdk.AccAddress, option group.VoteOption) {
+		_, err := s.groupKeeper.Vote(s.ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option})
+		s.Require().NoError(err)
+	}
+	exec := func(proposalID uint64) group.ProposalExecutorResult {
+		res, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalID, Executor: addrs[1].String()})
+		s.Require().NoError(err)
+		return res.Result
+	}
+
+	proposalID := submit()
+	vote(proposalID, addrs[1], group.VOTE_OPTION_YES)
+	vote(proposalID, addrs[3], group.VOTE_OPTION_YES)
+
+	proposal, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	stageTallies, stagePowers, err := s.groupKeeper.TallyStages(s.sdkCtx, *proposal.Proposal, groupID, stages)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"3", "1"}, stagePowers)
+	s.Require().Equal("1", stageTallies[0].YesCount)
+	s.Require().Equal("1", stageTallies[1].YesCount)
+
+	// The council did not approve the proposal yet.
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, exec(proposalID))
+	proposal, err = s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Proposal.Status)
+
+	vote(proposalID, addrs[2], group.VOTE_OPTION_YES)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, exec(proposalID))
+
+	// A proposal is rejected as soon as one stage can no longer approve it.
+	proposalID = submit()
+	vote(proposalID, addrs[1], group.VOTE_OPTION_YES)
+	vote(proposalID, addrs[2], group.VOTE_OPTION_YES)
+	vote(proposalID, addrs[3], group.VOTE_OPTION_NO)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, exec(proposalID))
+	proposal, err = s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposal.Proposal.Status)
+}
+
 func submitProposal(
 	ctx context.Context, s *TestSuite, msgs []sdk.Msg,
 	proposers []string,
@@ -686,7 +686,18 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 	}
 
 	sinceSubmission := ctx.BlockTime().Sub(p.SubmitTime) // duration passed since proposal submission.
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission)
+	var result group.DecisionPolicyResult
+	if stagedPolicy, ok := policy.(group.StagedDecisionPolicy); ok {
+		var stageTallies []group.TallyResult
+		var stagePowers []string
+		stageTallies, stagePowers, err = k.TallyStages(ctx, *p, policyInfo.GroupId, stagedPolicy.GetStages())
+		if err != nil {
+			return err
+		}
+		result, err = stagedPolicy.AllowStages(stageTallies, stagePowers, sinceSubmission)
+	} else {
+		result, err = policy.Allow(tallyResult, electorate.TotalWeight, sinceSubmission)
+	}
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	isFinal := result.Final || ctx.BlockTime().After(p.VotingPeriodEnd)
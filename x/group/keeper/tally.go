@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
@@ -61,3 +62,59 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 
 	return tallyResult, nil
 }
+
+// TallyStages tallies a proposal for each of the given stages of a
+// multi-stage decision policy. It returns, in the order of the stages, the
+// tally of the votes of the stage members and the total weight of the stage
+// members in the group. Stage members that are not members of the group carry
+// no weight.
+func (k Keeper) TallyStages(ctx sdk.Context, p group.Proposal, groupID uint64, stages []group.DecisionPolicyStage) ([]group.TallyResult, []string, error) {
+	stageTallies := make([]group.TallyResult, len(stages))
+	stagePowers := make([]string, len(stages))
+
+	for i, stage := range stages {
+		tallyResult := group.DefaultTallyResult()
+		power := math.NewDecFromInt64(0)
+
+		for _, address := range stage.Members {
+			var member group.GroupMember
+			err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+				GroupId: groupID,
+				Member:  &group.Member{Address: address},
+			}), &member)
+
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				continue
+			case err != nil:
+				return nil, nil, err
+			}
+
+			weight, err := math.NewPositiveDecFromString(member.Member.Weight)
+			if err != nil {
+				return nil, nil, err
+			}
+			power, err = power.Add(weight)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			vote, err := k.getVote(ctx, p.Id, sdk.MustAccAddressFromBech32(address))
+			switch {
+			case sdkerrors.ErrNotFound.Is(err):
+				continue
+			case err != nil:
+				return nil, nil, err
+			}
+
+			if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+				return nil, nil, sdkerrors.Wrap(err, "add new vote")
+			}
+		}
+
+		stageTallies[i] = tallyResult
+		stagePowers[i] = power.String()
+	}
+
+	return stageTallies, stagePowers, nil
+}
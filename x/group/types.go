@@ -40,6 +40,22 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
+// StagedDecisionPolicy is a DecisionPolicy whose outcome depends on the votes
+// of several subsets of the group members, called stages, rather than on the
+// votes of the whole group. The keeper tallies the votes of each stage and
+// calls AllowStages instead of Allow.
+type StagedDecisionPolicy interface {
+	DecisionPolicy
+
+	// GetStages returns the stages of the policy.
+	GetStages() []DecisionPolicyStage
+	// AllowStages defines policy-specific logic to allow a proposal to pass or
+	// not, based on the tally result and the total power of each stage, given
+	// in the same order as GetStages, and the time since the proposal was
+	// submitted.
+	AllowStages(stageTallies []TallyResult, stagePowers []string, sinceSubmission time.Duration) (DecisionPolicyResult, error)
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
@@ -227,6 +243,195 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string, si
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &VetoDecisionPolicy{}
+
+// NewVetoDecisionPolicy creates a new veto DecisionPolicy
+func NewVetoDecisionPolicy(vetoPercentage string, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &VetoDecisionPolicy{vetoPercentage, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+func (p VetoDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p VetoDecisionPolicy) ValidateBasic() error {
+	vetoPercentage, err := math.NewPositiveDecFromString(p.VetoPercentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "veto percentage")
+	}
+	if vetoPercentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "veto percentage must be > 0 and <= 1")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	// A proposal passes as soon as the min execution period has elapsed, so
+	// without it the members would have no time to veto.
+	if p.Windows.MinExecutionPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min execution period cannot be 0")
+	}
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period")
+	}
+
+	return nil
+}
+
+func (p *VetoDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow rejects a proposal as soon as the tally of no with veto votes equals or
+// exceeds the veto percentage, and allows it to pass once the min execution
+// period has elapsed otherwise.
+func (p VetoDecisionPolicy) Allow(tally TallyResult, totalPower string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	vetoPercentage, err := math.NewPositiveDecFromString(p.VetoPercentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "veto percentage")
+	}
+	vetoCount, err := math.NewNonNegativeDecFromString(tally.NoWithVetoCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "no with veto count")
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+
+	// A group without any weight can not veto.
+	if !totalPowerDec.IsZero() {
+		vetoPercentageCount, err := vetoCount.Quo(totalPowerDec)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if vetoPercentageCount.Cmp(vetoPercentage) >= 0 {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+	}
+
+	if sinceSubmission < p.Windows.MinExecutionPeriod {
+		return DecisionPolicyResult{}, errors.ErrUnauthorized.Wrapf("must wait %s after submission before execution, currently at %s", p.Windows.MinExecutionPeriod, sinceSubmission)
+	}
+
+	return DecisionPolicyResult{Allow: true, Final: true}, nil
+}
+
+// Implements StagedDecisionPolicy Interface
+var _ StagedDecisionPolicy = &MultiStageDecisionPolicy{}
+
+// NewMultiStageDecisionPolicy creates a new multi-stage DecisionPolicy
+func NewMultiStageDecisionPolicy(stages []DecisionPolicyStage, votingPeriod time.Duration, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &MultiStageDecisionPolicy{stages, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+func (p MultiStageDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+func (p MultiStageDecisionPolicy) ValidateBasic() error {
+	if len(p.Stages) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "stages")
+	}
+
+	names := make(map[string]bool, len(p.Stages))
+	for i, stage := range p.Stages {
+		if err := stage.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "stage %d", i)
+		}
+		if names[stage.Name] {
+			return sdkerrors.Wrapf(errors.ErrDuplicate, "stage name %s", stage.Name)
+		}
+		names[stage.Name] = true
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+func (p *MultiStageDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow always fails: a multi-stage policy can not be decided on the tally of
+// the whole group, see AllowStages.
+func (p MultiStageDecisionPolicy) Allow(TallyResult, string, time.Duration) (DecisionPolicyResult, error) {
+	return DecisionPolicyResult{}, sdkerrors.Wrap(errors.ErrInvalid, "multi-stage decision policy requires the tally of each stage")
+}
+
+// AllowStages allows a proposal to pass when every stage's tally of yes votes
+// equals or exceeds the stage percentage, and rejects it as soon as one stage
+// can not reach its percentage anymore.
+func (p MultiStageDecisionPolicy) AllowStages(stageTallies []TallyResult, stagePowers []string, sinceSubmission time.Duration) (DecisionPolicyResult, error) {
+	if len(stageTallies) != len(p.Stages) || len(stagePowers) != len(p.Stages) {
+		return DecisionPolicyResult{}, sdkerrors.Wrapf(errors.ErrInvalid, "expected the tally and power of %d stages", len(p.Stages))
+	}
+
+	allow := true
+	for i, stage := range p.Stages {
+		power, err := math.NewNonNegativeDecFromString(stagePowers[i])
+		if err != nil {
+			return DecisionPolicyResult{}, sdkerrors.Wrapf(err, "stage %s power", stage.Name)
+		}
+		// A stage without any weight can never approve the proposal.
+		if power.IsZero() {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+
+		stagePolicy := PercentageDecisionPolicy{Percentage: stage.Percentage, Windows: p.Windows}
+		result, err := stagePolicy.Allow(stageTallies[i], stagePowers[i], sinceSubmission)
+		if err != nil {
+			return DecisionPolicyResult{}, sdkerrors.Wrapf(err, "stage %s", stage.Name)
+		}
+		if result.Final && !result.Allow {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+		allow = allow && result.Allow
+	}
+
+	return DecisionPolicyResult{Allow: allow, Final: allow}, nil
+}
+
+func (s DecisionPolicyStage) ValidateBasic() error {
+	if s.Name == "" {
+		return sdkerrors.Wrap(errors.ErrEmpty, "stage name")
+	}
+
+	if len(s.Members) == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "stage members")
+	}
+	members := make(map[string]bool, len(s.Members))
+	for _, member := range s.Members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return sdkerrors.Wrap(err, "stage member")
+		}
+		if members[member] {
+			return sdkerrors.Wrapf(errors.ErrDuplicate, "stage member %s", member)
+		}
+		members[member] = true
+	}
+
+	percentage, err := math.NewPositiveDecFromString(s.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "stage percentage")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "stage percentage must be > 0 and <= 1")
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -297,10 +297,10 @@ func (m *MemberRequest) GetMetadata() string {
 
 // ThresholdDecisionPolicy is a decision policy where a proposal passes when it
 // satisfies the two following conditions:
-// 1. The sum of all `YES` voters' weights is greater or equal than the defined
-//    `threshold`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The sum of all `YES` voters' weights is greater or equal than the defined
+//     `threshold`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type ThresholdDecisionPolicy struct {
 	// threshold is the minimum weighted sum of `YES` votes that must be met or
 	// exceeded for a proposal to succeed.
@@ -358,10 +358,10 @@ func (m *ThresholdDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 
 // PercentageDecisionPolicy is a decision policy where a proposal passes when
 // it satisfies the two following conditions:
-// 1. The percentage of all `YES` voters' weights out of the total group weight
-//    is greater or equal than the given `percentage`.
-// 2. The voting and execution periods of the proposal respect the parameters
-//    given by `windows`.
+//  1. The percentage of all `YES` voters' weights out of the total group weight
+//     is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
 type PercentageDecisionPolicy struct {
 	// percentage is the minimum percentage the weighted sum of `YES` votes must
 	// meet for a proposal to succeed.
@@ -417,6 +417,201 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// VetoDecisionPolicy is a decision policy where proposals are optimistically
+// accepted: a proposal passes once `windows.min_execution_period` has elapsed
+// since its submission, unless the percentage of `NO_WITH_VETO` voters'
+// weights out of the total group weight reaches the given `veto_percentage`
+// beforehand, in which case the proposal is rejected.
+//
+// Since: cosmos-sdk 0.47
+type VetoDecisionPolicy struct {
+	// veto_percentage is the percentage of the total group weight that must
+	// vote `NO_WITH_VETO` for a proposal to be rejected.
+	VetoPercentage string `protobuf:"bytes,1,opt,name=veto_percentage,json=vetoPercentage,proto3" json:"veto_percentage,omitempty"`
+	// windows defines the different windows for voting and execution. The
+	// min_execution_period is the delay after which a proposal that was not
+	// vetoed passes, it must be positive and not exceed the voting period.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *VetoDecisionPolicy) Reset()         { *m = VetoDecisionPolicy{} }
+func (m *VetoDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*VetoDecisionPolicy) ProtoMessage()    {}
+func (*VetoDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *VetoDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VetoDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoDecisionPolicy.Merge(m, src)
+}
+func (m *VetoDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VetoDecisionPolicy proto.InternalMessageInfo
+
+func (m *VetoDecisionPolicy) GetVetoPercentage() string {
+	if m != nil {
+		return m.VetoPercentage
+	}
+	return ""
+}
+
+func (m *VetoDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// MultiStageDecisionPolicy is a decision policy where a proposal passes when
+// every one of its `stages` approves it, i.e. when the percentage of `YES`
+// voters' weights out of the total weight of the stage members is greater or
+// equal than the percentage of the stage. A proposal is rejected as soon as
+// one of the stages can no longer approve it.
+//
+// Since: cosmos-sdk 0.47
+type MultiStageDecisionPolicy struct {
+	// stages defines the subgroups that must all approve a proposal.
+	Stages []DecisionPolicyStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,2,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *MultiStageDecisionPolicy) Reset()         { *m = MultiStageDecisionPolicy{} }
+func (m *MultiStageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MultiStageDecisionPolicy) ProtoMessage()    {}
+func (*MultiStageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+}
+func (m *MultiStageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiStageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiStageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiStageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiStageDecisionPolicy.Merge(m, src)
+}
+func (m *MultiStageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiStageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiStageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiStageDecisionPolicy proto.InternalMessageInfo
+
+func (m *MultiStageDecisionPolicy) GetStages() []DecisionPolicyStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *MultiStageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
+// DecisionPolicyStage defines a subgroup of the group members whose approval
+// is required by a MultiStageDecisionPolicy.
+//
+// Since: cosmos-sdk 0.47
+type DecisionPolicyStage struct {
+	// name is the name of the stage, e.g. "council" or "treasury".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// members are the account addresses of the group members voting in this
+	// stage, each with its weight in the group. Addresses that are not, or no
+	// longer, members of the group carry no weight.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// percentage is the minimum percentage the weighted sum of `YES` votes of
+	// the stage members must meet for the stage to approve a proposal.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (m *DecisionPolicyStage) Reset()         { *m = DecisionPolicyStage{} }
+func (m *DecisionPolicyStage) String() string { return proto.CompactTextString(m) }
+func (*DecisionPolicyStage) ProtoMessage()    {}
+func (*DecisionPolicyStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+}
+func (m *DecisionPolicyStage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecisionPolicyStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecisionPolicyStage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecisionPolicyStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecisionPolicyStage.Merge(m, src)
+}
+func (m *DecisionPolicyStage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecisionPolicyStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecisionPolicyStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecisionPolicyStage proto.InternalMessageInfo
+
+func (m *DecisionPolicyStage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DecisionPolicyStage) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DecisionPolicyStage) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -440,7 +635,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,7 +701,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +784,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +850,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +925,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +970,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -822,7 +1017,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,6 +1089,9 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*VetoDecisionPolicy)(nil), "cosmos.group.v1.VetoDecisionPolicy")
+	proto.RegisterType((*MultiStageDecisionPolicy)(nil), "cosmos.group.v1.MultiStageDecisionPolicy")
+	proto.RegisterType((*DecisionPolicyStage)(nil), "cosmos.group.v1.DecisionPolicyStage")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -906,89 +1104,94 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0xa7, 0xb6, 0x99, 0x86, 0x66, 0x93, 0x14, 0x3b, 0x98, 0x0a,
-	0xa2, 0xa2, 0xd8, 0x6d, 0x2a, 0x81, 0xd4, 0x03, 0x60, 0x3b, 0x5b, 0xea, 0xaa, 0xb5, 0xad, 0xdd,
-	0x75, 0x42, 0xb9, 0xac, 0x36, 0xde, 0xe9, 0x66, 0x85, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd, 0x0f,
-	0x7a, 0x41, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0xbf, 0x00, 0xa9, 0x07, 0xc4, 0x85, 0x6b, 0xd5, 0x03,
-	0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0xe0, 0xc4, 0x95, 0x23, 0xda, 0x99, 0xd9, 0xc4, 0x1f, 0x89,
-	0x69, 0x2a, 0xe0, 0x94, 0xcc, 0x3c, 0xcf, 0xfb, 0xce, 0xf3, 0x7e, 0xae, 0x0c, 0x6b, 0x5d, 0xe2,
-	0xf7, 0x89, 0x5f, 0xb1, 0x3d, 0x32, 0x1c, 0x54, 0x0e, 0xae, 0x56, 0xe8, 0x68, 0x80, 0xfd, 0xf2,
-	0xc0, 0x23, 0x94, 0xa0, 0x1c, 0x07, 0xcb, 0x0c, 0x2c, 0x1f, 0x5c, 0x5d, 0x5d, 0xb2, 0x89, 0x4d,
-	0x18, 0x56, 0x09, 0xfe, 0xe3, 0xb4, 0xd5, 0x82, 0x4d, 0x88, 0xdd, 0xc3, 0x15, 0x76, 0xda, 0x1b,
-	0xde, 0xab, 0x58, 0x43, 0xcf, 0xa4, 0x0e, 0x71, 0x05, 0x5e, 0x9c, 0xc6, 0xa9, 0xd3, 0xc7, 0x3e,
-	0x35, 0xfb, 0x03, 0x41, 0x58, 0xe1, 0xef, 0x18, 0xdc, 0xb3, 0x78, 0x54, 0x40, 0xd3, 0xb6, 0xa6,
-	0x3b, 0xe2, 0x50, 0xe9, 0x5b, 0x09, 0x12, 0x77, 0x70, 0x7f, 0x0f, 0x7b, 0x68, 0x0b, 0x92, 0xa6,
-	0x65, 0x79, 0xd8, 0xf7, 0x65, 0x69, 0x5d, 0xda, 0x48, 0xd7, 0xe4, 0x9f, 0x1e, 0x6f, 0x2e, 0x09,
-	0x47, 0x55, 0x8e, 0x68, 0xd4, 0x73, 0x5c, 0x5b, 0x0d, 0x89, 0xe8, 0x02, 0x24, 0x0e, 0xb1, 0x63,
-	0xef, 0x53, 0x39, 0x1a, 0x98, 0xa8, 0xe2, 0x84, 0x56, 0x21, 0xd5, 0xc7, 0xd4, 0xb4, 0x4c, 0x6a,
-	0xca, 0x31, 0x86, 0x1c, 0x9d, 0xd1, 0x87, 0x90, 0x32, 0x2d, 0x0b, 0x5b, 0x86, 0x49, 0xe5, 0xf8,
-	0xba, 0xb4, 0x91, 0xd9, 0x5a, 0x2d, 0x73, 0x81, 0xe5, 0x50, 0x60, 0x59, 0x0f, 0x83, 0xab, 0xa5,
-	0x9e, 0xfc, 0x52, 0x8c, 0x3c, 0xfc, 0xb5, 0x28, 0xb1, 0x47, 0xb1, 0x55, 0xa5, 0xa5, 0x43, 0x38,
-	0xc7, 0x25, 0xab, 0xf8, 0xf3, 0x21, 0xf6, 0xe9, 0xff, 0xa5, 0xbc, 0xf4, 0x85, 0x04, 0xcb, 0xfa,
-	0xbe, 0x87, 0xfd, 0x7d, 0xd2, 0xb3, 0xb6, 0x71, 0xd7, 0xf1, 0x1d, 0xe2, 0xb6, 0x49, 0xcf, 0xe9,
-	0x8e, 0xd0, 0x45, 0x48, 0xd3, 0x10, 0xe2, 0x2a, 0xd4, 0xe3, 0x0b, 0xf4, 0x11, 0x24, 0x0f, 0x1d,
-	0xd7, 0x22, 0x87, 0x3e, 0x7b, 0x2e, 0xb3, 0xf5, 0x76, 0x79, 0xaa, 0x2d, 0xca, 0x93, 0xfe, 0x76,
-	0x39, 0x5b, 0x0d, 0xcd, 0xae, 0xa3, 0xa7, 0x8f, 0x37, 0xb3, 0x93, 0x9c, 0xd2, 0x43, 0x09, 0xe4,
-	0x36, 0xf6, 0xba, 0xd8, 0xa5, 0xa6, 0x8d, 0xa7, 0x04, 0x15, 0x00, 0x06, 0x47, 0x98, 0x50, 0x34,
-	0x76, 0xf3, 0x1f, 0x49, 0xfa, 0x4e, 0x82, 0xd7, 0x4f, 0x34, 0x43, 0x37, 0xe1, 0xdc, 0x01, 0xa1,
-	0x8e, 0x6b, 0x1b, 0x03, 0xec, 0x39, 0x84, 0x27, 0x29, 0xb3, 0xb5, 0x32, 0x53, 0xfb, 0x6d, 0xd1,
-	0xf8, 0xbc, 0xf4, 0x5f, 0x05, 0xa5, 0x5f, 0xe4, 0x96, 0x6d, 0x66, 0x88, 0x3a, 0xb0, 0xd4, 0x77,
-	0x5c, 0x03, 0xdf, 0xc7, 0xdd, 0x61, 0x40, 0x0c, 0x1d, 0x46, 0x5f, 0xde, 0x21, 0xea, 0x3b, 0xae,
-	0x12, 0xda, 0x73, 0xb7, 0xa5, 0x3f, 0x24, 0x48, 0x7f, 0x1c, 0x84, 0xde, 0x70, 0xef, 0x11, 0x94,
-	0x85, 0xa8, 0xc3, 0x35, 0xc6, 0xd5, 0xa8, 0x63, 0xa1, 0x32, 0x2c, 0x98, 0x56, 0xdf, 0x71, 0x79,
-	0xbb, 0xcc, 0xe9, 0x30, 0x4e, 0x9b, 0x3b, 0x01, 0x32, 0x24, 0x0f, 0xb0, 0x17, 0xa4, 0x88, 0x0d,
-	0x40, 0x5c, 0x0d, 0x8f, 0xe8, 0x4d, 0x58, 0xa4, 0x84, 0x9a, 0x3d, 0x43, 0xf4, 0xe6, 0x02, 0xb3,
-	0xcc, 0xb0, 0xbb, 0x5d, 0xde, 0xa0, 0x75, 0x80, 0xae, 0x87, 0x4d, 0xca, 0x07, 0x28, 0x71, 0x86,
-	0x01, 0x4a, 0x0b, 0xbb, 0x2a, 0x2d, 0xdd, 0x85, 0x0c, 0x0b, 0x55, 0x8c, 0xfe, 0x0a, 0xa4, 0x58,
-	0xd1, 0x8d, 0xa3, 0x90, 0x93, 0xec, 0xdc, 0xb0, 0x50, 0x05, 0x12, 0x7d, 0x46, 0x12, 0xe9, 0x5d,
-	0x9e, 0xe9, 0x12, 0x31, 0x8b, 0x82, 0x56, 0xfa, 0x2b, 0x0a, 0x39, 0xe6, 0x9b, 0x97, 0x9f, 0x25,
-	0xf3, 0x55, 0x06, 0x74, 0x5c, 0x53, 0x74, 0x52, 0xd3, 0x51, 0x2d, 0x62, 0x67, 0xaf, 0x45, 0xfc,
-	0xf4, 0x5a, 0x2c, 0x4c, 0xd6, 0xc2, 0x84, 0x9c, 0x25, 0x3a, 0xd9, 0x18, 0xb0, 0x58, 0x44, 0xb6,
-	0x97, 0x66, 0xb2, 0x5d, 0x75, 0x47, 0xb5, 0xd2, 0xd3, 0xc7, 0x9b, 0x85, 0xf9, 0x13, 0xa4, 0x66,
-	0xad, 0xc9, 0x19, 0x9d, 0xac, 0x65, 0xf2, 0x95, 0x6a, 0x79, 0x3d, 0xf5, 0xe0, 0x51, 0x31, 0xf2,
-	0xfb, 0xa3, 0xa2, 0x54, 0xfa, 0x61, 0x01, 0x52, 0x6d, 0x8f, 0x0c, 0x88, 0x6f, 0xf6, 0x66, 0x1a,
-	0xf8, 0x16, 0x2c, 0xf1, 0x7c, 0xf2, 0x58, 0x8c, 0xb0, 0x20, 0xff, 0xd4, 0xcf, 0xc8, 0x3e, 0x2e,
-	0xa6, 0x40, 0xe6, 0x36, 0xf7, 0x7b, 0x90, 0x1e, 0x30, 0x0d, 0xd8, 0xf3, 0xe5, 0xf8, 0x7a, 0x6c,
-	0xae, 0xf3, 0x63, 0x2a, 0x52, 0x20, 0xe3, 0x0f, 0xf7, 0xfa, 0x0e, 0x35, 0x82, 0x2f, 0x1b, 0x2b,
-	0xc6, 0xcb, 0x26, 0x03, 0xb8, 0x61, 0x00, 0xa1, 0xb7, 0xe0, 0x1c, 0x0f, 0x33, 0xac, 0x6a, 0x82,
-	0x65, 0x60, 0x91, 0x5d, 0xee, 0x88, 0xd2, 0x5e, 0x99, 0xca, 0x45, 0xc8, 0x4d, 0x32, 0xee, 0x78,
-	0xc4, 0xa1, 0xc5, 0xfb, 0x90, 0xf0, 0xa9, 0x49, 0x87, 0xbe, 0x9c, 0x5a, 0x97, 0x36, 0xb2, 0x5b,
-	0xc5, 0x99, 0x31, 0x08, 0x13, 0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0d, 0xe8, 0x9e, 0xe3, 0x9a,
-	0x3d, 0x83, 0x9a, 0xbd, 0xde, 0xc8, 0xf0, 0xb0, 0x3f, 0xec, 0x51, 0x39, 0xcd, 0xa2, 0xbb, 0x38,
-	0xe3, 0x44, 0x0f, 0x48, 0x2a, 0xe3, 0xd4, 0xe2, 0x41, 0x7c, 0x6a, 0x9e, 0x59, 0x8f, 0xdd, 0xa3,
-	0x36, 0xbc, 0x36, 0xb1, 0x48, 0x0d, 0xec, 0x5a, 0x32, 0x9c, 0x21, 0x5d, 0xb9, 0xf1, 0x6d, 0xaa,
-	0xb8, 0x16, 0x6a, 0x43, 0x8e, 0x2f, 0x53, 0xe2, 0x85, 0x02, 0x33, 0x2c, 0xca, 0x77, 0x4e, 0x8d,
-	0x52, 0x11, 0x7c, 0xae, 0x49, 0xcd, 0xe2, 0x89, 0x33, 0xba, 0x12, 0x34, 0x88, 0xef, 0x9b, 0x36,
-	0xf6, 0xe5, 0xc5, 0xf5, 0xd8, 0x69, 0x43, 0xa3, 0x1e, 0xb1, 0xae, 0xc7, 0x83, 0x2e, 0x2e, 0x7d,
-	0x2d, 0x41, 0x66, 0x3c, 0xd6, 0x35, 0x48, 0x8f, 0xb0, 0x6f, 0x74, 0xc9, 0xd0, 0xa5, 0xe2, 0x1b,
-	0x96, 0x1a, 0x61, 0xbf, 0x1e, 0x9c, 0x83, 0x52, 0x9b, 0x7b, 0x3e, 0x35, 0x1d, 0x57, 0x10, 0xf8,
-	0x97, 0x7c, 0x51, 0x5c, 0x72, 0xd2, 0x0a, 0xa4, 0x5c, 0x22, 0x70, 0xde, 0xaa, 0x49, 0x97, 0x70,
-	0xe8, 0x5d, 0x40, 0x2e, 0x31, 0x0e, 0x1d, 0xba, 0x6f, 0x1c, 0x60, 0x1a, 0x92, 0xf8, 0x82, 0xc8,
-	0xb9, 0x64, 0xd7, 0xa1, 0xfb, 0x3b, 0x98, 0x72, 0xb2, 0xd0, 0xf7, 0xa7, 0x04, 0xf1, 0x1d, 0x42,
-	0x31, 0x2a, 0x42, 0x66, 0x20, 0x52, 0x71, 0xbc, 0x34, 0x21, 0xbc, 0xe2, 0x3b, 0xea, 0x80, 0x50,
-	0xb1, 0x36, 0xe7, 0xee, 0x28, 0x46, 0x43, 0xd7, 0x20, 0x41, 0x06, 0xc1, 0xd7, 0x88, 0xa9, 0xcc,
-	0x6e, 0xad, 0xcd, 0xa4, 0x3e, 0x78, 0xb7, 0xc5, 0x28, 0xaa, 0xa0, 0xce, 0x5d, 0x6c, 0xff, 0xce,
-	0x3c, 0x5d, 0xfe, 0x52, 0x02, 0x38, 0x7e, 0x19, 0xad, 0xc1, 0xf2, 0x4e, 0x4b, 0x57, 0x8c, 0x56,
-	0x5b, 0x6f, 0xb4, 0x9a, 0x46, 0xa7, 0xa9, 0xb5, 0x95, 0x7a, 0xe3, 0x46, 0x43, 0xd9, 0xce, 0x47,
-	0xd0, 0x79, 0xc8, 0x8d, 0x83, 0x77, 0x15, 0x2d, 0x2f, 0xa1, 0x65, 0x38, 0x3f, 0x7e, 0x59, 0xad,
-	0x69, 0x7a, 0xb5, 0xd1, 0xcc, 0x47, 0x11, 0x82, 0xec, 0x38, 0xd0, 0x6c, 0xe5, 0x63, 0xe8, 0x22,
-	0xc8, 0x93, 0x77, 0xc6, 0x6e, 0x43, 0xbf, 0x69, 0xec, 0x28, 0x7a, 0x2b, 0x1f, 0x5f, 0x8d, 0x3f,
-	0xf8, 0xa6, 0x10, 0xb9, 0xfc, 0xa3, 0x04, 0xd9, 0xc9, 0x61, 0x43, 0x45, 0x58, 0x6b, 0xab, 0xad,
-	0x76, 0x4b, 0xab, 0xde, 0x36, 0x34, 0xbd, 0xaa, 0x77, 0xb4, 0x29, 0x65, 0x6f, 0xc0, 0xca, 0x34,
-	0x41, 0xeb, 0xd4, 0xee, 0x34, 0x74, 0x5d, 0xd9, 0xce, 0x4b, 0xc1, 0xb3, 0xd3, 0x70, 0xb5, 0x5e,
-	0x57, 0xda, 0x01, 0x1a, 0x3d, 0x09, 0x55, 0x95, 0x5b, 0x4a, 0x3d, 0x40, 0x63, 0x41, 0x46, 0x66,
-	0x6c, 0x6b, 0x2d, 0x35, 0x00, 0xe3, 0x27, 0xbd, 0x1b, 0x04, 0xb4, 0xad, 0x56, 0x77, 0x9b, 0xf9,
-	0x05, 0x11, 0xd0, 0xf7, 0x12, 0x5c, 0x38, 0x79, 0xae, 0xd0, 0x06, 0x5c, 0x3a, 0xb2, 0x57, 0x3e,
-	0x51, 0xea, 0x1d, 0xbd, 0xa5, 0x1a, 0xaa, 0xa2, 0x75, 0x6e, 0xeb, 0x53, 0x11, 0x5e, 0x82, 0xf5,
-	0x53, 0x99, 0xcd, 0x96, 0x6e, 0xa8, 0x9d, 0x66, 0x5e, 0x9a, 0xcb, 0xd2, 0x3a, 0xf5, 0xba, 0xa2,
-	0x69, 0xf9, 0xe8, 0x5c, 0xd6, 0x8d, 0x6a, 0xe3, 0x76, 0x47, 0x55, 0xf2, 0x31, 0x2e, 0xbe, 0xf6,
-	0xc1, 0x93, 0xe7, 0x05, 0xe9, 0xd9, 0xf3, 0x82, 0xf4, 0xdb, 0xf3, 0x82, 0xf4, 0xf0, 0x45, 0x21,
-	0xf2, 0xec, 0x45, 0x21, 0xf2, 0xf3, 0x8b, 0x42, 0xe4, 0xd3, 0x4b, 0xb6, 0x43, 0xf7, 0x87, 0x7b,
-	0xe5, 0x2e, 0xe9, 0x8b, 0x9f, 0x23, 0xe2, 0xcf, 0xa6, 0x6f, 0x7d, 0x56, 0xb9, 0xcf, 0x7f, 0x2d,
-	0xed, 0x25, 0x58, 0x27, 0x5e, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xea, 0x13, 0x0f, 0x14, 0x44,
-	0x0d, 0x00, 0x00,
+	// 1384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0xda, 0x8e, 0x1f, 0x9f, 0x53, 0xdb, 0x4c, 0x43, 0xe3, 0x24, 0xc5, 0x0e, 0x26, 0xa2,
+	0x51, 0x51, 0xec, 0xd6, 0x95, 0x40, 0xea, 0x01, 0xb0, 0x9d, 0x2d, 0x75, 0xd5, 0xda, 0xd6, 0xee,
+	0x3a, 0xa1, 0x5c, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0x61, 0xef, 0x18, 0xef, 0x38, 0xa9, 0x0f, 0xdc,
+	0x7b, 0x41, 0x54, 0xe2, 0xc2, 0x05, 0xa9, 0x12, 0x7f, 0x01, 0x52, 0x0e, 0x88, 0x0b, 0xd7, 0xaa,
+	0x07, 0x54, 0x71, 0xe2, 0x04, 0xa8, 0xbd, 0xc0, 0x89, 0x2b, 0x47, 0x34, 0x8f, 0x4d, 0xfc, 0x48,
+	0x5c, 0x52, 0x51, 0x4e, 0xf6, 0xcc, 0xef, 0xf7, 0xcd, 0xfc, 0xbe, 0xe7, 0xee, 0xc2, 0x6a, 0x8b,
+	0x78, 0x5d, 0xe2, 0x15, 0xda, 0x7d, 0x32, 0xe8, 0x15, 0xf6, 0xaf, 0x16, 0xe8, 0xb0, 0x87, 0xbd,
+	0x7c, 0xaf, 0x4f, 0x28, 0x41, 0x49, 0x01, 0xe6, 0x39, 0x98, 0xdf, 0xbf, 0xba, 0xb2, 0xd8, 0x26,
+	0x6d, 0xc2, 0xb1, 0x02, 0xfb, 0x27, 0x68, 0x2b, 0x99, 0x36, 0x21, 0xed, 0x0e, 0x2e, 0xf0, 0xd5,
+	0xee, 0xe0, 0x5e, 0xc1, 0x1e, 0xf4, 0x2d, 0xea, 0x10, 0x57, 0xe2, 0xd9, 0x49, 0x9c, 0x3a, 0x5d,
+	0xec, 0x51, 0xab, 0xdb, 0x93, 0x84, 0x65, 0x71, 0x8f, 0x29, 0x4e, 0x96, 0x97, 0x4a, 0x68, 0xd2,
+	0xd6, 0x72, 0x87, 0x02, 0xca, 0x7d, 0xa7, 0x40, 0xf8, 0x0e, 0xee, 0xee, 0xe2, 0x3e, 0x2a, 0x42,
+	0xc4, 0xb2, 0xed, 0x3e, 0xf6, 0xbc, 0xb4, 0xb2, 0xa6, 0x6c, 0xc4, 0xca, 0xe9, 0x9f, 0x0f, 0x37,
+	0x17, 0xe5, 0x41, 0x25, 0x81, 0xe8, 0xb4, 0xef, 0xb8, 0x6d, 0xcd, 0x27, 0xa2, 0x0b, 0x10, 0x3e,
+	0xc0, 0x4e, 0x7b, 0x8f, 0xa6, 0x03, 0xcc, 0x44, 0x93, 0x2b, 0xb4, 0x02, 0xd1, 0x2e, 0xa6, 0x96,
+	0x6d, 0x51, 0x2b, 0x1d, 0xe4, 0xc8, 0xd1, 0x1a, 0x7d, 0x00, 0x51, 0xcb, 0xb6, 0xb1, 0x6d, 0x5a,
+	0x34, 0x1d, 0x5a, 0x53, 0x36, 0xe2, 0xc5, 0x95, 0xbc, 0x10, 0x98, 0xf7, 0x05, 0xe6, 0x0d, 0xdf,
+	0xb9, 0x72, 0xf4, 0xf1, 0xaf, 0xd9, 0xb9, 0x87, 0xbf, 0x65, 0x15, 0x7e, 0x29, 0xb6, 0x4b, 0x34,
+	0x77, 0x00, 0xe7, 0x84, 0x64, 0x0d, 0x7f, 0x36, 0xc0, 0x1e, 0xfd, 0xbf, 0x94, 0xe7, 0xbe, 0x50,
+	0x60, 0xc9, 0xd8, 0xeb, 0x63, 0x6f, 0x8f, 0x74, 0xec, 0x2d, 0xdc, 0x72, 0x3c, 0x87, 0xb8, 0x0d,
+	0xd2, 0x71, 0x5a, 0x43, 0x74, 0x11, 0x62, 0xd4, 0x87, 0x84, 0x0a, 0xed, 0x78, 0x03, 0x7d, 0x08,
+	0x91, 0x03, 0xc7, 0xb5, 0xc9, 0x81, 0xc7, 0xaf, 0x8b, 0x17, 0xdf, 0xce, 0x4f, 0x94, 0x45, 0x7e,
+	0xfc, 0xbc, 0x1d, 0xc1, 0xd6, 0x7c, 0xb3, 0xeb, 0xe8, 0xc9, 0xe1, 0x66, 0x62, 0x9c, 0x93, 0x7b,
+	0xa8, 0x40, 0xba, 0x81, 0xfb, 0x2d, 0xec, 0x52, 0xab, 0x8d, 0x27, 0x04, 0x65, 0x00, 0x7a, 0x47,
+	0x98, 0x54, 0x34, 0xb2, 0xf3, 0x8a, 0x24, 0x7d, 0xa5, 0x00, 0xda, 0xc6, 0x94, 0x4c, 0x88, 0xb9,
+	0x04, 0xc9, 0x7d, 0x4c, 0x89, 0x39, 0xa5, 0x28, 0xc1, 0xb6, 0x1b, 0xaf, 0x5a, 0xd5, 0xa1, 0x02,
+	0xe9, 0x3b, 0x83, 0x0e, 0x75, 0xf4, 0x13, 0x02, 0x55, 0x86, 0xb0, 0xc7, 0xb6, 0x59, 0xf1, 0x04,
+	0x37, 0xe2, 0xc5, 0xf5, 0x17, 0xdc, 0xc8, 0xcf, 0x28, 0x87, 0x58, 0x5d, 0x6a, 0xd2, 0xf2, 0x15,
+	0xc9, 0xfe, 0x1c, 0xce, 0x9f, 0x70, 0x35, 0x42, 0x10, 0x72, 0xad, 0xae, 0x1f, 0x41, 0xfe, 0x9f,
+	0xb5, 0x40, 0x97, 0xf7, 0x04, 0x13, 0x10, 0x9c, 0xdd, 0x02, 0x92, 0x38, 0x51, 0x21, 0xc1, 0xc9,
+	0x0a, 0xc9, 0x7d, 0xaf, 0xc0, 0xeb, 0x27, 0xaa, 0x46, 0x37, 0xe1, 0xdc, 0x3e, 0xa1, 0x8e, 0xdb,
+	0x66, 0x09, 0x75, 0x88, 0x28, 0xf8, 0x78, 0x71, 0x79, 0xaa, 0x8f, 0xb7, 0xe4, 0x10, 0x13, 0x6d,
+	0xfc, 0x35, 0x6b, 0xe3, 0x05, 0x61, 0xd9, 0xe0, 0x86, 0xa8, 0x09, 0x8b, 0x5d, 0xc7, 0x35, 0xf1,
+	0x7d, 0xdc, 0x1a, 0x30, 0xa2, 0x7f, 0x60, 0xe0, 0xdf, 0x1f, 0x88, 0xba, 0x8e, 0xab, 0xfa, 0xf6,
+	0xe2, 0xd8, 0xdc, 0x9f, 0x0a, 0xc4, 0x3e, 0x62, 0x91, 0xaf, 0xba, 0xf7, 0x08, 0x4a, 0x40, 0xc0,
+	0x11, 0x1a, 0x43, 0x5a, 0xc0, 0xb1, 0x51, 0x1e, 0xe6, 0x2d, 0xbb, 0xeb, 0xb8, 0xa2, 0xf5, 0x67,
+	0x84, 0x4a, 0xd0, 0x66, 0x4e, 0xb3, 0x34, 0x44, 0xf6, 0x71, 0x9f, 0x85, 0x88, 0x0f, 0xb3, 0x90,
+	0xe6, 0x2f, 0xd1, 0x9b, 0xb0, 0x40, 0x09, 0xb5, 0x3a, 0xa6, 0x9c, 0x33, 0xf3, 0xdc, 0x32, 0xce,
+	0xf7, 0x76, 0xf8, 0x16, 0xaa, 0x00, 0xb4, 0xfa, 0xd8, 0xa2, 0x62, 0x18, 0x86, 0xcf, 0x30, 0x0c,
+	0x63, 0xd2, 0xae, 0x44, 0x73, 0x77, 0x21, 0xce, 0x5d, 0x95, 0x63, 0x7c, 0x19, 0xa2, 0xbc, 0xe6,
+	0xcc, 0x23, 0x97, 0x23, 0x7c, 0x5d, 0xb5, 0x51, 0x01, 0xc2, 0x22, 0xf7, 0x32, 0xbc, 0x4b, 0x53,
+	0x45, 0x2a, 0xe7, 0xaa, 0xa4, 0xe5, 0xfe, 0x0e, 0x40, 0x92, 0x9f, 0x2d, 0xd2, 0xcf, 0x83, 0xf9,
+	0x32, 0xc3, 0x76, 0x54, 0x53, 0x60, 0x5c, 0xd3, 0x51, 0x2e, 0x82, 0x67, 0xcf, 0x45, 0xe8, 0xf4,
+	0x5c, 0xcc, 0x8f, 0xe7, 0xc2, 0x82, 0xa4, 0x2d, 0x2b, 0xd9, 0xec, 0x71, 0x5f, 0x64, 0xb4, 0x17,
+	0xa7, 0xa2, 0x5d, 0x72, 0x87, 0xe5, 0xdc, 0x93, 0xc3, 0xcd, 0xcc, 0xec, 0x06, 0xd6, 0x12, 0xf6,
+	0xd8, 0x7a, 0x22, 0x97, 0x91, 0x97, 0xca, 0xe5, 0xf5, 0xe8, 0x83, 0x47, 0xd9, 0xb9, 0x3f, 0x1e,
+	0x65, 0x95, 0xdc, 0x8f, 0xf3, 0x10, 0x6d, 0xf4, 0x49, 0x8f, 0x78, 0x56, 0x67, 0xaa, 0x80, 0x6f,
+	0xc1, 0xa2, 0x88, 0xa7, 0xf0, 0xc5, 0xf4, 0x13, 0xf2, 0xa2, 0x7a, 0x46, 0xed, 0xe3, 0x64, 0x4a,
+	0x64, 0x66, 0x71, 0xbf, 0x0b, 0xb1, 0x1e, 0xd7, 0xc0, 0xe6, 0x4a, 0xe8, 0x05, 0x73, 0xe5, 0x98,
+	0x8a, 0x54, 0x88, 0x7b, 0x83, 0xdd, 0xae, 0x43, 0x4d, 0xf6, 0x96, 0x92, 0x9e, 0x3f, 0x43, 0x30,
+	0x40, 0x18, 0x32, 0x08, 0xbd, 0x05, 0xe7, 0x84, 0x9b, 0x7e, 0x56, 0xc3, 0x3c, 0x02, 0x0b, 0x7c,
+	0x73, 0x5b, 0xa6, 0xf6, 0xca, 0x44, 0x2c, 0x7c, 0x6e, 0x84, 0x73, 0x47, 0x3d, 0xf6, 0x2d, 0xde,
+	0xe3, 0x03, 0x9f, 0x0e, 0xbc, 0x74, 0x74, 0x4d, 0xd9, 0x48, 0x14, 0xb3, 0x53, 0x6d, 0xe0, 0x07,
+	0x5e, 0xe7, 0x34, 0x4d, 0xd2, 0x51, 0x03, 0xd0, 0x3d, 0xc7, 0xb5, 0x3a, 0x26, 0xb5, 0x3a, 0x9d,
+	0xa1, 0xd9, 0xc7, 0xde, 0xa0, 0x43, 0xd3, 0x31, 0xee, 0xdd, 0xc5, 0xa9, 0x43, 0x0c, 0x46, 0xd2,
+	0x38, 0x47, 0x3e, 0x2d, 0x52, 0xdc, 0x7a, 0x64, 0x1f, 0x35, 0xe0, 0xb5, 0xb1, 0x41, 0x6a, 0x62,
+	0xd7, 0x4e, 0xc3, 0x19, 0xc2, 0x95, 0x1c, 0x9d, 0xa6, 0xaa, 0x6b, 0xa3, 0x06, 0x24, 0xc5, 0x30,
+	0x25, 0x7d, 0x5f, 0x60, 0x9c, 0x7b, 0x79, 0xe9, 0x54, 0x2f, 0x55, 0xc9, 0x17, 0x9a, 0xb4, 0x04,
+	0x1e, 0x5b, 0xa3, 0x2b, 0xac, 0x40, 0x3c, 0x8f, 0x3f, 0x21, 0x17, 0xd6, 0x82, 0xa7, 0x35, 0x8d,
+	0x76, 0xc4, 0xba, 0x1e, 0x62, 0x55, 0x9c, 0xfb, 0x46, 0x81, 0xf8, 0xa8, 0xaf, 0xab, 0x10, 0x1b,
+	0x62, 0xcf, 0x6c, 0x91, 0x81, 0x4b, 0xe5, 0xb3, 0x2b, 0x3a, 0xc4, 0x5e, 0x85, 0xad, 0x59, 0xaa,
+	0xad, 0x5d, 0x8f, 0x5a, 0x8e, 0x2b, 0x09, 0xe2, 0xad, 0x6c, 0x41, 0x6e, 0x0a, 0xd2, 0x32, 0x44,
+	0x5d, 0x22, 0x71, 0x51, 0xaa, 0x11, 0x97, 0x08, 0xe8, 0x1d, 0x40, 0x2e, 0x31, 0x0f, 0x1c, 0xba,
+	0x67, 0xf2, 0x17, 0x0d, 0x41, 0x12, 0x03, 0x22, 0xe9, 0x92, 0x1d, 0x87, 0xee, 0xb1, 0xd7, 0x12,
+	0x4e, 0x96, 0xfa, 0xfe, 0x52, 0x20, 0xb4, 0x4d, 0x28, 0x46, 0x59, 0x88, 0xf7, 0x64, 0x28, 0x8e,
+	0x87, 0x26, 0xf8, 0x5b, 0x62, 0x46, 0xed, 0x13, 0x2a, 0xc7, 0xe6, 0xcc, 0x19, 0xc5, 0x69, 0xe8,
+	0x1a, 0x84, 0x49, 0x8f, 0x3d, 0x8d, 0xb8, 0xca, 0x44, 0x71, 0x75, 0x2a, 0xf4, 0xec, 0xde, 0x3a,
+	0xa7, 0x68, 0x92, 0x3a, 0x73, 0xb0, 0xfd, 0x37, 0xfd, 0x74, 0xf9, 0x4b, 0x05, 0xe0, 0xf8, 0x66,
+	0xb4, 0x0a, 0x4b, 0xdb, 0x75, 0x43, 0x35, 0xeb, 0x0d, 0xa3, 0x5a, 0xaf, 0x99, 0xcd, 0x9a, 0xde,
+	0x50, 0x2b, 0xd5, 0x1b, 0x55, 0x75, 0x2b, 0x35, 0x87, 0xce, 0x43, 0x72, 0x14, 0xbc, 0xab, 0xea,
+	0x29, 0x05, 0x2d, 0xc1, 0xf9, 0xd1, 0xcd, 0x52, 0x59, 0x37, 0x4a, 0xd5, 0x5a, 0x2a, 0x80, 0x10,
+	0x24, 0x46, 0x81, 0x5a, 0x3d, 0x15, 0x44, 0x17, 0x21, 0x3d, 0xbe, 0x67, 0xee, 0x54, 0x8d, 0x9b,
+	0xe6, 0xb6, 0x6a, 0xd4, 0x53, 0xa1, 0x95, 0xd0, 0x83, 0x6f, 0x33, 0x73, 0x97, 0x7f, 0x52, 0x20,
+	0x31, 0xde, 0x6c, 0x28, 0x0b, 0xab, 0x0d, 0xad, 0xde, 0xa8, 0xeb, 0xa5, 0xdb, 0xa6, 0x6e, 0x94,
+	0x8c, 0xa6, 0x3e, 0xa1, 0xec, 0x0d, 0x58, 0x9e, 0x24, 0xe8, 0xcd, 0xf2, 0x9d, 0xaa, 0x61, 0xa8,
+	0x5b, 0x29, 0x85, 0x5d, 0x3b, 0x09, 0x97, 0x2a, 0x15, 0xb5, 0xc1, 0xd0, 0xc0, 0x49, 0xa8, 0xa6,
+	0xde, 0x52, 0x2b, 0x0c, 0x0d, 0xb2, 0x88, 0x4c, 0xd9, 0x96, 0xeb, 0x1a, 0x03, 0x43, 0x27, 0xdd,
+	0xcb, 0x1c, 0xda, 0xd2, 0x4a, 0x3b, 0xb5, 0xd4, 0xbc, 0x74, 0xe8, 0x07, 0x05, 0x2e, 0x9c, 0xdc,
+	0x57, 0x68, 0x03, 0xd6, 0x8f, 0xec, 0xd5, 0x8f, 0xd5, 0x4a, 0xd3, 0xa8, 0x6b, 0xa6, 0xa6, 0xea,
+	0xcd, 0xdb, 0xc6, 0x84, 0x87, 0xeb, 0xb0, 0x76, 0x2a, 0xb3, 0x56, 0x37, 0x4c, 0xad, 0x59, 0x4b,
+	0x29, 0x33, 0x59, 0x7a, 0xb3, 0x52, 0x51, 0x75, 0x3d, 0x15, 0x98, 0xc9, 0xba, 0x51, 0xaa, 0xde,
+	0x6e, 0x6a, 0x6a, 0x2a, 0x28, 0xc4, 0x97, 0xdf, 0x7f, 0xfc, 0x2c, 0xa3, 0x3c, 0x7d, 0x96, 0x51,
+	0x7e, 0x7f, 0x96, 0x51, 0x1e, 0x3e, 0xcf, 0xcc, 0x3d, 0x7d, 0x9e, 0x99, 0xfb, 0xe5, 0x79, 0x66,
+	0xee, 0x93, 0xf5, 0xb6, 0x43, 0xf7, 0x06, 0xbb, 0xf9, 0x16, 0xe9, 0xca, 0x4f, 0x4b, 0xf9, 0xb3,
+	0xe9, 0xd9, 0x9f, 0x16, 0xee, 0x8b, 0x2f, 0xdf, 0xdd, 0x30, 0xaf, 0xc4, 0x6b, 0xff, 0x0c, 0x00,
+	0xf8, 0x4e, 0x38, 0x99, 0x10, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1213,6 +1416,143 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *VetoDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VetoDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VetoPercentage) > 0 {
+		i -= len(m.VetoPercentage)
+		copy(dAtA[i:], m.VetoPercentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoPercentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiStageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiStageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiStageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecisionPolicyStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecisionPolicyStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecisionPolicyStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1233,20 +1573,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1272,12 +1612,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1374,12 +1714,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1467,12 +1807,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1500,12 +1840,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1610,12 +1950,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1734,6 +2074,65 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *VetoDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VetoPercentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MultiStageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DecisionPolicyStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2492,6 +2891,390 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VetoDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiStageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiStageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiStageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, DecisionPolicyStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionPolicyStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionPolicyStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionPolicyStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"github.com/stretchr/testify/require"
@@ -429,3 +430,240 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestVetoDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{"all good", group.NewVetoDecisionPolicy("0.3", time.Hour, time.Minute), false},
+		{"veto percentage == 1", group.NewVetoDecisionPolicy("1", time.Hour, time.Minute), false},
+		{"veto percentage > 1", group.NewVetoDecisionPolicy("1.5", time.Hour, time.Minute), true},
+		{"zero veto percentage", group.NewVetoDecisionPolicy("0", time.Hour, time.Minute), true},
+		{"zero voting period", group.NewVetoDecisionPolicy("0.3", 0, 0), true},
+		{"zero min exec period", group.NewVetoDecisionPolicy("0.3", time.Hour, 0), true},
+		{"min exec period > voting period", group.NewVetoDecisionPolicy("0.3", time.Hour, time.Hour*2), true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestVetoDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewVetoDecisionPolicy("0.3", time.Second*100, time.Second*50)
+	testCases := []struct {
+		name           string
+		tally          group.TallyResult
+		totalPower     string
+		votingDuration time.Duration
+		result         group.DecisionPolicyResult
+		expErr         bool
+	}{
+		{
+			"veto reached before min exec period",
+			group.TallyResult{YesCount: "0", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "3"},
+			"10",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"veto reached after min exec period",
+			group.TallyResult{YesCount: "7", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "3"},
+			"10",
+			time.Second * 60,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"veto not reached before min exec period",
+			group.TallyResult{YesCount: "0", NoCount: "5", AbstainCount: "0", NoWithVetoCount: "2"},
+			"10",
+			time.Second * 10,
+			group.DecisionPolicyResult{},
+			true,
+		},
+		{
+			"veto not reached after min exec period",
+			group.TallyResult{YesCount: "0", NoCount: "5", AbstainCount: "0", NoWithVetoCount: "2"},
+			"10",
+			time.Second * 50,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"no votes after min exec period",
+			group.DefaultTallyResult(),
+			"10",
+			time.Second * 60,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"zero total power",
+			group.DefaultTallyResult(),
+			"0",
+			time.Second * 60,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, policyResult)
+			}
+		})
+	}
+}
+
+func TestMultiStageDecisionPolicyValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+
+	testCases := []struct {
+		name   string
+		stages []group.DecisionPolicyStage
+		expErr bool
+	}{
+		{
+			"all good",
+			[]group.DecisionPolicyStage{
+				{Name: "council", Members: []string{addr1}, Percentage: "0.5"},
+				{Name: "treasury", Members: []string{addr1, addr2}, Percentage: "1"},
+			},
+			false,
+		},
+		{"no stages", nil, true},
+		{
+			"empty stage name",
+			[]group.DecisionPolicyStage{{Members: []string{addr1}, Percentage: "0.5"}},
+			true,
+		},
+		{
+			"duplicate stage name",
+			[]group.DecisionPolicyStage{
+				{Name: "council", Members: []string{addr1}, Percentage: "0.5"},
+				{Name: "council", Members: []string{addr2}, Percentage: "0.5"},
+			},
+			true,
+		},
+		{
+			"no stage members",
+			[]group.DecisionPolicyStage{{Name: "council", Percentage: "0.5"}},
+			true,
+		},
+		{
+			"invalid stage member",
+			[]group.DecisionPolicyStage{{Name: "council", Members: []string{"invalid"}, Percentage: "0.5"}},
+			true,
+		},
+		{
+			"duplicate stage member",
+			[]group.DecisionPolicyStage{{Name: "council", Members: []string{addr1, addr1}, Percentage: "0.5"}},
+			true,
+		},
+		{
+			"stage percentage > 1",
+			[]group.DecisionPolicyStage{{Name: "council", Members: []string{addr1}, Percentage: "2"}},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := group.NewMultiStageDecisionPolicy(tc.stages, time.Hour, 0).ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMultiStageDecisionPolicyAllowStages(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	policy := &group.MultiStageDecisionPolicy{
+		Stages: []group.DecisionPolicyStage{
+			{Name: "council", Members: []string{addr1}, Percentage: "0.5"},
+			{Name: "treasury", Members: []string{addr1}, Percentage: "0.5"},
+		},
+		Windows: &group.DecisionPolicyWindows{VotingPeriod: time.Second * 100},
+	}
+	yes := func(count string) group.TallyResult {
+		return group.TallyResult{YesCount: count, NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"}
+	}
+	no := func(count string) group.TallyResult {
+		return group.TallyResult{YesCount: "0", NoCount: count, AbstainCount: "0", NoWithVetoCount: "0"}
+	}
+
+	testCases := []struct {
+		name   string
+		tally  []group.TallyResult
+		powers []string
+		result group.DecisionPolicyResult
+		expErr bool
+	}{
+		{
+			"all stages approve",
+			[]group.TallyResult{yes("2"), yes("3")},
+			[]string{"3", "4"},
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"one stage undecided",
+			[]group.TallyResult{yes("2"), yes("1")},
+			[]string{"3", "4"},
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"one stage rejects",
+			[]group.TallyResult{yes("3"), no("3")},
+			[]string{"3", "4"},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"stage without power",
+			[]group.TallyResult{yes("3"), group.DefaultTallyResult()},
+			[]string{"3", "0"},
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"missing stage tally",
+			[]group.TallyResult{yes("3")},
+			[]string{"3"},
+			group.DecisionPolicyResult{},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policyResult, err := policy.AllowStages(tc.tally, tc.powers, time.Second*50)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.result, policyResult)
+			}
+		})
+	}
+
+	_, err := policy.Allow(yes("3"), "3", time.Second*50)
+	require.Error(t, err)
+}
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+// GetQueryCmd returns the cli query commands for the msgfees module.
+func GetQueryCmd() *cobra.Command {
+	msgfeesQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the msgfees module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	msgfeesQueryCmd.AddCommand(
+		GetCmdQueryMsgFee(),
+		GetCmdQueryMsgFees(),
+	)
+
+	return msgfeesQueryCmd
+}
+
+// GetCmdQueryMsgFee implements a command to return the fees of a Msg type URL.
+func GetCmdQueryMsgFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-fee [msg-type-url]",
+		Short: "Query the fees of a Msg type URL",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the gas surcharge and the flat fee charged for each Msg of a type URL.
+
+Example:
+$ %s query msgfees msg-fee /cosmos.authz.v1beta1.MsgGrant
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgFee(cmd.Context(), &types.QueryMsgFeeRequest{MsgTypeUrl: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.MsgFee)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMsgFees implements a command to return the fees of all the Msg
+// type URLs with fees.
+func GetCmdQueryMsgFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-fees",
+		Short: "Query the fees of all the Msg type URLs with fees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MsgFees(cmd.Context(), &types.QueryMsgFeesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "msg fees")
+
+	return cmd
+}
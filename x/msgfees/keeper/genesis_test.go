@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	genesis := types.NewGenesisState([]types.MsgFee{
+		types.NewMsgFee(sendMsgTypeURL, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		types.NewMsgFee(delegateMsgTypeURL, 500, nil),
+	})
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+
+	s.keeper.InitGenesis(s.ctx, genesis)
+	gasSurcharge, _ := s.keeper.MsgFeeCharges(s.ctx, delegateMsgTypeURL)
+	s.Require().Equal(uint64(500), gasSurcharge)
+
+	exported := s.keeper.ExportGenesis(s.ctx)
+	s.Require().ElementsMatch(genesis.MsgFees, exported.MsgFees)
+}
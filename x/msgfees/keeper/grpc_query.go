@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// MsgFee returns the fees of a Msg type URL.
+func (k Keeper) MsgFee(c context.Context, req *types.QueryMsgFeeRequest) (*types.QueryMsgFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg type url")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	msgFee, found := k.GetMsgFee(ctx, req.MsgTypeUrl)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fees for msg type url %s", req.MsgTypeUrl)
+	}
+
+	return &types.QueryMsgFeeResponse{MsgFee: msgFee}, nil
+}
+
+// MsgFees returns the fees of all the Msg type URLs with fees.
+func (k Keeper) MsgFees(c context.Context, req *types.QueryMsgFeesRequest) (*types.QueryMsgFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MsgFeePrefix)
+
+	var msgFees []types.MsgFee
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var msgFee types.MsgFee
+		if err := k.cdc.Unmarshal(value, &msgFee); err != nil {
+			return err
+		}
+
+		msgFees = append(msgFees, msgFee)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	sendFee := types.NewMsgFee(sendMsgTypeURL, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	s.keeper.SetMsgFee(s.ctx, sendFee)
+	s.keeper.SetMsgFee(s.ctx, types.NewMsgFee(delegateMsgTypeURL, 500, nil))
+
+	msgFee, err := s.queryClient.MsgFee(gocontext.Background(), &types.QueryMsgFeeRequest{MsgTypeUrl: sendMsgTypeURL})
+	s.Require().NoError(err)
+	s.Require().Equal(sendFee, msgFee.MsgFee)
+
+	_, err = s.queryClient.MsgFee(gocontext.Background(), &types.QueryMsgFeeRequest{MsgTypeUrl: "/cosmos.gov.v1.MsgVote"})
+	s.Require().Error(err)
+
+	_, err = s.queryClient.MsgFee(gocontext.Background(), &types.QueryMsgFeeRequest{})
+	s.Require().Error(err)
+
+	msgFees, err := s.queryClient.MsgFees(gocontext.Background(), &types.QueryMsgFeesRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(msgFees.MsgFees, 1)
+	s.Require().Equal(uint64(2), msgFees.Pagination.Total)
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+var _ ante.MsgFeeKeeper = Keeper{}
+
+// Keeper of the msgfees store
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of setting the fees of the Msg type URLs. Typically,
+	// this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper constructs a new msgfees Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/msgfees module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetMsgFee returns the fees of a Msg type URL, and false if it has none.
+func (k Keeper) GetMsgFee(ctx sdk.Context, msgTypeURL string) (types.MsgFee, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MsgFeeKey(msgTypeURL))
+	if bz == nil {
+		return types.MsgFee{}, false
+	}
+
+	var msgFee types.MsgFee
+	k.cdc.MustUnmarshal(bz, &msgFee)
+	return msgFee, true
+}
+
+// SetMsgFee sets the fees of a Msg type URL, overwriting any existing ones.
+// Setting an empty MsgFee removes the fees of the Msg type URL.
+func (k Keeper) SetMsgFee(ctx sdk.Context, msgFee types.MsgFee) {
+	store := ctx.KVStore(k.storeKey)
+	if msgFee.IsEmpty() {
+		store.Delete(types.MsgFeeKey(msgFee.MsgTypeUrl))
+		return
+	}
+
+	store.Set(types.MsgFeeKey(msgFee.MsgTypeUrl), k.cdc.MustMarshal(&msgFee))
+}
+
+// IterateMsgFees iterates over the fees of the Msg type URLs, and calls the
+// provided callback for each of them. Iteration stops when the callback
+// returns true.
+func (k Keeper) IterateMsgFees(ctx sdk.Context, cb func(msgFee types.MsgFee) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MsgFeePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var msgFee types.MsgFee
+		k.cdc.MustUnmarshal(iterator.Value(), &msgFee)
+		if cb(msgFee) {
+			break
+		}
+	}
+}
+
+// GetMsgFees returns the fees of all the Msg type URLs.
+func (k Keeper) GetMsgFees(ctx sdk.Context) []types.MsgFee {
+	msgFees := []types.MsgFee{}
+	k.IterateMsgFees(ctx, func(msgFee types.MsgFee) bool {
+		msgFees = append(msgFees, msgFee)
+		return false
+	})
+
+	return msgFees
+}
+
+// MsgFeeCharges returns the gas surcharge and the flat fee of a Msg type URL.
+// It implements the ante.MsgFeeKeeper interface.
+func (k Keeper) MsgFeeCharges(ctx sdk.Context, msgTypeURL string) (gasSurcharge uint64, flatFee sdk.Coins) {
+	msgFee, _ := k.GetMsgFee(ctx, msgTypeURL)
+	return msgFee.GasSurcharge, msgFee.FlatFee
+}
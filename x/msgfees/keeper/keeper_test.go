@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/msgfees"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/keeper"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+const (
+	sendMsgTypeURL     = "/cosmos.bank.v1beta1.MsgSend"
+	delegateMsgTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	keeper      keeper.Keeper
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	authority   sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1})
+	encCfg := moduletestutil.MakeTestEncodingConfig(msgfees.AppModuleBasic{})
+
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.keeper = keeper.NewKeeper(encCfg.Codec, key, s.authority.String())
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(s.ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, s.keeper)
+	s.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestMsgFees() {
+	// no fees by default
+	_, found := s.keeper.GetMsgFee(s.ctx, sendMsgTypeURL)
+	s.Require().False(found)
+	gasSurcharge, flatFee := s.keeper.MsgFeeCharges(s.ctx, sendMsgTypeURL)
+	s.Require().Zero(gasSurcharge)
+	s.Require().True(flatFee.IsZero())
+
+	sendFee := types.NewMsgFee(sendMsgTypeURL, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	delegateFee := types.NewMsgFee(delegateMsgTypeURL, 500, nil)
+	s.keeper.SetMsgFee(s.ctx, sendFee)
+	s.keeper.SetMsgFee(s.ctx, delegateFee)
+
+	msgFee, found := s.keeper.GetMsgFee(s.ctx, sendMsgTypeURL)
+	s.Require().True(found)
+	s.Require().Equal(sendFee, msgFee)
+	gasSurcharge, flatFee = s.keeper.MsgFeeCharges(s.ctx, sendMsgTypeURL)
+	s.Require().Equal(uint64(1000), gasSurcharge)
+	s.Require().Equal(sendFee.FlatFee, flatFee)
+	s.Require().ElementsMatch([]types.MsgFee{sendFee, delegateFee}, s.keeper.GetMsgFees(s.ctx))
+
+	// an empty MsgFee removes the fees
+	s.keeper.SetMsgFee(s.ctx, types.NewMsgFee(sendMsgTypeURL, 0, nil))
+	_, found = s.keeper.GetMsgFee(s.ctx, sendMsgTypeURL)
+	s.Require().False(found)
+	s.Require().Equal([]types.MsgFee{delegateFee}, s.keeper.GetMsgFees(s.ctx))
+}
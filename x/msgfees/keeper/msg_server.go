@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/msgfees MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// SetMsgFees sets the fees of Msg type URLs.
+func (ms msgServer) SetMsgFees(goCtx context.Context, req *types.MsgSetMsgFees) (*types.MsgSetMsgFeesResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	if err := types.ValidateMsgFees(req.MsgFees); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, msgFee := range req.MsgFees {
+		ms.SetMsgFee(ctx, msgFee)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetMsgFee,
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgFee.MsgTypeUrl),
+				sdk.NewAttribute(types.AttributeKeyGasSurcharge, strconv.FormatUint(msgFee.GasSurcharge, 10)),
+				sdk.NewAttribute(types.AttributeKeyFlatFee, msgFee.FlatFee.String()),
+			),
+		)
+	}
+
+	return &types.MsgSetMsgFeesResponse{}, nil
+}
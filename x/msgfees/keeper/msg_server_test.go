@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+func (s *KeeperTestSuite) TestSetMsgFees() {
+	sendFee := types.NewMsgFee(sendMsgTypeURL, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	s.keeper.SetMsgFee(s.ctx, types.NewMsgFee(delegateMsgTypeURL, 500, nil))
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetMsgFees
+		expFees []types.MsgFee
+		expErr  error
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetMsgFees(sdk.AccAddress("invalid").String(), []types.MsgFee{sendFee}),
+			expErr: govtypes.ErrInvalidSigner,
+		},
+		{
+			name:   "invalid msg type url",
+			msg:    types.NewMsgSetMsgFees(s.authority.String(), []types.MsgFee{types.NewMsgFee("cosmos.bank.v1beta1.MsgSend", 1000, nil)}),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			name:   "duplicate msg type url",
+			msg:    types.NewMsgSetMsgFees(s.authority.String(), []types.MsgFee{sendFee, sendFee}),
+			expErr: types.ErrInvalidMsgTypeURL,
+		},
+		{
+			name: "set fees and remove the fees of a msg type url",
+			msg: types.NewMsgSetMsgFees(s.authority.String(), []types.MsgFee{
+				sendFee,
+				types.NewMsgFee(delegateMsgTypeURL, 0, nil),
+			}),
+			expFees: []types.MsgFee{sendFee},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetMsgFees(s.ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expFees, s.keeper.GetMsgFees(s.ctx))
+		})
+	}
+}
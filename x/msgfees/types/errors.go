@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/msgfees module sentinel errors
+var (
+	ErrInvalidMsgTypeURL = sdkerrors.Register(ModuleName, 2, "invalid msg type url")
+	ErrInvalidMsgFee     = sdkerrors.Register(ModuleName, 3, "invalid msg fee")
+)
@@ -0,0 +1,10 @@
+package types
+
+// msgfees module event types
+const (
+	EventTypeSetMsgFee = "set_msg_fee"
+
+	AttributeKeyMsgTypeURL   = "msg_type_url"
+	AttributeKeyGasSurcharge = "gas_surcharge"
+	AttributeKeyFlatFee      = "flat_fee"
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(msgFees []MsgFee) *GenesisState {
+	return &GenesisState{
+		MsgFees: msgFees,
+	}
+}
+
+// DefaultGenesisState returns a default msgfees module genesis state, without
+// fees.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]MsgFee{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	for _, msgFee := range data.MsgFees {
+		if msgFee.IsEmpty() {
+			return fmt.Errorf("empty fees for msg type url %s", msgFee.MsgTypeUrl)
+		}
+	}
+
+	return ValidateMsgFees(data.MsgFees)
+}
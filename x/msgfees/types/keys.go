@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the msgfees module
+	ModuleName = "msgfees"
+
+	// StoreKey is the default store key for msgfees
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for msgfees
+	RouterKey = ModuleName
+)
+
+// Keys for msgfees store
+// Items are stored with the following key: values
+//
+// - 0x01<msgTypeURL_Bytes>: MsgFee
+var (
+	MsgFeePrefix = []byte{0x01}
+)
+
+// MsgFeeKey returns the store key of the fees of a Msg type URL.
+func MsgFeeKey(msgTypeURL string) []byte {
+	return append(append([]byte{}, MsgFeePrefix...), msgTypeURL...)
+}
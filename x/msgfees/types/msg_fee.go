@@ -0,0 +1,55 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMsgFee creates a new MsgFee instance.
+func NewMsgFee(msgTypeURL string, gasSurcharge uint64, flatFee sdk.Coins) MsgFee {
+	return MsgFee{
+		MsgTypeUrl:   msgTypeURL,
+		GasSurcharge: gasSurcharge,
+		FlatFee:      flatFee,
+	}
+}
+
+// IsEmpty returns true if the MsgFee has neither a gas surcharge nor a flat
+// fee.
+func (m MsgFee) IsEmpty() bool {
+	return m.GasSurcharge == 0 && m.FlatFee.IsZero()
+}
+
+// Validate validates the MsgFee. An empty MsgFee is valid, it removes the fees
+// of its Msg type URL.
+func (m MsgFee) Validate() error {
+	if !strings.HasPrefix(m.MsgTypeUrl, "/") || len(m.MsgTypeUrl) == 1 {
+		return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%q must start with a /", m.MsgTypeUrl)
+	}
+
+	if err := m.FlatFee.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidMsgFee, "invalid flat fee of %s: %s", m.MsgTypeUrl, err)
+	}
+
+	return nil
+}
+
+// ValidateMsgFees validates a list of MsgFees, which must not have duplicate
+// Msg type URLs.
+func ValidateMsgFees(msgFees []MsgFee) error {
+	seen := make(map[string]bool, len(msgFees))
+	for _, msgFee := range msgFees {
+		if err := msgFee.Validate(); err != nil {
+			return err
+		}
+
+		if seen[msgFee.MsgTypeUrl] {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "duplicate msg type url %s", msgFee.MsgTypeUrl)
+		}
+		seen[msgFee.MsgTypeUrl] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/msgfees/types"
+)
+
+func TestValidateMsgFees(t *testing.T) {
+	sendMsgTypeURL := "/cosmos.bank.v1beta1.MsgSend"
+	sendFee := types.NewMsgFee(sendMsgTypeURL, 1000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	testCases := []struct {
+		name    string
+		msgFees []types.MsgFee
+		expErr  error
+	}{
+		{"no fees", nil, nil},
+		{"valid fees", []types.MsgFee{sendFee, types.NewMsgFee("/cosmos.staking.v1beta1.MsgDelegate", 0, nil)}, nil},
+		{"msg type url without /", []types.MsgFee{types.NewMsgFee("cosmos.bank.v1beta1.MsgSend", 1000, nil)}, types.ErrInvalidMsgTypeURL},
+		{"empty msg type url", []types.MsgFee{types.NewMsgFee("/", 1000, nil)}, types.ErrInvalidMsgTypeURL},
+		{"invalid flat fee", []types.MsgFee{types.NewMsgFee(sendMsgTypeURL, 0, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}})}, types.ErrInvalidMsgFee},
+		{"duplicate msg type url", []types.MsgFee{sendFee, types.NewMsgFee(sendMsgTypeURL, 0, nil)}, types.ErrInvalidMsgTypeURL},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMsgFees(tc.msgFees)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	// the genesis fees must not be empty
+	genesis := types.NewGenesisState([]types.MsgFee{types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", 0, nil)})
+	require.Error(t, types.ValidateGenesis(*genesis))
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetMsgFees{}
+
+// NewMsgSetMsgFees creates a new MsgSetMsgFees instance.
+func NewMsgSetMsgFees(authority string, msgFees []MsgFee) *MsgSetMsgFees {
+	return &MsgSetMsgFees{
+		Authority: authority,
+		MsgFees:   msgFees,
+	}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetMsgFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetMsgFees message.
+func (m *MsgSetMsgFees) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetMsgFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.MsgFees) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgFee, "msg fees cannot be empty")
+	}
+
+	return ValidateMsgFees(m.MsgFees)
+}
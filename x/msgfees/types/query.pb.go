@@ -0,0 +1,1060 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/msgfees/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMsgFeeRequest is the request type for the Query/MsgFee RPC method.
+type QueryMsgFeeRequest struct {
+	// msg_type_url is the type URL of the Msg to query the fees of.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryMsgFeeRequest) Reset()         { *m = QueryMsgFeeRequest{} }
+func (m *QueryMsgFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeRequest) ProtoMessage()    {}
+func (*QueryMsgFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ff204b85ee4eeb8, []int{0}
+}
+func (m *QueryMsgFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeRequest.Merge(m, src)
+}
+func (m *QueryMsgFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeeRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryMsgFeeResponse is the response type for the Query/MsgFee RPC method.
+type QueryMsgFeeResponse struct {
+	// msg_fee are the fees of the Msg type URL.
+	MsgFee MsgFee `protobuf:"bytes,1,opt,name=msg_fee,json=msgFee,proto3" json:"msg_fee"`
+}
+
+func (m *QueryMsgFeeResponse) Reset()         { *m = QueryMsgFeeResponse{} }
+func (m *QueryMsgFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeeResponse) ProtoMessage()    {}
+func (*QueryMsgFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ff204b85ee4eeb8, []int{1}
+}
+func (m *QueryMsgFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeeResponse.Merge(m, src)
+}
+func (m *QueryMsgFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeeResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeeResponse) GetMsgFee() MsgFee {
+	if m != nil {
+		return m.MsgFee
+	}
+	return MsgFee{}
+}
+
+// QueryMsgFeesRequest is the request type for the Query/MsgFees RPC method.
+type QueryMsgFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgFeesRequest) Reset()         { *m = QueryMsgFeesRequest{} }
+func (m *QueryMsgFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeesRequest) ProtoMessage()    {}
+func (*QueryMsgFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ff204b85ee4eeb8, []int{2}
+}
+func (m *QueryMsgFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeesRequest.Merge(m, src)
+}
+func (m *QueryMsgFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeesRequest proto.InternalMessageInfo
+
+func (m *QueryMsgFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMsgFeesResponse is the response type for the Query/MsgFees RPC method.
+type QueryMsgFeesResponse struct {
+	// msg_fees are the fees of the Msg type URLs.
+	MsgFees []MsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMsgFeesResponse) Reset()         { *m = QueryMsgFeesResponse{} }
+func (m *QueryMsgFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgFeesResponse) ProtoMessage()    {}
+func (*QueryMsgFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ff204b85ee4eeb8, []int{3}
+}
+func (m *QueryMsgFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgFeesResponse.Merge(m, src)
+}
+func (m *QueryMsgFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgFeesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgFeesResponse) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func (m *QueryMsgFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryMsgFeeRequest)(nil), "cosmos.msgfees.v1.QueryMsgFeeRequest")
+	proto.RegisterType((*QueryMsgFeeResponse)(nil), "cosmos.msgfees.v1.QueryMsgFeeResponse")
+	proto.RegisterType((*QueryMsgFeesRequest)(nil), "cosmos.msgfees.v1.QueryMsgFeesRequest")
+	proto.RegisterType((*QueryMsgFeesResponse)(nil), "cosmos.msgfees.v1.QueryMsgFeesResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/msgfees/v1/query.proto", fileDescriptor_2ff204b85ee4eeb8) }
+
+var fileDescriptor_2ff204b85ee4eeb8 = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0xcb, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0xaa, 0xad, 0x9e, 0x2e, 0x9e, 0xdf, 0xf0, 0x19, 0xdb, 0x58, 0x22, 0xb6, 0x22,
+	0x7a, 0x47, 0x2a, 0x88, 0x38, 0x76, 0xa8, 0x53, 0x51, 0x83, 0x2e, 0x82, 0x94, 0xa4, 0x5e, 0xcf,
+	0x60, 0x93, 0x4b, 0xf3, 0x26, 0xc5, 0xe2, 0x20, 0x08, 0xee, 0x82, 0xa3, 0xff, 0x50, 0xc7, 0x82,
+	0x8b, 0x93, 0x48, 0xeb, 0x1f, 0x22, 0xb9, 0xbb, 0x68, 0x43, 0x2b, 0xf9, 0xa6, 0x36, 0xbc, 0xcf,
+	0xfb, 0x3c, 0xbf, 0xe7, 0xee, 0x50, 0x67, 0x2a, 0x20, 0x12, 0x40, 0x23, 0xe0, 0x33, 0xc6, 0x80,
+	0x2e, 0x5d, 0xba, 0xc8, 0x59, 0xba, 0x22, 0x49, 0x2a, 0x32, 0x81, 0xaf, 0xaa, 0x31, 0xd1, 0x63,
+	0xb2, 0x74, 0xad, 0x13, 0x2e, 0xb8, 0x90, 0x53, 0x5a, 0xfc, 0x53, 0x42, 0xab, 0xcd, 0x85, 0xe0,
+	0x73, 0x46, 0xfd, 0x24, 0xa4, 0x7e, 0x1c, 0x8b, 0xcc, 0xcf, 0x42, 0x11, 0x83, 0x9e, 0xde, 0xd5,
+	0x29, 0x81, 0x0f, 0x4c, 0xf9, 0xd3, 0xa5, 0x1b, 0xb0, 0xcc, 0x77, 0x69, 0xe2, 0xf3, 0x30, 0x96,
+	0x62, 0xad, 0x3d, 0x42, 0x94, 0xad, 0x12, 0xa6, 0xad, 0x9c, 0x87, 0x08, 0x3f, 0x2f, 0x0c, 0xc6,
+	0xc0, 0x47, 0x8c, 0x79, 0x6c, 0x91, 0x33, 0xc8, 0x70, 0x17, 0x5d, 0x89, 0x80, 0x4f, 0x0a, 0xe1,
+	0x24, 0x4f, 0xe7, 0xa7, 0x66, 0xd7, 0xbc, 0x73, 0xc9, 0x43, 0x11, 0xf0, 0x17, 0xab, 0x84, 0xbd,
+	0x4c, 0xe7, 0xce, 0x53, 0x74, 0xad, 0xb2, 0x07, 0x89, 0x88, 0x81, 0xe1, 0x47, 0xa8, 0x55, 0x2c,
+	0xce, 0x18, 0x93, 0x3b, 0x97, 0x07, 0xd7, 0xc9, 0x41, 0x65, 0xa2, 0x76, 0x86, 0xe7, 0xd7, 0x3f,
+	0x6f, 0x1a, 0x5e, 0x33, 0x92, 0x5f, 0xce, 0xeb, 0x8a, 0x21, 0x94, 0x24, 0x23, 0x84, 0xfe, 0x55,
+	0xd2, 0x9e, 0xbd, 0xd2, 0xb3, 0xe8, 0x4f, 0xd4, 0xf9, 0xea, 0xfe, 0xe4, 0x99, 0xcf, 0xcb, 0x16,
+	0xde, 0xde, 0xa6, 0xf3, 0xcd, 0x44, 0x27, 0x55, 0x7f, 0x4d, 0xfc, 0x18, 0x5d, 0xd4, 0xc4, 0x70,
+	0x6a, 0x76, 0xcf, 0x9d, 0x05, 0xb9, 0xa5, 0x90, 0x01, 0x3f, 0xa9, 0xc0, 0x35, 0x24, 0x5c, 0xbf,
+	0x16, 0x4e, 0x05, 0xef, 0xd3, 0x0d, 0x3e, 0x37, 0xd0, 0x05, 0x49, 0x87, 0x3f, 0xa0, 0xa6, 0xca,
+	0xc2, 0xb7, 0x8f, 0x60, 0x1c, 0x5e, 0x95, 0xd5, 0xab, 0x93, 0xa9, 0x38, 0xc7, 0xf9, 0xf4, 0xfd,
+	0xf7, 0xd7, 0x46, 0x1b, 0x5b, 0xf4, 0xf0, 0x41, 0xe8, 0x03, 0xc0, 0x1f, 0x51, 0x6b, 0xac, 0xab,
+	0xd5, 0xd8, 0x96, 0xf7, 0x63, 0xf5, 0x6b, 0x75, 0x3a, 0xff, 0x96, 0xcc, 0xef, 0xe0, 0x1b, 0xff,
+	0xcf, 0x87, 0xe1, 0x68, 0xbd, 0xb5, 0xcd, 0xcd, 0xd6, 0x36, 0x7f, 0x6d, 0x6d, 0xf3, 0xcb, 0xce,
+	0x36, 0x36, 0x3b, 0xdb, 0xf8, 0xb1, 0xb3, 0x8d, 0x57, 0xf7, 0x78, 0x98, 0xbd, 0xcd, 0x03, 0x32,
+	0x15, 0x51, 0x69, 0xa0, 0x7e, 0xee, 0xc3, 0x9b, 0x77, 0xf4, 0xfd, 0x5f, 0x37, 0xf9, 0xb6, 0x83,
+	0xa6, 0x7c, 0xdc, 0x0f, 0xfe, 0x0c, 0x00, 0x4e, 0x19, 0x1c, 0xed, 0x8f, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MsgFee returns the fees of a Msg type URL.
+	MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error)
+	// MsgFees returns the fees of all the Msg type URLs with fees.
+	MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MsgFee(ctx context.Context, in *QueryMsgFeeRequest, opts ...grpc.CallOption) (*QueryMsgFeeResponse, error) {
+	out := new(QueryMsgFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.msgfees.v1.Query/MsgFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgFees(ctx context.Context, in *QueryMsgFeesRequest, opts ...grpc.CallOption) (*QueryMsgFeesResponse, error) {
+	out := new(QueryMsgFeesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.msgfees.v1.Query/MsgFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MsgFee returns the fees of a Msg type URL.
+	MsgFee(context.Context, *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error)
+	// MsgFees returns the fees of all the Msg type URLs with fees.
+	MsgFees(context.Context, *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MsgFee(ctx context.Context, req *QueryMsgFeeRequest) (*QueryMsgFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFee not implemented")
+}
+func (*UnimplementedQueryServer) MsgFees(ctx context.Context, req *QueryMsgFeesRequest) (*QueryMsgFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgFees not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MsgFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.msgfees.v1.Query/MsgFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFee(ctx, req.(*QueryMsgFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.msgfees.v1.Query/MsgFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgFees(ctx, req.(*QueryMsgFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.msgfees.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MsgFee",
+			Handler:    _Query_MsgFee_Handler,
+		},
+		{
+			MethodName: "MsgFees",
+			Handler:    _Query_MsgFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/msgfees/v1/query.proto",
+}
+
+func (m *QueryMsgFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MsgFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMsgFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMsgFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MsgFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMsgFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMsgFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMsgFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
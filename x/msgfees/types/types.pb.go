@@ -0,0 +1,613 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/msgfees/v1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgFee defines the fees charged in the AnteHandler for each Msg of a type
+// URL in a tx, in addition to the gas and fee of the tx.
+type MsgFee struct {
+	// msg_type_url is the type URL of the Msg, e.g. "/cosmos.authz.v1beta1.MsgGrant".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas_surcharge is the gas consumed for each Msg of the type URL.
+	GasSurcharge uint64 `protobuf:"varint,2,opt,name=gas_surcharge,json=gasSurcharge,proto3" json:"gas_surcharge,omitempty"`
+	// flat_fee is deducted from the fee payer for each Msg of the type URL.
+	FlatFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=flat_fee,json=flatFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"flat_fee"`
+}
+
+func (m *MsgFee) Reset()         { *m = MsgFee{} }
+func (m *MsgFee) String() string { return proto.CompactTextString(m) }
+func (*MsgFee) ProtoMessage()    {}
+func (*MsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ae067649b25e6c8, []int{0}
+}
+func (m *MsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFee.Merge(m, src)
+}
+func (m *MsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFee proto.InternalMessageInfo
+
+func (m *MsgFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgFee) GetGasSurcharge() uint64 {
+	if m != nil {
+		return m.GasSurcharge
+	}
+	return 0
+}
+
+func (m *MsgFee) GetFlatFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FlatFee
+	}
+	return nil
+}
+
+// GenesisState defines the msgfees module's genesis state.
+type GenesisState struct {
+	// msg_fees are the fees of the Msg type URLs.
+	MsgFees []MsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ae067649b25e6c8, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetMsgFees() []MsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgFee)(nil), "cosmos.msgfees.v1.MsgFee")
+	proto.RegisterType((*GenesisState)(nil), "cosmos.msgfees.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("cosmos/msgfees/v1/types.proto", fileDescriptor_3ae067649b25e6c8) }
+
+var fileDescriptor_3ae067649b25e6c8 = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x3f, 0x4e, 0xc3, 0x30,
+	0x14, 0xc6, 0x63, 0x5a, 0xb5, 0xc5, 0x94, 0x81, 0x88, 0x21, 0x54, 0x22, 0x8d, 0xca, 0x92, 0x01,
+	0x1c, 0x02, 0x1b, 0x63, 0x91, 0x8a, 0x84, 0xc4, 0x92, 0xc2, 0xc2, 0x12, 0x39, 0xe1, 0xc5, 0x8d,
+	0x68, 0xea, 0x2a, 0xcf, 0xad, 0xe8, 0x2d, 0x38, 0x07, 0x17, 0xe0, 0x0a, 0x1d, 0x3b, 0x32, 0x01,
+	0x6a, 0x2f, 0x82, 0x9c, 0x04, 0x10, 0x0b, 0x93, 0xff, 0x7c, 0xf6, 0xfb, 0x7e, 0xef, 0x7b, 0xf4,
+	0x30, 0x96, 0x98, 0x49, 0xf4, 0x32, 0x14, 0x09, 0x00, 0x7a, 0x73, 0xdf, 0x53, 0x8b, 0x29, 0x20,
+	0x9b, 0xe6, 0x52, 0x49, 0x73, 0xaf, 0x94, 0x59, 0x25, 0xb3, 0xb9, 0xdf, 0xd9, 0x17, 0x52, 0xc8,
+	0x42, 0xf5, 0xf4, 0xae, 0x7c, 0xd8, 0xb1, 0xab, 0x3a, 0x11, 0x47, 0xf0, 0xe6, 0x7e, 0x04, 0x8a,
+	0xfb, 0x5e, 0x2c, 0xd3, 0x49, 0xa9, 0xf7, 0x5e, 0x09, 0x6d, 0xdc, 0xa0, 0x18, 0x00, 0x98, 0x0e,
+	0x6d, 0x67, 0x28, 0x42, 0x6d, 0x13, 0xce, 0xf2, 0xb1, 0x45, 0x1c, 0xe2, 0x6e, 0x07, 0x34, 0x43,
+	0x71, 0xbb, 0x98, 0xc2, 0x5d, 0x3e, 0x36, 0x8f, 0xe8, 0xae, 0xe0, 0x18, 0xe2, 0x2c, 0x8f, 0x47,
+	0x3c, 0x17, 0x60, 0x6d, 0x39, 0xc4, 0xad, 0x07, 0x6d, 0xc1, 0x71, 0xf8, 0x7d, 0x67, 0x26, 0xb4,
+	0x95, 0x8c, 0xb9, 0x0a, 0x13, 0x00, 0xab, 0xe6, 0xd4, 0xdc, 0x9d, 0xb3, 0x03, 0x56, 0xd1, 0x6a,
+	0x08, 0x56, 0x41, 0xb0, 0x4b, 0x99, 0x4e, 0xfa, 0xa7, 0xcb, 0xf7, 0xae, 0xf1, 0xf2, 0xd1, 0x75,
+	0x45, 0xaa, 0x46, 0xb3, 0x88, 0xc5, 0x32, 0xf3, 0x2a, 0xe2, 0x72, 0x39, 0xc1, 0x87, 0xc7, 0xaa,
+	0x73, 0xfd, 0x01, 0x83, 0xa6, 0x2e, 0x3e, 0x00, 0xe8, 0x5d, 0xd3, 0xf6, 0x15, 0x4c, 0x00, 0x53,
+	0x1c, 0x2a, 0xae, 0xc0, 0xbc, 0xa0, 0x2d, 0x8d, 0xaf, 0xe3, 0xb0, 0xc8, 0x5f, 0xdf, 0xdf, 0x94,
+	0x58, 0xd9, 0x6b, 0xbf, 0xae, 0x7d, 0x83, 0x66, 0x56, 0x9c, 0xb0, 0x3f, 0x58, 0xae, 0x6d, 0xb2,
+	0x5a, 0xdb, 0xe4, 0x73, 0x6d, 0x93, 0xe7, 0x8d, 0x6d, 0xac, 0x36, 0xb6, 0xf1, 0xb6, 0xb1, 0x8d,
+	0xfb, 0xe3, 0x7f, 0xc1, 0x9e, 0x7e, 0xe6, 0x53, 0x20, 0x46, 0x8d, 0x22, 0xd4, 0xf3, 0xaf, 0x01,
+	0x00, 0x5d, 0x5a, 0xe2, 0xb0, 0xbe, 0x01, 0x00, 0x00,
+}
+
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlatFee) > 0 {
+		for iNdEx := len(m.FlatFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasSurcharge != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasSurcharge))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasSurcharge != 0 {
+		n += 1 + sovTypes(uint64(m.GasSurcharge))
+	}
+	if len(m.FlatFee) > 0 {
+		for _, e := range m.FlatFee {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSurcharge", wireType)
+			}
+			m.GasSurcharge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasSurcharge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFee = append(m.FlatFee, types.Coin{})
+			if err := m.FlatFee[len(m.FlatFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
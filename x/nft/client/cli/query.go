@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyaltyInfo(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +275,36 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyaltyInfo implements the query royalty-info command.
+func GetCmdQueryRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty-info [class-id] [sale-price]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty owed for a sale of an nft of the class at the given price.",
+		Example: fmt.Sprintf(`$ %s query %s royalty-info <class-id> 1000stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), &nft.QueryRoyaltyInfoRequest{
+				ClassId:   args[0],
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,10 +9,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName             = "name"
+	FlagSymbol           = "symbol"
+	FlagDescription      = "description"
+	FlagURI              = "uri"
+	FlagURIHash          = "uri-hash"
+	FlagBurnPermission   = "burn-permission"
+	FlagUpdatePermission = "update-permission"
+	FlagRoyaltyReceiver  = "royalty-receiver"
+	FlagRoyaltyRate      = "royalty-rate"
+	FlagReceiver         = "receiver"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +41,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdateNFT(),
 	)
 
 	return nftTxCmd
@@ -58,3 +77,186 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class, the sender becomes the class issuer",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --burn-permission owner --update-permission issuer --royalty-receiver <address> --royalty-rate 0.05 --from <issuer> --chain-id <chain-id>
+
+Permissions can be one of: none, issuer, owner, issuer-or-owner.`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			burnPermission, err := parsePermission(cmd, FlagBurnPermission)
+			if err != nil {
+				return err
+			}
+			updatePermission, err := parsePermission(cmd, FlagUpdatePermission)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Id:               args[0],
+				Name:             name,
+				Symbol:           symbol,
+				Description:      description,
+				Uri:              uri,
+				UriHash:          uriHash,
+				Issuer:           clientCtx.GetFromAddress().String(),
+				BurnPermission:   burnPermission,
+				UpdatePermission: updatePermission,
+			}
+
+			royaltyReceiver, _ := cmd.Flags().GetString(FlagRoyaltyReceiver)
+			if len(royaltyReceiver) > 0 {
+				royaltyRate, _ := cmd.Flags().GetString(FlagRoyaltyRate)
+				rate, err := sdk.NewDecFromStr(royaltyRate)
+				if err != nil {
+					return err
+				}
+				msg.Royalty = &nft.Royalty{Receiver: royaltyReceiver, Rate: rate}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagBurnPermission, "owner", "The accounts allowed to burn nfts of the class")
+	cmd.Flags().String(FlagUpdatePermission, "issuer", "The accounts allowed to update nfts of the class")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The address receiving royalties on secondary sales")
+	cmd.Flags().String(FlagRoyaltyRate, "0", "The share of the sale price paid to the royalty receiver")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mint a new nft, only the class issuer is allowed to mint",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			receiver, _ := cmd.Flags().GetString(FlagReceiver)
+
+			msg := nft.MsgMint{
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Minter:   clientCtx.GetFromAddress().String(),
+				Receiver: receiver,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagReceiver, "", "The address receiving the nft, defaults to the minter")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft according to the burn permission of its class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				ClassId: args[0],
+				Id:      args[1],
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [class-id] [nft-id] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft according to the update permission of its class",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-nft <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <sender> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgUpdateNFT{
+				ClassId: args[0],
+				Id:      args[1],
+				Uri:     uri,
+				UriHash: uriHash,
+				Sender:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parsePermission reads a permission flag given as none, issuer, owner or issuer-or-owner.
+func parsePermission(cmd *cobra.Command, flag string) (nft.Permission, error) {
+	value, _ := cmd.Flags().GetString(flag)
+	if value == "none" {
+		return nft.Permission_PERMISSION_UNSPECIFIED, nil
+	}
+
+	name := "PERMISSION_" + strings.ToUpper(strings.ReplaceAll(value, "-", "_"))
+	permission, ok := nft.Permission_value[name]
+	if !ok {
+		return nft.Permission_PERMISSION_UNSPECIFIED, fmt.Errorf("invalid %s: %s", flag, value)
+	}
+	return nft.Permission(permission), nil
+}
@@ -10,6 +10,22 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if len(class.Issuer) > 0 {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+		if err := ValidatePermission(class.BurnPermission); err != nil {
+			return err
+		}
+		if err := ValidatePermission(class.UpdatePermission); err != nil {
+			return err
+		}
+		if class.Royalty != nil {
+			if err := ValidateRoyalty(*class.Royalty); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -198,3 +198,34 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyInfo return the royalty owed for a sale of an nft of the given class, same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	if class.Royalty == nil {
+		return &nft.QueryRoyaltyInfoResponse{RoyaltyAmount: sdk.NewCoin(r.SalePrice.Denom, sdk.ZeroInt())}, nil
+	}
+
+	amount := class.Royalty.Rate.MulInt(r.SalePrice.Amount).TruncateInt()
+	return &nft.QueryRoyaltyInfoResponse{
+		Receiver:      class.Royalty.Receiver,
+		RoyaltyAmount: sdk.NewCoin(r.SalePrice.Denom, amount),
+	}, nil
+}
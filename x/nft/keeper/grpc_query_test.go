@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,73 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	var req *nft.QueryRoyaltyInfoRequest
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse)
+	}{
+		{
+			"fail empty ClassId",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{SalePrice: sdk.NewInt64Coin("stake", 1000)}
+			},
+			"invalid class id",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"fail Class not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{ClassId: "kitty1", SalePrice: sdk.NewInt64Coin("stake", 1000)}
+			},
+			"not found class",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"success no royalty",
+			func(index int, require *require.Assertions) {
+				s.TestSaveClass()
+				req = &nft.QueryRoyaltyInfoRequest{ClassId: testClassID, SalePrice: sdk.NewInt64Coin("stake", 1000)}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Empty(res.Receiver, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 0), res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success",
+			func(index int, require *require.Assertions) {
+				_, err := s.msgServer.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+					Id:      "royalty",
+					Issuer:  s.addrs[0].String(),
+					Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(5, 2)},
+				})
+				require.NoError(err)
+				req = &nft.QueryRoyaltyInfoRequest{ClassId: "royalty", SalePrice: sdk.NewInt64Coin("stake", 1030)}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Equal(s.addrs[1].String(), res.Receiver, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 51), res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.RoyaltyInfo(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
@@ -8,10 +8,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implement Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -40,3 +50,154 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	class := nft.Class{
+		Id:               msg.Id,
+		Name:             msg.Name,
+		Symbol:           msg.Symbol,
+		Description:      msg.Description,
+		Uri:              msg.Uri,
+		UriHash:          msg.UriHash,
+		Data:             msg.Data,
+		Issuer:           msg.Issuer,
+		BurnPermission:   msg.BurnPermission,
+		UpdatePermission: msg.UpdatePermission,
+		Royalty:          msg.Royalty,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// Mint implement Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if class.Issuer == "" || class.Issuer != msg.Minter {
+		return nil, sdkerrors.Wrapf(nft.ErrNoPermission, "%s is not the issuer of class %s", minter, msg.ClassId)
+	}
+
+	receiver := minter
+	if len(msg.Receiver) > 0 {
+		receiver, err = sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implement Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if err := k.checkPermission(ctx, class, class.BurnPermission, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if err := k.checkPermission(ctx, class, class.UpdatePermission, msg.Id, sender); err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+		Data:    msg.Data,
+	}
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Sender:  msg.Sender,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// checkPermission returns an error if the sender is not allowed by the given
+// permission of the class to act on the nft.
+func (k msgServer) checkPermission(ctx sdk.Context, class nft.Class, permission nft.Permission, nftID string, sender sdk.AccAddress) error {
+	if !k.HasNFT(ctx, class.Id, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	var issuer sdk.AccAddress
+	if len(class.Issuer) > 0 {
+		var err error
+		issuer, err = sdk.AccAddressFromBech32(class.Issuer)
+		if err != nil {
+			return err
+		}
+	}
+
+	owner := k.GetOwner(ctx, class.Id, nftID)
+	if !permission.Allows(sender, issuer, owner) {
+		return sdkerrors.Wrapf(nft.ErrNoPermission, "%s is not allowed to act on nft %s of class %s", sender, nftID, class.Id)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) createClass(burnPermission, updatePermission nft.Permission) {
+	_, err := s.msgServer.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+		Id:               testClassID,
+		Name:             testClassName,
+		Symbol:           testClassSymbol,
+		Issuer:           s.addrs[0].String(),
+		BurnPermission:   burnPermission,
+		UpdatePermission: updatePermission,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestCreateClassMsg() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	msg := &nft.MsgCreateClass{
+		Id:               testClassID,
+		Name:             testClassName,
+		Symbol:           testClassSymbol,
+		Issuer:           s.addrs[0].String(),
+		BurnPermission:   nft.Permission_PERMISSION_OWNER,
+		UpdatePermission: nft.Permission_PERMISSION_ISSUER,
+		Royalty:          &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(1, 1)},
+	}
+	_, err := s.msgServer.CreateClass(goCtx, msg)
+	s.Require().NoError(err)
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[0].String(), class.Issuer)
+	s.Require().Equal(nft.Permission_PERMISSION_OWNER, class.BurnPermission)
+	s.Require().Equal(nft.Permission_PERMISSION_ISSUER, class.UpdatePermission)
+	s.Require().Equal(msg.Royalty, class.Royalty)
+
+	_, err = s.msgServer.CreateClass(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestMintMsg() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Minter: s.addrs[0].String()})
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.createClass(nft.Permission_PERMISSION_OWNER, nft.Permission_PERMISSION_ISSUER)
+
+	_, err = s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Minter: s.addrs[1].String()})
+	s.Require().ErrorIs(err, nft.ErrNoPermission)
+
+	_, err = s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Uri: testURI, Minter: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	_, err = s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: "kitty2", Minter: s.addrs[0].String(), Receiver: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+	s.Require().Equal(uint64(2), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	_, err = s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Minter: s.addrs[0].String()})
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+
+	// classes saved through the keeper have no issuer and can not be minted through Msg/Mint
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "keeper-class"}))
+	_, err = s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: "keeper-class", Id: testID, Minter: s.addrs[0].String()})
+	s.Require().ErrorIs(err, nft.ErrNoPermission)
+}
+
+func (s *TestSuite) TestBurnMsg() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, owner, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	testCases := []struct {
+		msg        string
+		permission nft.Permission
+		sender     sdk.AccAddress
+		expErr     error
+	}{
+		{"unspecified permission", nft.Permission_PERMISSION_UNSPECIFIED, owner, nft.ErrNoPermission},
+		{"issuer permission by owner", nft.Permission_PERMISSION_ISSUER, owner, nft.ErrNoPermission},
+		{"issuer permission by issuer", nft.Permission_PERMISSION_ISSUER, issuer, nil},
+		{"owner permission by issuer", nft.Permission_PERMISSION_OWNER, issuer, nft.ErrNoPermission},
+		{"owner permission by owner", nft.Permission_PERMISSION_OWNER, owner, nil},
+		{"issuer or owner permission by other", nft.Permission_PERMISSION_ISSUER_OR_OWNER, other, nft.ErrNoPermission},
+		{"issuer or owner permission by issuer", nft.Permission_PERMISSION_ISSUER_OR_OWNER, issuer, nil},
+		{"issuer or owner permission by owner", nft.Permission_PERMISSION_ISSUER_OR_OWNER, owner, nil},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			s.SetupTest()
+			goCtx = sdk.WrapSDKContext(s.ctx)
+			s.createClass(tc.permission, nft.Permission_PERMISSION_UNSPECIFIED)
+			_, err := s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Minter: issuer.String(), Receiver: owner.String()})
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: testClassID, Id: testID, Sender: tc.sender.String()})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				s.Require().True(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+			s.Require().Equal(uint64(0), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+		})
+	}
+
+	_, err := s.msgServer.Burn(goCtx, &nft.MsgBurn{ClassId: testClassID, Id: "kitty2", Sender: owner.String()})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *TestSuite) TestUpdateNFTMsg() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	issuer, owner := s.addrs[0], s.addrs[1]
+
+	s.createClass(nft.Permission_PERMISSION_UNSPECIFIED, nft.Permission_PERMISSION_ISSUER)
+	_, err := s.msgServer.Mint(goCtx, &nft.MsgMint{ClassId: testClassID, Id: testID, Uri: testURI, Minter: issuer.String(), Receiver: owner.String()})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: testID, Uri: "new uri", Sender: owner.String()})
+	s.Require().ErrorIs(err, nft.ErrNoPermission)
+
+	_, err = s.msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: "kitty2", Uri: "new uri", Sender: issuer.String()})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	_, err = s.msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: testURIHash, Sender: issuer.String()})
+	s.Require().NoError(err)
+
+	token, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal("new uri", token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+	s.Require().Equal(owner, s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+}
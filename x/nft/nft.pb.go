@@ -5,7 +5,10 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,6 +26,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Permission defines which accounts are allowed to perform an action on the nfts of a class.
+type Permission int32
+
+const (
+	// PERMISSION_UNSPECIFIED defines that no account is allowed to perform the action.
+	Permission_PERMISSION_UNSPECIFIED Permission = 0
+	// PERMISSION_ISSUER defines that only the class issuer is allowed to perform the action.
+	Permission_PERMISSION_ISSUER Permission = 1
+	// PERMISSION_OWNER defines that only the nft owner is allowed to perform the action.
+	Permission_PERMISSION_OWNER Permission = 2
+	// PERMISSION_ISSUER_OR_OWNER defines that both the class issuer and the nft owner are allowed to perform the
+	// action.
+	Permission_PERMISSION_ISSUER_OR_OWNER Permission = 3
+)
+
+var Permission_name = map[int32]string{
+	0: "PERMISSION_UNSPECIFIED",
+	1: "PERMISSION_ISSUER",
+	2: "PERMISSION_OWNER",
+	3: "PERMISSION_ISSUER_OR_OWNER",
+}
+
+var Permission_value = map[string]int32{
+	"PERMISSION_UNSPECIFIED":     0,
+	"PERMISSION_ISSUER":          1,
+	"PERMISSION_OWNER":           2,
+	"PERMISSION_ISSUER_OR_OWNER": 3,
+}
+
+func (x Permission) String() string {
+	return proto.EnumName(Permission_name, int32(x))
+}
+
+func (Permission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -39,6 +79,16 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// issuer is the account that created the class through Msg/CreateClass, it is the only account allowed to mint
+	// nfts of the class. Classes created directly through the keeper have no issuer. Optional
+	Issuer string `protobuf:"bytes,8,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// burn_permission defines which accounts are allowed to burn nfts of the class through Msg/Burn.
+	BurnPermission Permission `protobuf:"varint,9,opt,name=burn_permission,json=burnPermission,proto3,enum=cosmos.nft.v1beta1.Permission" json:"burn_permission,omitempty"`
+	// update_permission defines which accounts are allowed to update nfts of the class through Msg/UpdateNFT.
+	UpdatePermission Permission `protobuf:"varint,10,opt,name=update_permission,json=updatePermission,proto3,enum=cosmos.nft.v1beta1.Permission" json:"update_permission,omitempty"`
+	// royalty defines the royalty paid to the class creator on secondary sales of its nfts, similar to ERC2981.
+	// Optional
+	Royalty *Royalty `protobuf:"bytes,11,opt,name=royalty,proto3" json:"royalty,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +173,82 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Class) GetBurnPermission() Permission {
+	if m != nil {
+		return m.BurnPermission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+func (m *Class) GetUpdatePermission() Permission {
+	if m != nil {
+		return m.UpdatePermission
+	}
+	return Permission_PERMISSION_UNSPECIFIED
+}
+
+func (m *Class) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+// Royalty defines the royalty of a class.
+type Royalty struct {
+	// receiver is the address receiving the royalty.
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// rate is the share of the sale price paid to the receiver, it must be between 0 and 1.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+func (m *Royalty) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -141,7 +267,7 @@ func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,34 +332,52 @@ func (m *NFT) GetData() *types.Any {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.Permission", Permission_name, Permission_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*Royalty)(nil), "cosmos.nft.v1beta1.Royalty")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xe3, 0x34, 0x69, 0xa7, 0x52, 0x71, 0x57, 0xa1, 0x72, 0x03, 0x72, 0xa3, 0x1c, 0x50,
+	0x84, 0x54, 0x9b, 0x16, 0xb8, 0xf5, 0xd2, 0x8f, 0x14, 0x2c, 0x44, 0x1a, 0xd9, 0x54, 0x48, 0x5c,
+	0x2c, 0x7f, 0x6c, 0x9d, 0x15, 0x89, 0x37, 0xda, 0xb5, 0x2b, 0xfc, 0x0b, 0xb8, 0xc2, 0x7f, 0xe9,
+	0x8d, 0x3f, 0xd0, 0x63, 0xd5, 0x13, 0xe2, 0x50, 0xa1, 0xf6, 0x8f, 0x20, 0xaf, 0xb7, 0xc1, 0x52,
+	0x2b, 0xca, 0x29, 0x33, 0xef, 0xbd, 0x79, 0xca, 0xcc, 0x3e, 0xc3, 0xd3, 0x90, 0xf2, 0x29, 0xe5,
+	0x56, 0x72, 0x92, 0x5a, 0xa7, 0x5b, 0x01, 0x4e, 0xfd, 0xad, 0xa2, 0x36, 0x67, 0x8c, 0xa6, 0x14,
+	0xa1, 0x92, 0x35, 0x0b, 0x44, 0xb2, 0x9d, 0x76, 0x4c, 0x63, 0x2a, 0x68, 0xab, 0xa8, 0x4a, 0x65,
+	0x67, 0x3d, 0xa6, 0x34, 0x9e, 0x60, 0x4b, 0x74, 0x41, 0x76, 0x62, 0xf9, 0x49, 0x7e, 0x4b, 0x95,
+	0x26, 0x5e, 0x39, 0x23, 0x1d, 0x45, 0xd3, 0xfb, 0xa1, 0xc2, 0xc2, 0xfe, 0xc4, 0xe7, 0x1c, 0xad,
+	0x40, 0x9d, 0x44, 0xba, 0xd2, 0x55, 0xfa, 0x4b, 0x4e, 0x9d, 0x44, 0x08, 0x41, 0x23, 0xf1, 0xa7,
+	0x58, 0xaf, 0x0b, 0x44, 0xd4, 0x68, 0x0d, 0x9a, 0x3c, 0x9f, 0x06, 0x74, 0xa2, 0xab, 0x02, 0x95,
+	0x1d, 0xea, 0xc2, 0x72, 0x84, 0x79, 0xc8, 0xc8, 0x2c, 0x25, 0x34, 0xd1, 0x1b, 0x82, 0xac, 0x42,
+	0x48, 0x03, 0x35, 0x63, 0x44, 0x5f, 0x10, 0x4c, 0x51, 0xa2, 0x75, 0x58, 0xcc, 0x18, 0xf1, 0xc6,
+	0x3e, 0x1f, 0xeb, 0x4d, 0x01, 0xb7, 0x32, 0x46, 0xde, 0xfa, 0x7c, 0x8c, 0xfa, 0xd0, 0x88, 0xfc,
+	0xd4, 0xd7, 0x5b, 0x5d, 0xa5, 0xbf, 0xbc, 0xdd, 0x36, 0xcb, 0xcd, 0xcc, 0xdb, 0xcd, 0xcc, 0xdd,
+	0x24, 0x77, 0x84, 0x02, 0xbd, 0x80, 0x26, 0xe1, 0x3c, 0xc3, 0x4c, 0x5f, 0x2c, 0x2c, 0xf6, 0xf4,
+	0xcb, 0xb3, 0xcd, 0xb6, 0x5c, 0x70, 0x37, 0x8a, 0x18, 0xe6, 0xdc, 0x4d, 0x19, 0x49, 0x62, 0x47,
+	0xea, 0xd0, 0x1b, 0x78, 0x14, 0x64, 0x2c, 0xf1, 0x66, 0x98, 0x4d, 0x09, 0xe7, 0xc5, 0xdf, 0x5d,
+	0xea, 0x2a, 0xfd, 0x95, 0x6d, 0xc3, 0xbc, 0x7b, 0x6a, 0x73, 0x34, 0x57, 0x39, 0x2b, 0xc5, 0xd8,
+	0xdf, 0x1e, 0xbd, 0x83, 0xd5, 0x6c, 0x16, 0xf9, 0x29, 0xae, 0x5a, 0xc1, 0x7f, 0x59, 0x69, 0xe5,
+	0x60, 0xc5, 0xec, 0x35, 0xb4, 0x18, 0xcd, 0xfd, 0x49, 0x9a, 0xeb, 0xcb, 0x62, 0xe9, 0x27, 0xf7,
+	0x59, 0x38, 0xa5, 0xc4, 0xb9, 0xd5, 0xf6, 0xbe, 0x2b, 0xd0, 0x92, 0x20, 0x7a, 0x05, 0x8b, 0x0c,
+	0x87, 0x98, 0x9c, 0x62, 0xa6, 0x2b, 0x0f, 0x1c, 0x63, 0xae, 0x44, 0x23, 0x68, 0x30, 0x3f, 0x95,
+	0xaf, 0xbc, 0xb7, 0x73, 0x7e, 0xb5, 0x51, 0xfb, 0x75, 0xb5, 0xf1, 0x2c, 0x26, 0xe9, 0x38, 0x0b,
+	0xcc, 0x90, 0x4e, 0x65, 0x5c, 0xe4, 0xcf, 0x26, 0x8f, 0x3e, 0x5b, 0x69, 0x3e, 0xc3, 0xdc, 0x3c,
+	0xc0, 0xe1, 0xe5, 0xd9, 0x26, 0x48, 0xff, 0x03, 0x1c, 0x3a, 0xc2, 0xa9, 0xf7, 0x55, 0x01, 0x75,
+	0x78, 0xf8, 0xa1, 0x78, 0xdf, 0xb0, 0x08, 0x96, 0x37, 0x4f, 0x55, 0x4b, 0xf4, 0x76, 0x24, 0xa3,
+	0x56, 0x9f, 0x47, 0x4d, 0x86, 0x43, 0xbd, 0x3f, 0x1c, 0x8d, 0xfb, 0xc3, 0x01, 0x0f, 0x85, 0xe3,
+	0x79, 0x06, 0x50, 0x39, 0x71, 0x07, 0xd6, 0x46, 0x03, 0xe7, 0xbd, 0xed, 0xba, 0xf6, 0xd1, 0xd0,
+	0x3b, 0x1e, 0xba, 0xa3, 0xc1, 0xbe, 0x7d, 0x68, 0x0f, 0x0e, 0xb4, 0x1a, 0x7a, 0x0c, 0xab, 0x15,
+	0xce, 0x76, 0xdd, 0xe3, 0x81, 0xa3, 0x29, 0xa8, 0x0d, 0x5a, 0x05, 0x3e, 0xfa, 0x38, 0x1c, 0x38,
+	0x5a, 0x1d, 0x19, 0xd0, 0xb9, 0x23, 0xf6, 0x8e, 0x1c, 0xc9, 0xab, 0x7b, 0x3b, 0xe7, 0xd7, 0x86,
+	0x72, 0x71, 0x6d, 0x28, 0xbf, 0xaf, 0x0d, 0xe5, 0xdb, 0x8d, 0x51, 0xbb, 0xb8, 0x31, 0x6a, 0x3f,
+	0x6f, 0x8c, 0xda, 0xa7, 0xde, 0x3f, 0xcf, 0xfa, 0xa5, 0xf8, 0xec, 0x83, 0xa6, 0x58, 0xe4, 0xe5,
+	0x9f, 0x01, 0x00, 0xbf, 0x19, 0x3e, 0x9f, 0x17, 0x04, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +400,35 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UpdatePermission != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.UpdatePermission))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BurnPermission != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.BurnPermission))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -313,6 +486,46 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -421,6 +634,35 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.BurnPermission != 0 {
+		n += 1 + sovNft(uint64(m.BurnPermission))
+	}
+	if m.UpdatePermission != 0 {
+		n += 1 + sovNft(uint64(m.UpdatePermission))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovNft(uint64(l))
 	return n
 }
 
@@ -716,6 +958,228 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnPermission", wireType)
+			}
+			m.BurnPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnPermission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePermission", wireType)
+			}
+			m.UpdatePermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatePermission |= Permission(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
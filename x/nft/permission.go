@@ -0,0 +1,23 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Allows returns whether the sender is allowed to perform an action guarded by
+// the permission, given the issuer of the class and the owner of the nft.
+func (p Permission) Allows(sender, issuer, owner sdk.AccAddress) bool {
+	isIssuer := !issuer.Empty() && sender.Equals(issuer)
+	isOwner := !owner.Empty() && sender.Equals(owner)
+
+	switch p {
+	case Permission_PERMISSION_ISSUER:
+		return isIssuer
+	case Permission_PERMISSION_OWNER:
+		return isOwner
+	case Permission_PERMISSION_ISSUER_OR_OWNER:
+		return isIssuer || isOwner
+	default:
+		return false
+	}
+}
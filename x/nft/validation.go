@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -33,3 +34,22 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidatePermission returns whether the permission is a known value
+func ValidatePermission(p Permission) error {
+	if _, ok := Permission_name[int32(p)]; !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid permission: %d", p)
+	}
+	return nil
+}
+
+// ValidateRoyalty returns whether the royalty has a valid receiver and a rate between 0 and 1
+func ValidateRoyalty(r Royalty) error {
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid receiver address (%s)", r.Receiver)
+	}
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "rate must be between 0 and 1: %s", r.Rate)
+	}
+	return nil
+}